@@ -13,6 +13,7 @@ import (
 	"github.com/openimsdk/chat/internal/api/util"
 	"github.com/openimsdk/chat/pkg/common/apistruct"
 	"github.com/openimsdk/chat/pkg/common/config"
+	chatconstant "github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/imapi"
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/common/xlsx"
@@ -26,8 +27,10 @@ import (
 	"github.com/openimsdk/tools/apiresp"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/encrypt"
+	"github.com/openimsdk/tools/utils/idutil"
 )
 
 func New(chatClient chat.ChatClient, adminClient admin.AdminClient, imApiCaller imapi.CallerInterface, api *util.Api) *Api {
@@ -52,11 +55,43 @@ func (o *Api) AdminLogin(c *gin.Context) {
 		apiresp.GinError(c, err)
 		return
 	}
+	req.Ip, err = o.GetClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
 	loginResp, err := o.adminClient.Login(c, req)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
+	if loginResp.TotpTicket != "" {
+		apiresp.GinSuccess(c, &apistruct.AdminLoginResp{
+			AdminAccount:       loginResp.AdminAccount,
+			AdminUserID:        loginResp.AdminUserID,
+			TotpTicket:         loginResp.TotpTicket,
+			TotpEnrollRequired: loginResp.TotpEnrollRequired,
+		})
+		return
+	}
+	o.adminLoginSuccess(c, loginResp)
+}
+
+func (o *Api) LoginAdminTOTP(c *gin.Context) {
+	req, err := a2r.ParseRequest[admin.LoginAdminTOTPReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	loginResp, err := o.adminClient.LoginAdminTOTP(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	o.adminLoginSuccess(c, loginResp)
+}
+
+func (o *Api) adminLoginSuccess(c *gin.Context, loginResp *admin.LoginResp) {
 	imAdminUserID := o.GetDefaultIMAdminUserID()
 	imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
 	if err != nil {
@@ -73,6 +108,46 @@ func (o *Api) AdminLogin(c *gin.Context) {
 	apiresp.GinSuccess(c, resp)
 }
 
+func (o *Api) AddRole(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.AddRole, o.adminClient)
+}
+
+func (o *Api) UpdateRole(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.UpdateRole, o.adminClient)
+}
+
+func (o *Api) DelRole(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.DelRole, o.adminClient)
+}
+
+func (o *Api) SearchRole(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SearchRole, o.adminClient)
+}
+
+func (o *Api) ListPermission(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.ListPermission, o.adminClient)
+}
+
+func (o *Api) AssignAdminRole(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.AssignAdminRole, o.adminClient)
+}
+
+func (o *Api) EnrollAdminTOTP(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.EnrollAdminTOTP, o.adminClient)
+}
+
+func (o *Api) ConfirmAdminTOTP(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.ConfirmAdminTOTP, o.adminClient)
+}
+
+func (o *Api) DisableAdminTOTP(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.DisableAdminTOTP, o.adminClient)
+}
+
+func (o *Api) RegenerateAdminTOTPRecoveryCodes(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.RegenerateAdminTOTPRecoveryCodes, o.adminClient)
+}
+
 func (o *Api) ResetUserPassword(c *gin.Context) {
 	req, err := a2r.ParseRequest[chat.ChangePasswordReq](c)
 	if err != nil {
@@ -100,6 +175,100 @@ func (o *Api) ResetUserPassword(c *gin.Context) {
 	apiresp.GinSuccess(c, resp)
 }
 
+func (o *Api) GetUserSessions(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.GetUserSessions, o.adminClient)
+}
+
+func (o *Api) RevokeUserSession(c *gin.Context) {
+	req, err := a2r.ParseRequest[admin.RevokeUserSessionReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.adminClient.RevokeUserSession(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if platform := resp.Session.Platform; platform != 0 {
+		imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		if err := o.imApiCaller.ForceOffLinePlatform(mctx.WithApiToken(c, imToken), req.UserID, platform); err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *Api) ImpersonateUser(c *gin.Context) {
+	req, err := a2r.ParseRequest[admin.ImpersonateUserReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	ip, err := o.GetClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	resp, err := o.adminClient.ImpersonateUser(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	res := &apistruct.ImpersonateUserResp{
+		ChatToken:    resp.Token,
+		ExpireTime:   resp.ExpireTime,
+		Impersonator: resp.Impersonator,
+	}
+	if req.ImToken {
+		imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		res.ImToken, err = o.imApiCaller.GetUserToken(mctx.WithApiToken(c, imToken), req.UserID, req.Platform)
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+	}
+	apiresp.GinSuccess(c, res)
+}
+
+// SignOutExpiredImpersonations signs users out of the IM on the platforms impersonations got an IM
+// token for, once the impersonation ends. The IM token itself lives as long as any other.
+func (o *Api) SignOutExpiredImpersonations(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx := o.WithAdminUser(mcontext.SetOperationID(context.Background(), "impersonation_sign_out_"+idutil.OperationIDGenerator()))
+		resp, err := o.adminClient.TakeExpiredImpersonation(ctx, &admin.TakeExpiredImpersonationReq{Limit: 100})
+		if err != nil {
+			log.ZError(ctx, "take expired impersonations failed", err)
+			continue
+		}
+		if len(resp.Tokens) == 0 {
+			continue
+		}
+		imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(ctx)
+		if err != nil {
+			log.ZError(ctx, "sign out expired impersonations failed", err, "count", len(resp.Tokens))
+			continue
+		}
+		for _, t := range resp.Tokens {
+			if err := o.imApiCaller.ForceOffLinePlatform(mctx.WithApiToken(ctx, imToken), t.UserID, t.Platform); err != nil {
+				log.ZError(ctx, "sign out expired impersonation failed", err, "userID", t.UserID, "platform", t.Platform)
+			}
+		}
+	}
+}
+
 func (o *Api) AdminUpdateInfo(c *gin.Context) {
 	req, err := a2r.ParseRequest[admin.AdminUpdateInfoReq](c)
 	if err != nil {
@@ -128,6 +297,14 @@ func (o *Api) AdminInfo(c *gin.Context) {
 	a2r.Call(c, admin.AdminClient.GetAdminInfo, o.adminClient)
 }
 
+func (o *Api) ResetUserTOTP(c *gin.Context) {
+	a2r.Call(c, chat.ChatClient.ResetUserTOTP, o.chatClient)
+}
+
+func (o *Api) SearchSuspiciousLogin(c *gin.Context) {
+	a2r.Call(c, chat.ChatClient.SearchSuspiciousLogin, o.chatClient)
+}
+
 func (o *Api) ChangeAdminPassword(c *gin.Context) {
 	a2r.Call(c, admin.AdminClient.ChangeAdminPassword, o.adminClient)
 }
@@ -292,10 +469,30 @@ func (o *Api) SearchUserIPLimitLogin(c *gin.Context) {
 	a2r.Call(c, admin.AdminClient.SearchUserIPLimitLogin, o.adminClient)
 }
 
+func (o *Api) SearchLoginLock(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SearchLoginLock, o.adminClient)
+}
+
+func (o *Api) ClearLoginLock(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.ClearLoginLock, o.adminClient)
+}
+
 func (o *Api) DelUserIPLimitLogin(c *gin.Context) {
 	a2r.Call(c, admin.AdminClient.DelUserIPLimitLogin, o.adminClient)
 }
 
+func (o *Api) AddGeoRule(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.AddGeoRule, o.adminClient)
+}
+
+func (o *Api) DelGeoRule(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.DelGeoRule, o.adminClient)
+}
+
+func (o *Api) SearchGeoRule(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SearchGeoRule, o.adminClient)
+}
+
 func (o *Api) SearchIPForbidden(c *gin.Context) {
 	a2r.Call(c, admin.AdminClient.SearchIPForbidden, o.adminClient)
 }
@@ -313,35 +510,109 @@ func (o *Api) ParseToken(c *gin.Context) {
 }
 
 func (o *Api) BlockUser(c *gin.Context) {
-	req, err := a2r.ParseRequest[admin.BlockUserReq](c)
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
-	}
-	resp, err := o.adminClient.BlockUser(c, req)
+	a2r.Call(c, admin.AdminClient.BlockUser, o.adminClient)
+}
+
+func (o *Api) UnblockUser(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.UnblockUser, o.adminClient)
+}
+
+func (o *Api) SearchBlockUser(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SearchBlockUser, o.adminClient)
+}
+
+func (o *Api) SearchBlockHistory(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SearchBlockHistory, o.adminClient)
+}
+
+func (o *Api) SearchAppeal(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SearchAppeal, o.adminClient)
+}
+
+func (o *Api) AssignAppeal(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.AssignAppeal, o.adminClient)
+}
+
+func (o *Api) HandleAppeal(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.HandleAppeal, o.adminClient)
+}
+
+func (o *Api) SearchReport(c *gin.Context) {
+	a2r.Call(c, chat.ChatClient.SearchReport, o.chatClient)
+}
+
+func (o *Api) SearchReportTarget(c *gin.Context) {
+	a2r.Call(c, chat.ChatClient.SearchReportTarget, o.chatClient)
+}
+
+func (o *Api) HandleReport(c *gin.Context) {
+	req, err := a2r.ParseRequest[chat.HandleReportReq](c)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
-	imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+	resp, err := o.chatClient.HandleReport(c, req)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
-	err = o.imApiCaller.ForceOffLine(mctx.WithApiToken(c, imToken), req.UserID)
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
+	if resp.WarnedBy == chatconstant.WarnedByIM {
+		imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		if err := o.imApiCaller.SendTextMessage(mctx.WithApiToken(c, imToken), resp.UserID, resp.ImWarning); err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		resp.ImWarning = ""
 	}
 	apiresp.GinSuccess(c, resp)
 }
 
-func (o *Api) UnblockUser(c *gin.Context) {
-	a2r.Call(c, admin.AdminClient.UnblockUser, o.adminClient)
+func (o *Api) AddModerationRule(c *gin.Context) {
+	a2r.Call(c, chat.ChatClient.AddModerationRule, o.chatClient)
 }
 
-func (o *Api) SearchBlockUser(c *gin.Context) {
-	a2r.Call(c, admin.AdminClient.SearchBlockUser, o.adminClient)
+func (o *Api) UpdateModerationRule(c *gin.Context) {
+	a2r.Call(c, chat.ChatClient.UpdateModerationRule, o.chatClient)
+}
+
+func (o *Api) DelModerationRule(c *gin.Context) {
+	a2r.Call(c, chat.ChatClient.DelModerationRule, o.chatClient)
+}
+
+func (o *Api) SearchModerationRule(c *gin.Context) {
+	a2r.Call(c, chat.ChatClient.SearchModerationRule, o.chatClient)
+}
+
+func (o *Api) AddModerationAllowUser(c *gin.Context) {
+	a2r.Call(c, chat.ChatClient.AddModerationAllowUser, o.chatClient)
+}
+
+func (o *Api) DelModerationAllowUser(c *gin.Context) {
+	a2r.Call(c, chat.ChatClient.DelModerationAllowUser, o.chatClient)
+}
+
+func (o *Api) SearchModerationAllowUser(c *gin.Context) {
+	a2r.Call(c, chat.ChatClient.SearchModerationAllowUser, o.chatClient)
+}
+
+func (o *Api) SearchDelivery(c *gin.Context) {
+	a2r.Call(c, chat.ChatClient.SearchDelivery, o.chatClient)
+}
+
+func (o *Api) SetMessageTemplate(c *gin.Context) {
+	a2r.Call(c, chat.ChatClient.SetMessageTemplate, o.chatClient)
+}
+
+func (o *Api) DelMessageTemplate(c *gin.Context) {
+	a2r.Call(c, chat.ChatClient.DelMessageTemplate, o.chatClient)
+}
+
+func (o *Api) SearchMessageTemplate(c *gin.Context) {
+	a2r.Call(c, chat.ChatClient.SearchMessageTemplate, o.chatClient)
 }
 
 func (o *Api) SetClientConfig(c *gin.Context) {
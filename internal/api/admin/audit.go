@@ -0,0 +1,81 @@
+package admin
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/tools/a2r"
+	"github.com/openimsdk/tools/apiresp"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+const auditExportPage = 1000
+
+func (o *Api) SearchAuditLog(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.SearchAuditLog, o.adminClient)
+}
+
+func (o *Api) VerifyAuditLog(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.VerifyAuditLog, o.adminClient)
+}
+
+// ExportAuditLog streams the entries matching the search conditions as CSV, newest first.
+func (o *Api) ExportAuditLog(c *gin.Context) {
+	var req admin.ScanAuditLogReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apiresp.GinError(c, errs.ErrArgs.WithDetail(err.Error()).Wrap())
+		return
+	}
+	req.Cursor, req.Limit = "", auditExportPage
+	resp, err := o.adminClient.ScanAuditLog(c, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=audit_%s.csv", time.Now().Format("20060102150405")))
+	c.Header("Content-Description", "File Transfer")
+	c.Status(http.StatusOK)
+	c.Writer.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w := csv.NewWriter(c.Writer)
+	_ = w.Write([]string{"seq", "time", "operatorUserID", "action", "target", "diff", "ip", "operationID", "errCode", "errMsg", "resultOf", "prevHash", "hash"})
+	for {
+		for _, l := range resp.Logs {
+			_ = w.Write([]string{
+				strconv.FormatInt(l.Seq, 10),
+				time.UnixMilli(l.CreateTime).UTC().Format(time.RFC3339Nano),
+				l.OperatorUserID,
+				l.Action,
+				l.Target,
+				l.Diff,
+				l.Ip,
+				l.OperationID,
+				strconv.Itoa(int(l.ErrCode)),
+				l.ErrMsg,
+				strconv.FormatInt(l.ResultOf, 10),
+				l.PrevHash,
+				l.Hash,
+			})
+		}
+		w.Flush()
+		if resp.NextCursor == "" {
+			return
+		}
+		req.Cursor = resp.NextCursor
+		if resp, err = o.adminClient.ScanAuditLog(c, &req); err != nil {
+			// The headers are out, drop the connection so the client sees an incomplete transfer
+			// rather than a file that looks complete.
+			log.ZError(c, "export audit log failed", err, "cursor", req.Cursor)
+			if conn, _, err := c.Writer.Hijack(); err == nil {
+				_ = conn.Close()
+			}
+			return
+		}
+	}
+}
@@ -14,11 +14,13 @@ import (
 	chatmw "github.com/openimsdk/chat/internal/api/mw"
 	"github.com/openimsdk/chat/internal/api/util"
 	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/imapi"
 	"github.com/openimsdk/chat/pkg/common/kdisc"
 	disetcd "github.com/openimsdk/chat/pkg/common/kdisc/etcd"
 	adminclient "github.com/openimsdk/chat/pkg/protocol/admin"
 	chatclient "github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/discovery/etcd"
 	"github.com/openimsdk/tools/errs"
@@ -70,10 +72,23 @@ func Start(ctx context.Context, index int, config *Config) error {
 		ChatAdminUserID: config.Share.ChatAdmin[0],
 	}
 	adminApi := New(chatClient, adminClient, im, &base)
+	go adminApi.SignOutExpiredImpersonations(30 * time.Second)
 	mwApi := chatmw.New(adminClient)
 	gin.SetMode(gin.ReleaseMode)
 	engine := gin.New()
 	engine.Use(gin.Recovery(), mw.CorsHandler(), mw.GinParseOperationID())
+	if config.AdminAPI.RateLimit.Enable {
+		rdb, err := redisutil.NewRedisClient(ctx, config.Redis.Build())
+		if err != nil {
+			return err
+		}
+		limiter, err := mwApi.NewRateLimiter(config.AdminAPI.RateLimit, rdb, base.GetClientIP)
+		if err != nil {
+			return err
+		}
+		engine.Use(limiter.Handle)
+	}
+	engine.Use(mwApi.Audit(base.GetClientIP))
 	SetAdminRoute(engine, adminApi, mwApi, config, client)
 
 	if config.Discovery.Enable == kdisc.ETCDCONST {
@@ -119,28 +134,44 @@ func Start(ctx context.Context, index int, config *Config) error {
 }
 
 func SetAdminRoute(router gin.IRouter, admin *Api, mw *chatmw.MW, cfg *Config, client discovery.SvcDiscoveryRegistry) {
+	router.GET("/.well-known/jwks.json", admin.JWKS(admin.adminClient)) // Public keys verifying chat tokens
 
 	adminRouterGroup := router.Group("/account")
-	adminRouterGroup.POST("/login", admin.AdminLogin)                                   // Login
-	adminRouterGroup.POST("/update", mw.CheckAdmin, admin.AdminUpdateInfo)              // Modify information
-	adminRouterGroup.POST("/info", mw.CheckAdmin, admin.AdminInfo)                      // Get information
-	adminRouterGroup.POST("/change_password", mw.CheckAdmin, admin.ChangeAdminPassword) // Change admin account's password
-	adminRouterGroup.POST("/add_admin", mw.CheckAdmin, admin.AddAdminAccount)           // Add admin account
-	adminRouterGroup.POST("/add_user", mw.CheckAdmin, admin.AddUserAccount)             // Add user account
-	adminRouterGroup.POST("/del_admin", mw.CheckAdmin, admin.DelAdminAccount)           // Delete admin
-	adminRouterGroup.POST("/search", mw.CheckAdmin, admin.SearchAdminAccount)           // Get admin list
+	adminRouterGroup.POST("/login", admin.AdminLogin)                                                         // Login
+	adminRouterGroup.POST("/update", mw.CheckAdmin, admin.AdminUpdateInfo)                                    // Modify information
+	adminRouterGroup.POST("/info", mw.CheckAdmin, admin.AdminInfo)                                            // Get information
+	adminRouterGroup.POST("/change_password", mw.CheckAdmin, admin.ChangeAdminPassword)                       // Change admin account's password
+	adminRouterGroup.POST("/add_admin", mw.CheckPermission(constant.PermAdminsManage), admin.AddAdminAccount) // Add admin account
+	adminRouterGroup.POST("/add_user", mw.CheckPermission(constant.PermUsersWrite), admin.AddUserAccount)     // Add user account
+	adminRouterGroup.POST("/del_admin", mw.CheckPermission(constant.PermAdminsManage), admin.DelAdminAccount) // Delete admin
+	adminRouterGroup.POST("/search", mw.CheckPermission(constant.PermAdminsManage), admin.SearchAdminAccount) // Get admin list
+	adminRouterGroup.POST("/login/totp", admin.LoginAdminTOTP)                                                // Complete login with the TOTP code
+
+	totpRouterGroup := adminRouterGroup.Group("/totp")
+	totpRouterGroup.POST("/enroll", mw.CheckAdminOrNil, admin.EnrollAdminTOTP)                                // Generate a TOTP secret, with the admin token or a login ticket
+	totpRouterGroup.POST("/confirm", mw.CheckAdmin, admin.ConfirmAdminTOTP)                                   // Enable TOTP with the first code
+	totpRouterGroup.POST("/disable", mw.CheckAdmin, admin.DisableAdminTOTP)                                   // Disable TOTP when the policy allows it
+	totpRouterGroup.POST("/recovery_codes/regenerate", mw.CheckAdmin, admin.RegenerateAdminTOTPRecoveryCodes) // Replace own recovery codes, or another admin's as super admin
 	//account.POST("/add_notification_account")
 
+	roleRouterGroup := router.Group("/role")
+	roleRouterGroup.POST("/add", mw.CheckPermission(constant.PermRolesManage), admin.AddRole)                                  // Add role with permissions
+	roleRouterGroup.POST("/update", mw.CheckPermission(constant.PermRolesManage), admin.UpdateRole)                            // Modify role name, note or permissions
+	roleRouterGroup.POST("/del", mw.CheckPermission(constant.PermRolesManage), admin.DelRole)                                  // Delete roles no longer assigned
+	roleRouterGroup.POST("/search", mw.CheckPermission(constant.PermRolesManage, constant.PermAdminsManage), admin.SearchRole) // Search roles
+	roleRouterGroup.POST("/permissions", mw.CheckAdmin, admin.ListPermission)                                                  // List all permission names
+	roleRouterGroup.POST("/assign", mw.CheckPermission(constant.PermRolesManage), admin.AssignAdminRole)                       // Replace the roles of an admin
+
 	importGroup := router.Group("/user/import")
-	importGroup.POST("/json", mw.CheckAdmin, admin.ImportUserByJson)
-	importGroup.POST("/xlsx", mw.CheckAdmin, admin.ImportUserByXlsx)
+	importGroup.POST("/json", mw.CheckPermission(constant.PermUsersWrite), admin.ImportUserByJson)
+	importGroup.POST("/xlsx", mw.CheckPermission(constant.PermUsersWrite), admin.ImportUserByXlsx)
 	importGroup.GET("/xlsx", admin.BatchImportTemplate)
 
-	allowRegisterGroup := router.Group("/user/allow_register", mw.CheckAdmin)
-	allowRegisterGroup.POST("/get", admin.GetAllowRegister)
-	allowRegisterGroup.POST("/set", admin.SetAllowRegister)
+	allowRegisterGroup := router.Group("/user/allow_register")
+	allowRegisterGroup.POST("/get", mw.CheckPermission(constant.PermConfigRead, constant.PermConfigWrite), admin.GetAllowRegister)
+	allowRegisterGroup.POST("/set", mw.CheckPermission(constant.PermConfigWrite), admin.SetAllowRegister)
 
-	defaultRouter := router.Group("/default", mw.CheckAdmin)
+	defaultRouter := router.Group("/default", mw.CheckPermission(constant.PermDefaultManage))
 	defaultUserRouter := defaultRouter.Group("/user")
 	defaultUserRouter.POST("/add", admin.AddDefaultFriend)       // Add default friend at registration
 	defaultUserRouter.POST("/del", admin.DelDefaultFriend)       // Delete default friend at registration
@@ -152,68 +183,114 @@ func SetAdminRoute(router gin.IRouter, admin *Api, mw *chatmw.MW, cfg *Config, c
 	defaultGroupRouter.POST("/find", admin.FindDefaultGroup)     // Get default group list at registration
 	defaultGroupRouter.POST("/search", admin.SearchDefaultGroup) // Search default group list at registration
 
-	invitationCodeRouter := router.Group("/invitation_code", mw.CheckAdmin)
+	invitationCodeRouter := router.Group("/invitation_code", mw.CheckPermission(constant.PermInvitationManage))
 	invitationCodeRouter.POST("/add", admin.AddInvitationCode)       // Add invitation code
 	invitationCodeRouter.POST("/gen", admin.GenInvitationCode)       // Generate invitation code
 	invitationCodeRouter.POST("/del", admin.DelInvitationCode)       // Delete invitation code
 	invitationCodeRouter.POST("/search", admin.SearchInvitationCode) // Search invitation code
 
-	forbiddenRouter := router.Group("/forbidden", mw.CheckAdmin)
-	ipForbiddenRouter := forbiddenRouter.Group("/ip")
+	forbiddenRouter := router.Group("/forbidden")
+	ipForbiddenRouter := forbiddenRouter.Group("/ip", mw.CheckPermission(constant.PermForbiddenManage))
 	ipForbiddenRouter.POST("/add", admin.AddIPForbidden)       // Add forbidden IP for registration/login
 	ipForbiddenRouter.POST("/del", admin.DelIPForbidden)       // Delete forbidden IP for registration/login
 	ipForbiddenRouter.POST("/search", admin.SearchIPForbidden) // Search forbidden IPs for registration/login
-	userForbiddenRouter := forbiddenRouter.Group("/user")
+	userForbiddenRouter := forbiddenRouter.Group("/user", mw.CheckPermission(constant.PermForbiddenManage))
 	userForbiddenRouter.POST("/add", admin.AddUserIPLimitLogin)       // Add limit for user login on specific IP
 	userForbiddenRouter.POST("/del", admin.DelUserIPLimitLogin)       // Delete user limit on specific IP for login
 	userForbiddenRouter.POST("/search", admin.SearchUserIPLimitLogin) // Search limit for user login on specific IP
+	geoForbiddenRouter := forbiddenRouter.Group("/geo", mw.CheckPermission(constant.PermForbiddenManage))
+	geoForbiddenRouter.POST("/add", admin.AddGeoRule)       // Block or verify registration/login from countries or regions
+	geoForbiddenRouter.POST("/del", admin.DelGeoRule)       // Delete country or region rules
+	geoForbiddenRouter.POST("/search", admin.SearchGeoRule) // Search country or region rules
+	lockRouter := forbiddenRouter.Group("/login_lock")
+	lockRouter.POST("/search", mw.CheckPermission(constant.PermUsersRead), admin.SearchLoginLock) // Search accounts and IPs locked after failed logins
+	lockRouter.POST("/clear", mw.CheckPermission(constant.PermUsersBlock), admin.ClearLoginLock)  // Unlock accounts and IPs locked after failed logins
 
-	appletRouterGroup := router.Group("/applet", mw.CheckAdmin)
+	appletRouterGroup := router.Group("/applet", mw.CheckPermission(constant.PermAppletManage))
 	appletRouterGroup.POST("/add", admin.AddApplet)       // Add applet
 	appletRouterGroup.POST("/del", admin.DelApplet)       // Delete applet
 	appletRouterGroup.POST("/update", admin.UpdateApplet) // Modify applet
 	appletRouterGroup.POST("/search", admin.SearchApplet) // Search applet
 
-	blockRouter := router.Group("/block", mw.CheckAdmin)
-	blockRouter.POST("/add", admin.BlockUser)          // Block user
-	blockRouter.POST("/del", admin.UnblockUser)        // Unblock user
-	blockRouter.POST("/search", admin.SearchBlockUser) // Search blocked users
+	blockRouter := router.Group("/block")
+	blockRouter.POST("/add", mw.CheckPermission(constant.PermUsersBlock), admin.BlockUser)                    // Block user
+	blockRouter.POST("/del", mw.CheckPermission(constant.PermUsersBlock), admin.UnblockUser)                  // Unblock user
+	blockRouter.POST("/search", mw.CheckPermission(constant.PermUsersRead), admin.SearchBlockUser)            // Search blocked users
+	blockRouter.POST("/history/search", mw.CheckPermission(constant.PermUsersRead), admin.SearchBlockHistory) // Search block and unblock history
+
+	appealRouter := router.Group("/appeal")
+	appealRouter.POST("/search", mw.CheckPermission(constant.PermUsersRead), admin.SearchAppeal)  // Search appeals against blocks
+	appealRouter.POST("/assign", mw.CheckPermission(constant.PermUsersBlock), admin.AssignAppeal) // Assign appeals to an admin
+	appealRouter.POST("/handle", mw.CheckPermission(constant.PermUsersBlock), admin.HandleAppeal) // Accept (unblock) or reject an appeal
+
+	reportRouter := router.Group("/report")
+	reportRouter.POST("/search", mw.CheckPermission(constant.PermUsersRead), admin.SearchReport)              // Search abuse reports
+	reportRouter.POST("/target/search", mw.CheckPermission(constant.PermUsersRead), admin.SearchReportTarget) // Moderation queue of reported targets
+	reportRouter.POST("/handle", mw.CheckPermission(constant.PermUsersBlock), admin.HandleReport)             // Block, warn or dismiss the reports against a target
+
+	router.Group("/delivery").POST("/search", mw.CheckPermission(constant.PermUsersRead), admin.SearchDelivery) // SMS and email delivery status per account
 
-	userRouter := router.Group("/user", mw.CheckAdmin)
-	userRouter.POST("/password/reset", admin.ResetUserPassword) // Reset user password
+	templateRouter := router.Group("/message_template", mw.CheckPermission(constant.PermTemplateManage))
+	templateRouter.POST("/set", admin.SetMessageTemplate)       // Add or replace a localized verification code template
+	templateRouter.POST("/del", admin.DelMessageTemplate)       // Delete a template, the one on disk or built in applies again
+	templateRouter.POST("/search", admin.SearchMessageTemplate) // Search templates added through the admin API
 
-	initGroup := router.Group("/client_config", mw.CheckAdmin)
-	initGroup.POST("/get", admin.GetClientConfig) // Get client initialization configuration
-	initGroup.POST("/set", admin.SetClientConfig) // Set client initialization configuration
-	initGroup.POST("/del", admin.DelClientConfig) // Delete client initialization configuration
+	moderationRouter := router.Group("/moderation", mw.CheckPermission(constant.PermModerationManage))
+	moderationRouter.POST("/rule/add", admin.AddModerationRule)                  // Add a content moderation rule
+	moderationRouter.POST("/rule/update", admin.UpdateModerationRule)            // Update a content moderation rule
+	moderationRouter.POST("/rule/del", admin.DelModerationRule)                  // Delete content moderation rules
+	moderationRouter.POST("/rule/search", admin.SearchModerationRule)            // Search content moderation rules
+	moderationRouter.POST("/allow_user/add", admin.AddModerationAllowUser)       // Exempt users from content moderation
+	moderationRouter.POST("/allow_user/del", admin.DelModerationAllowUser)       // Moderate the content of users again
+	moderationRouter.POST("/allow_user/search", admin.SearchModerationAllowUser) // Search users exempt from content moderation
 
-	statistic := router.Group("/statistic", mw.CheckAdmin)
+	userRouter := router.Group("/user")
+	userRouter.POST("/password/reset", mw.CheckPermission(constant.PermUsersWrite), admin.ResetUserPassword)             // Reset user password
+	userRouter.POST("/totp/reset", mw.CheckPermission(constant.PermUsersWrite), admin.ResetUserTOTP)                     // Remove user TOTP so they can enroll again
+	userRouter.POST("/suspicious_login/search", mw.CheckPermission(constant.PermUsersRead), admin.SearchSuspiciousLogin) // Search logins flagged as new device or impossible travel
+	userRouter.POST("/session/list", mw.CheckPermission(constant.PermUsersRead), admin.GetUserSessions)                  // List user signed-in devices
+	userRouter.POST("/session/revoke", mw.CheckPermission(constant.PermUsersBlock), admin.RevokeUserSession)             // Sign out a user device
+	userRouter.POST("/impersonate", mw.CheckPermission(constant.PermUsersImpersonate), admin.ImpersonateUser)            // Get a short-lived token to act as the user for support
+
+	initGroup := router.Group("/client_config")
+	initGroup.POST("/get", mw.CheckAdmin, admin.GetClientConfig)                                      // Get client initialization configuration
+	initGroup.POST("/set", mw.CheckPermission(constant.PermClientConfigWrite), admin.SetClientConfig) // Set client initialization configuration
+	initGroup.POST("/del", mw.CheckPermission(constant.PermClientConfigWrite), admin.DelClientConfig) // Delete client initialization configuration
+
+	statistic := router.Group("/statistic", mw.CheckPermission(constant.PermStatisticRead))
 	statistic.POST("/new_user_count", admin.NewUserCount)
 	statistic.POST("/login_user_count", admin.LoginUserCount)
 
 	applicationGroup := router.Group("application")
-	applicationGroup.POST("/add_version", mw.CheckAdmin, admin.AddApplicationVersion)
-	applicationGroup.POST("/update_version", mw.CheckAdmin, admin.UpdateApplicationVersion)
-	applicationGroup.POST("/delete_version", mw.CheckAdmin, admin.DeleteApplicationVersion)
+	applicationGroup.POST("/add_version", mw.CheckPermission(constant.PermApplicationManage), admin.AddApplicationVersion)
+	applicationGroup.POST("/update_version", mw.CheckPermission(constant.PermApplicationManage), admin.UpdateApplicationVersion)
+	applicationGroup.POST("/delete_version", mw.CheckPermission(constant.PermApplicationManage), admin.DeleteApplicationVersion)
 	applicationGroup.POST("/latest_version", admin.LatestApplicationVersion)
 	applicationGroup.POST("/page_versions", admin.PageApplicationVersion)
 
+	auditGroup := router.Group("/audit", mw.CheckPermission(constant.PermAuditRead))
+	auditGroup.POST("/search", admin.SearchAuditLog) // Search admin operations
+	auditGroup.POST("/verify", admin.VerifyAuditLog) // Check the hash chain for changed or deleted entries
+	auditGroup.POST("/export", admin.ExportAuditLog) // Download admin operations as CSV
+
 	var etcdClient *clientv3.Client
 	if cfg.Discovery.Enable == kdisc.ETCDCONST {
 		etcdClient = client.(*etcd.SvcDiscoveryRegistryImpl).GetClient()
 	}
 	cm := NewConfigManager(cfg.AllConfig, etcdClient, cfg.ConfigPath, cfg.RuntimeEnv)
 	{
-		configGroup := router.Group("/config", mw.CheckAdmin)
-		configGroup.POST("/get_config_list", cm.GetConfigList)
-		configGroup.POST("/get_config", cm.GetConfig)
-		configGroup.POST("/set_config", cm.SetConfig)
-		configGroup.POST("/set_configs", cm.SetConfigs)
-		configGroup.POST("/reset_config", cm.ResetConfig)
-		configGroup.POST("/get_enable_config_manager", cm.GetEnableConfigManager)
-		configGroup.POST("/set_enable_config_manager", cm.SetEnableConfigManager)
+		configRead := mw.CheckPermission(constant.PermConfigRead, constant.PermConfigWrite)
+		configWrite := mw.CheckPermission(constant.PermConfigWrite)
+		configGroup := router.Group("/config")
+		configGroup.POST("/get_config_list", configRead, cm.GetConfigList)
+		configGroup.POST("/get_config", configRead, cm.GetConfig)
+		configGroup.POST("/set_config", configWrite, cm.SetConfig)
+		configGroup.POST("/set_configs", configWrite, cm.SetConfigs)
+		configGroup.POST("/reset_config", configWrite, cm.ResetConfig)
+		configGroup.POST("/get_enable_config_manager", configRead, cm.GetEnableConfigManager)
+		configGroup.POST("/set_enable_config_manager", configWrite, cm.SetEnableConfigManager)
 	}
 	{
-		router.POST("/restart", mw.CheckAdmin, cm.Restart)
+		router.POST("/restart", mw.CheckPermission(constant.PermSystemRestart), cm.Restart)
 	}
 }
@@ -16,12 +16,14 @@ package chat
 
 import (
 	"io"
+	"net/http"
 	"time"
 
 	"github.com/openimsdk/chat/internal/api/util"
 
 	"github.com/gin-gonic/gin"
 	"github.com/openimsdk/chat/pkg/common/apistruct"
+	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/imapi"
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
@@ -64,6 +66,9 @@ func (o *Api) SendVerifyCode(c *gin.Context) {
 		return
 	}
 	req.Ip = ip
+	if req.Language == "" {
+		req.Language = c.GetHeader("Accept-Language")
+	}
 	resp, err := o.chatClient.SendVerifyCode(c, req)
 	if err != nil {
 		apiresp.GinError(c, err)
@@ -72,6 +77,10 @@ func (o *Api) SendVerifyCode(c *gin.Context) {
 	apiresp.GinSuccess(c, resp)
 }
 
+func (o *Api) GetCaptcha(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.GetCaptcha, o.chatClient)
+}
+
 func (o *Api) VerifyCode(c *gin.Context) {
 	a2r.Call(c, chatpb.ChatClient.VerifyCode, o.chatClient)
 }
@@ -152,6 +161,7 @@ func (o *Api) RegisterUser(c *gin.Context) {
 		}
 	}
 	resp.ChatToken = respRegisterUser.ChatToken
+	resp.RefreshToken = respRegisterUser.RefreshToken
 	resp.UserID = respRegisterUser.UserID
 	apiresp.GinSuccess(c, &resp)
 }
@@ -173,6 +183,37 @@ func (o *Api) Login(c *gin.Context) {
 		apiresp.GinError(c, err)
 		return
 	}
+	if resp.TotpTicket != "" {
+		apiresp.GinSuccess(c, &apistruct.LoginResp{
+			UserID:     resp.UserID,
+			TotpTicket: resp.TotpTicket,
+		})
+		return
+	}
+	o.loginSuccess(c, resp, req.Platform)
+}
+
+func (o *Api) LoginTOTP(c *gin.Context) {
+	req, err := a2r.ParseRequest[chatpb.LoginTOTPReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	ip, err := o.GetClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	resp, err := o.chatClient.LoginTOTP(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	o.loginSuccess(c, resp, req.Platform)
+}
+
+func (o *Api) loginSuccess(c *gin.Context, resp *chatpb.LoginResp, platform int32) {
 	adminToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
 	if err != nil {
 		apiresp.GinError(c, err)
@@ -180,18 +221,23 @@ func (o *Api) Login(c *gin.Context) {
 	}
 	apiCtx := mctx.WithApiToken(c, adminToken)
 
-	imToken, err := o.imApiCaller.GetUserToken(apiCtx, resp.UserID, req.Platform)
+	imToken, err := o.imApiCaller.GetUserToken(apiCtx, resp.UserID, platform)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
 	apiresp.GinSuccess(c, &apistruct.LoginResp{
-		ImToken:   imToken,
-		UserID:    resp.UserID,
-		ChatToken: resp.ChatToken,
+		ImToken:      imToken,
+		UserID:       resp.UserID,
+		ChatToken:    resp.ChatToken,
+		RefreshToken: resp.RefreshToken,
 	})
 }
 
+func (o *Api) RefreshToken(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.RefreshToken, o.adminClient)
+}
+
 func (o *Api) ResetPassword(c *gin.Context) {
 	a2r.Call(c, chatpb.ChatClient.ResetPassword, o.chatClient)
 }
@@ -221,6 +267,71 @@ func (o *Api) ChangePassword(c *gin.Context) {
 	apiresp.GinSuccess(c, resp)
 }
 
+// ################## TOTP ##################
+
+func (o *Api) EnrollTOTP(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.EnrollTOTP, o.chatClient)
+}
+
+func (o *Api) ConfirmTOTP(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.ConfirmTOTP, o.chatClient)
+}
+
+func (o *Api) DisableTOTP(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.DisableTOTP, o.chatClient)
+}
+
+func (o *Api) RegenerateTOTPRecoveryCodes(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.RegenerateTOTPRecoveryCodes, o.chatClient)
+}
+
+func (o *Api) GetTOTPStatus(c *gin.Context) {
+	a2r.Call(c, chatpb.ChatClient.GetTOTPStatus, o.chatClient)
+}
+
+// ################## SESSION ##################
+
+func (o *Api) GetSessions(c *gin.Context) {
+	req, err := a2r.ParseRequest[admin.GetUserSessionsReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.UserID = mctx.GetOpUserID(c)
+	resp, err := o.adminClient.GetUserSessions(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *Api) RevokeSession(c *gin.Context) {
+	req, err := a2r.ParseRequest[admin.RevokeUserSessionReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.UserID = mctx.GetOpUserID(c)
+	resp, err := o.adminClient.RevokeUserSession(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if platform := resp.Session.Platform; platform != 0 {
+		imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		if err := o.imApiCaller.ForceOffLinePlatform(mctx.WithApiToken(c, imToken), req.UserID, platform); err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
 // ################## USER ##################
 
 func (o *Api) UpdateUserInfo(c *gin.Context) {
@@ -295,6 +406,63 @@ func (o *Api) GetClientConfig(c *gin.Context) {
 	a2r.Call(c, admin.AdminClient.GetClientConfig, o.adminClient)
 }
 
+// ################## APPEAL ##################
+
+func (o *Api) GetAppeal(c *gin.Context) {
+	a2r.Call(c, admin.AdminClient.GetAppeal, o.adminClient)
+}
+
+func (o *Api) SubmitAppeal(c *gin.Context) {
+	req, err := a2r.ParseRequest[admin.SubmitAppealReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip, err = o.GetClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.adminClient.SubmitAppeal(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+// AddReport files a report, the sender of a reported message is checked against the IM
+// so a report cannot name a user who did not send it.
+func (o *Api) AddReport(c *gin.Context) {
+	req, err := a2r.ParseRequest[chatpb.AddReportReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if req.TargetType == constant.ReportTargetMessage {
+		imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		sendID, err := o.imApiCaller.MessageSender(mctx.WithApiToken(c, imToken), mctx.GetOpUserID(c), req.ConversationID, req.Seq)
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		if sendID != req.TargetUserID {
+			apiresp.GinError(c, errs.ErrArgs.WrapMsg("targetUserID is not the sender of the message"))
+			return
+		}
+	}
+	resp, err := o.chatClient.AddReport(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
 // ################## CALLBACK ##################
 
 func (o *Api) OpenIMCallback(c *gin.Context) {
@@ -307,10 +475,15 @@ func (o *Api) OpenIMCallback(c *gin.Context) {
 		Command: c.Query(constantpb.CallbackCommand),
 		Body:    string(body),
 	}
-	if _, err := o.chatClient.OpenIMCallback(c, req); err != nil {
+	resp, err := o.chatClient.OpenIMCallback(c, req)
+	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
+	if resp.Body != "" {
+		c.Data(http.StatusOK, "application/json", []byte(resp.Body))
+		return
+	}
 	apiresp.GinSuccess(c, nil)
 }
 
@@ -323,8 +496,14 @@ func (o *Api) SearchFriend(c *gin.Context) {
 		apiresp.GinError(c, err)
 		return
 	}
-	if req.UserID == "" {
-		req.UserID = mctx.GetOpUserID(c)
+	opUserID, userType, err := mctx.Check(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	// users search among their own friends only
+	if req.UserID == "" || userType == constant.NormalUser {
+		req.UserID = opUserID
 	}
 	imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
 	if err != nil {
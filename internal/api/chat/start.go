@@ -19,6 +19,7 @@ import (
 	disetcd "github.com/openimsdk/chat/pkg/common/kdisc/etcd"
 	adminclient "github.com/openimsdk/chat/pkg/protocol/admin"
 	chatclient "github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/discovery/etcd"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/mw"
@@ -30,9 +31,10 @@ import (
 )
 
 type Config struct {
-	ApiConfig config.API
-	Discovery config.Discovery
-	Share     config.Share
+	ApiConfig   config.API
+	Discovery   config.Discovery
+	Share       config.Share
+	RedisConfig config.Redis
 
 	RuntimeEnv string
 }
@@ -73,6 +75,17 @@ func Start(ctx context.Context, index int, cfg *Config) error {
 	gin.SetMode(gin.ReleaseMode)
 	engine := gin.New()
 	engine.Use(gin.Recovery(), mw.CorsHandler(), mw.GinParseOperationID())
+	if cfg.ApiConfig.RateLimit.Enable {
+		rdb, err := redisutil.NewRedisClient(ctx, cfg.RedisConfig.Build())
+		if err != nil {
+			return err
+		}
+		limiter, err := mwApi.NewRateLimiter(cfg.ApiConfig.RateLimit, rdb, base.GetClientIP)
+		if err != nil {
+			return err
+		}
+		engine.Use(limiter.Handle)
+	}
 	SetChatRoute(engine, adminApi, mwApi)
 
 	var (
@@ -92,6 +105,7 @@ func Start(ctx context.Context, index int, cfg *Config) error {
 			[]string{
 				config.ChatAPIChatCfgFileName,
 				config.DiscoveryConfigFileName,
+				config.RedisConfigFileName,
 				config.ShareFileName,
 				config.LogConfigFileName,
 			},
@@ -125,13 +139,29 @@ func Start(ctx context.Context, index int, cfg *Config) error {
 }
 
 func SetChatRoute(router gin.IRouter, chat *Api, mw *chatmw.MW) {
+	router.GET("/.well-known/jwks.json", chat.JWKS(chat.adminClient)) // Public keys verifying chat tokens
+
 	account := router.Group("/account")
-	account.POST("/code/send", chat.SendVerifyCode)                      // Send verification code
-	account.POST("/code/verify", chat.VerifyCode)                        // Verify the verification code
-	account.POST("/register", mw.CheckAdminOrNil, chat.RegisterUser)     // Register
-	account.POST("/login", chat.Login)                                   // Login
-	account.POST("/password/reset", chat.ResetPassword)                  // Forgot password
-	account.POST("/password/change", mw.CheckToken, chat.ChangePassword) // Change password
+	account.POST("/captcha", chat.GetCaptcha)                                                    // Get a captcha challenge
+	account.POST("/code/send", chat.SendVerifyCode)                                              // Send verification code
+	account.POST("/code/verify", chat.VerifyCode)                                                // Verify the verification code
+	account.POST("/register", mw.CheckAdminOrNil, chat.RegisterUser)                             // Register
+	account.POST("/login", chat.Login)                                                           // Login
+	account.POST("/login/totp", chat.LoginTOTP)                                                  // Complete login with the TOTP code
+	account.POST("/password/reset", chat.ResetPassword)                                          // Forgot password
+	account.POST("/password/change", mw.CheckToken, mw.RefuseImpersonation, chat.ChangePassword) // Change password
+	account.POST("/token/refresh", chat.RefreshToken)                                            // Exchange a refresh token for a new chat token
+
+	totp := account.Group("/totp", mw.CheckToken, mw.RefuseImpersonation)
+	totp.POST("/enroll", chat.EnrollTOTP)                                     // Generate a TOTP secret
+	totp.POST("/confirm", chat.ConfirmTOTP)                                   // Enable TOTP with the first code
+	totp.POST("/disable", chat.DisableTOTP)                                   // Disable TOTP
+	totp.POST("/recovery_codes/regenerate", chat.RegenerateTOTPRecoveryCodes) // Replace the recovery codes
+	totp.POST("/status", chat.GetTOTPStatus)                                  // Get TOTP status
+
+	session := account.Group("/session", mw.CheckToken)
+	session.POST("/list", chat.GetSessions)     // List signed-in devices
+	session.POST("/revoke", chat.RevokeSession) // Sign out a device
 
 	user := router.Group("/user", mw.CheckToken)
 	user.POST("/update", chat.UpdateUserInfo)                 // Edit personal information
@@ -147,6 +177,13 @@ func SetChatRoute(router gin.IRouter, chat *Api, mw *chatmw.MW) {
 
 	router.Group("/client_config").POST("/get", chat.GetClientConfig) // Get client initialization configuration
 
+	// Appeals against a block, with the ticket in errDlt of a login refused with AccountBlocked
+	appeal := router.Group("/appeal")
+	appeal.POST("/get", chat.GetAppeal)       // Get the block and the appeal status
+	appeal.POST("/submit", chat.SubmitAppeal) // Submit the appeal
+
+	router.Group("/report", mw.CheckToken).POST("/add", chat.AddReport) // Report a user, group or message
+
 	applicationGroup := router.Group("application")
 	applicationGroup.POST("/latest_version", chat.LatestApplicationVersion)
 	applicationGroup.POST("/page_versions", chat.PageApplicationVersion)
@@ -0,0 +1,181 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mw
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	constantpb "github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/apiresp"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+const (
+	auditMaxBody     = 64 * 1024
+	auditMaxResponse = 4 * 1024
+)
+
+// auditReadOnly are the last path segments of routes that change nothing.
+var auditReadOnly = []string{
+	"search", "get", "find", "info", "list", "permissions", "export", "verify",
+	"get_config", "get_config_list", "get_enable_config_manager",
+	"latest_version", "page_versions", "new_user_count", "login_user_count",
+}
+
+// auditSkip are routes without an operator, or whose body is credentials only.
+var auditSkip = []string{"/account/login", "/account/login/totp"}
+
+// auditTargetKeys are the request fields naming what an operation acts on.
+var auditTargetKeys = []string{
+	"userID", "userIDs", "account", "roleID", "roleIDs", "groupIDs", "ips", "forbiddens",
+	"limits", "rules", "codes", "keys", "configName", "id",
+}
+
+type auditWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *auditWriter) Write(b []byte) (int, error) {
+	if room := auditMaxResponse - w.body.Len(); room > 0 {
+		w.body.Write(b[:min(room, len(b))])
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+const (
+	auditOperationKey = "auditOperation"
+	auditRecordedKey  = "auditRecorded"
+)
+
+// Audit prepares an audit entry for every admin operation. CheckAdmin and CheckPermission record
+// it before the handler runs and refuse the request when it cannot be recorded, the result is
+// added once the handler returns. clientIP resolves the address recorded with the entry.
+func (o *MW) Audit(clientIP func(c *gin.Context) (string, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodPost || datautil.Contain(c.Request.URL.Path, auditSkip...) ||
+			datautil.Contain(path.Base(c.Request.URL.Path), auditReadOnly...) {
+			return
+		}
+		var body []byte
+		if strings.HasPrefix(c.ContentType(), "application/json") {
+			var err error
+			body, err = io.ReadAll(io.LimitReader(c.Request.Body, auditMaxBody))
+			if err != nil {
+				c.Abort()
+				apiresp.GinError(c, errs.ErrArgs.WrapMsg("read request body failed", "err", err.Error()))
+				return
+			}
+			c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), c.Request.Body))
+		}
+		op := &admin.AuditOperation{
+			Action:      c.Request.URL.Path,
+			OperationID: c.GetHeader(constantpb.OperationID),
+		}
+		op.Target, op.Diff = auditRequest(body)
+		op.Ip, _ = clientIP(c)
+		c.Set(auditOperationKey, op)
+		w := &auditWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		c.Next()
+		if !c.GetBool(auditRecordedKey) {
+			return
+		}
+		req := &admin.AddAuditLogReq{OperationID: op.OperationID}
+		req.ErrCode, req.ErrMsg = auditResult(w.body.Bytes())
+		if _, err := o.client.AddAuditLog(context.WithoutCancel(c), req); err != nil {
+			log.ZError(c, "add audit log result failed", err, "action", op.Action, "target", op.Target)
+		}
+	}
+}
+
+// auditOperation returns the entry Audit prepared for the request, nil if it is not audited.
+func auditOperation(c *gin.Context) *admin.AuditOperation {
+	v, _ := c.Get(auditOperationKey)
+	op, _ := v.(*admin.AuditOperation)
+	return op
+}
+
+// auditResult returns the error code and message of an API response.
+func auditResult(body []byte) (int32, string) {
+	var resp struct {
+		ErrCode int32  `json:"errCode"`
+		ErrMsg  string `json:"errMsg"`
+	}
+	_ = json.Unmarshal(body, &resp)
+	return resp.ErrCode, resp.ErrMsg
+}
+
+// auditRequest returns the target of a JSON request body and the body with secrets redacted.
+// A body that is not a JSON object cannot be redacted, only its size and hash are kept.
+func auditRequest(body []byte) (string, string) {
+	if len(body) == 0 {
+		return "", ""
+	}
+	var m map[string]any
+	if err := json.Unmarshal(body, &m); err != nil {
+		return "", fmt.Sprintf("unparsed body, %d bytes, sha256 %x", len(body), sha256.Sum256(body))
+	}
+	redact(m)
+	var targets []string
+	for _, key := range auditTargetKeys {
+		if v, ok := m[key]; ok {
+			data, _ := json.Marshal(v)
+			targets = append(targets, fmt.Sprintf("%s=%s", key, data))
+		}
+	}
+	diff, _ := json.Marshal(m)
+	return strings.Join(targets, " "), string(diff)
+}
+
+func redact(v any) {
+	switch t := v.(type) {
+	case map[string]any:
+		for key, val := range t {
+			if secretKey(key) {
+				t[key] = "***"
+				continue
+			}
+			redact(val)
+		}
+	case []any:
+		for _, val := range t {
+			redact(val)
+		}
+	}
+}
+
+func secretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range []string{"password", "secret", "token", "ticket"} {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return datautil.Contain(key, "code", "verifycode", "recoverycode", "totpcode")
+}
@@ -0,0 +1,153 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mw
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	constantpb "github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/apiresp"
+	"github.com/openimsdk/tools/errs"
+	"google.golang.org/grpc"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// auditClient accepts the token "t" of admin a1 and records the audit calls.
+type auditClient struct {
+	admin.AdminClient
+	auditErr error
+	checks   []*admin.CheckAdminPermissionReq
+	results  []*admin.AddAuditLogReq
+}
+
+func (c *auditClient) ParseToken(ctx context.Context, req *admin.ParseTokenReq, opts ...grpc.CallOption) (*admin.ParseTokenResp, error) {
+	return &admin.ParseTokenResp{UserID: "a1", UserType: constant.AdminUser}, nil
+}
+
+func (c *auditClient) GetUserToken(ctx context.Context, req *admin.GetUserTokenReq, opts ...grpc.CallOption) (*admin.GetUserTokenResp, error) {
+	return &admin.GetUserTokenResp{TokensMap: map[string]int32{"t": constantpb.NormalToken}}, nil
+}
+
+func (c *auditClient) CheckAdminPermission(ctx context.Context, req *admin.CheckAdminPermissionReq, opts ...grpc.CallOption) (*admin.CheckAdminPermissionResp, error) {
+	c.checks = append(c.checks, req)
+	if req.Audit != nil && c.auditErr != nil {
+		return nil, c.auditErr
+	}
+	return &admin.CheckAdminPermissionResp{UserID: "a1"}, nil
+}
+
+func (c *auditClient) AddAuditLog(ctx context.Context, req *admin.AddAuditLogReq, opts ...grpc.CallOption) (*admin.AddAuditLogResp, error) {
+	c.results = append(c.results, req)
+	return &admin.AddAuditLogResp{}, nil
+}
+
+func auditEngine(client *auditClient, handled *bool) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	o := New(client)
+	engine := gin.New()
+	engine.Use(o.Audit(func(c *gin.Context) (string, error) { return "10.0.0.1", nil }))
+	handler := func(c *gin.Context) {
+		*handled = true
+		apiresp.GinError(c, errs.ErrArgs.WrapMsg("bad user"))
+	}
+	engine.POST("/user/block", o.CheckPermission(constant.PermUsersBlock), handler)
+	engine.POST("/user/search", o.CheckPermission(constant.PermUsersRead), handler)
+	engine.POST("/client_config/set", o.CheckAdmin, handler)
+	return engine
+}
+
+func auditPost(engine *gin.Engine, path string) *httptest.ResponseRecorder {
+	return auditPostBody(engine, path, `{"userID":"u1","password":"secret"}`)
+}
+
+func auditPostBody(engine *gin.Engine, path string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("token", "t")
+	req.Header.Set(constantpb.OperationID, "op1")
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+	return w
+}
+
+func TestAuditRecordsBeforeHandler(t *testing.T) {
+	for _, route := range []string{"/user/block", "/client_config/set"} {
+		client := &auditClient{}
+		var handled bool
+		auditPost(auditEngine(client, &handled), route)
+		if !handled {
+			t.Fatalf("%s: handler did not run", route)
+		}
+		if len(client.checks) != 1 || client.checks[0].Audit == nil {
+			t.Fatalf("%s: operation not recorded by the permission check: %+v", route, client.checks)
+		}
+		op := client.checks[0].Audit
+		if op.Action != route || op.Target != `userID="u1"` || op.Ip != "10.0.0.1" || op.OperationID != "op1" {
+			t.Fatalf("%s: operation = %+v", route, op)
+		}
+		if strings.Contains(op.Diff, "secret") {
+			t.Fatalf("%s: password not redacted: %s", route, op.Diff)
+		}
+		if len(client.results) != 1 || client.results[0].OperationID != "op1" || client.results[0].ErrCode != errs.ArgsError {
+			t.Fatalf("%s: result = %+v", route, client.results)
+		}
+	}
+}
+
+func TestAuditHidesUnparsedBody(t *testing.T) {
+	client := &auditClient{}
+	var handled bool
+	auditPostBody(auditEngine(client, &handled), "/user/block", `{"userID":"u1","password":"secret"`)
+	if len(client.checks) != 1 || client.checks[0].Audit == nil {
+		t.Fatalf("operation not recorded by the permission check: %+v", client.checks)
+	}
+	op := client.checks[0].Audit
+	if strings.Contains(op.Diff, "secret") || !strings.HasPrefix(op.Diff, "unparsed body, 34 bytes, sha256 ") {
+		t.Fatalf("unparsed body stored as %q", op.Diff)
+	}
+}
+
+func TestAuditRefusesWhenUnrecorded(t *testing.T) {
+	for _, route := range []string{"/user/block", "/client_config/set"} {
+		client := &auditClient{auditErr: errs.ErrInternalServer.WrapMsg("audit log unavailable")}
+		var handled bool
+		w := auditPost(auditEngine(client, &handled), route)
+		if handled {
+			t.Fatalf("%s: handler ran without an audit entry", route)
+		}
+		if !strings.Contains(w.Body.String(), `"errCode":500`) {
+			t.Fatalf("%s: response = %s", route, w.Body.String())
+		}
+		if len(client.results) != 0 {
+			t.Fatalf("%s: result recorded for a refused request", route)
+		}
+	}
+}
+
+func TestAuditSkipsReadOnly(t *testing.T) {
+	client := &auditClient{auditErr: errs.ErrInternalServer.WrapMsg("audit log unavailable")}
+	var handled bool
+	auditPost(auditEngine(client, &handled), "/user/search")
+	if !handled || len(client.checks) != 1 || client.checks[0].Audit != nil || len(client.results) != 0 {
+		t.Fatalf("read-only route audited: handled = %v, checks = %+v", handled, client.checks)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	constantpb "github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/apiresp"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
 )
 
 func New(client admin.AdminClient) *MW {
@@ -33,27 +34,27 @@ type MW struct {
 	client admin.AdminClient
 }
 
-func (o *MW) parseToken(c *gin.Context) (string, int32, string, error) {
+func (o *MW) parseToken(c *gin.Context) (*admin.ParseTokenResp, string, error) {
 	token := c.GetHeader("token")
 	if token == "" {
-		return "", 0, "", errs.ErrArgs.WrapMsg("token is empty")
+		return nil, "", errs.ErrArgs.WrapMsg("token is empty")
 	}
 	resp, err := o.client.ParseToken(c, &admin.ParseTokenReq{Token: token})
 	if err != nil {
-		return "", 0, "", err
+		return nil, "", err
 	}
-	return resp.UserID, resp.UserType, token, nil
+	return resp, token, nil
 }
 
-func (o *MW) parseTokenType(c *gin.Context, userType int32) (string, string, error) {
-	userID, t, token, err := o.parseToken(c)
+func (o *MW) parseTokenType(c *gin.Context, userType int32) (*admin.ParseTokenResp, string, error) {
+	resp, token, err := o.parseToken(c)
 	if err != nil {
-		return "", "", err
+		return nil, "", err
 	}
-	if t != userType {
-		return "", "", errs.ErrArgs.WrapMsg("token type error")
+	if resp.UserType != userType {
+		return nil, "", errs.ErrArgs.WrapMsg("token type error")
 	}
-	return userID, token, nil
+	return resp, token, nil
 }
 
 func (o *MW) isValidToken(c *gin.Context, userID string, token string) error {
@@ -82,59 +83,113 @@ func (o *MW) setToken(c *gin.Context, userID string, userType int32) {
 	SetToken(c, userID, userType)
 }
 
+// CheckToken accepts user and admin tokens. For a token an admin uses to act as the user,
+// the admin user ID is kept in the context, see Impersonator.
 func (o *MW) CheckToken(c *gin.Context) {
-	userID, userType, token, err := o.parseToken(c)
+	resp, token, err := o.parseToken(c)
 	if err != nil {
 		c.Abort()
 		apiresp.GinError(c, err)
 		return
 	}
-	if err := o.isValidToken(c, userID, token); err != nil {
+	if err := o.isValidToken(c, resp.UserID, token); err != nil {
 		c.Abort()
 		apiresp.GinError(c, err)
 		return
 	}
-	o.setToken(c, userID, userType)
+	o.setToken(c, resp.UserID, resp.UserType)
+	if resp.Impersonator != "" {
+		SetImpersonator(c, resp.Impersonator)
+	}
+}
+
+// RefuseImpersonation aborts requests made with a token an admin uses to act as the user.
+// It goes after CheckToken or CheckUser on routes the user must do in person.
+func (o *MW) RefuseImpersonation(c *gin.Context) {
+	if impersonator := Impersonator(c); impersonator != "" {
+		c.Abort()
+		apiresp.GinError(c, errs.ErrNoPermission.WrapMsg("not allowed while an admin acts as the user"))
+		return
+	}
 }
 
+// CheckAdmin requires an admin token, an audited operation is recorded before it runs.
 func (o *MW) CheckAdmin(c *gin.Context) {
-	userID, token, err := o.parseTokenType(c, constant.AdminUser)
+	o.checkAdminToken(c)
+	if c.IsAborted() {
+		return
+	}
+	if op := auditOperation(c); op != nil {
+		o.checkAdminPermission(c, nil, op)
+	}
+}
+
+func (o *MW) checkAdminToken(c *gin.Context) {
+	resp, token, err := o.parseTokenType(c, constant.AdminUser)
 	if err != nil {
 		c.Abort()
 		apiresp.GinError(c, err)
 		return
 	}
-	if err := o.isValidToken(c, userID, token); err != nil {
+	if err := o.isValidToken(c, resp.UserID, token); err != nil {
+		c.Abort()
+		apiresp.GinError(c, err)
+		return
+	}
+	o.setToken(c, resp.UserID, constant.AdminUser)
+}
+
+// CheckPermission returns a handler that requires an admin token holding any of the permissions.
+// An audited operation is recorded before it runs.
+func (o *MW) CheckPermission(permissions ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		o.checkAdminToken(c)
+		if c.IsAborted() {
+			return
+		}
+		o.checkAdminPermission(c, permissions, auditOperation(c))
+	}
+}
+
+// checkAdminPermission checks the permissions and records op, the request is refused when op
+// cannot be recorded.
+func (o *MW) checkAdminPermission(c *gin.Context, permissions []string, op *admin.AuditOperation) {
+	if _, err := o.client.CheckAdminPermission(c, &admin.CheckAdminPermissionReq{Permissions: permissions, Audit: op}); err != nil {
 		c.Abort()
 		apiresp.GinError(c, err)
 		return
 	}
-	o.setToken(c, userID, constant.AdminUser)
+	if op != nil {
+		c.Set(auditRecordedKey, true)
+	}
 }
 
 func (o *MW) CheckUser(c *gin.Context) {
-	userID, token, err := o.parseTokenType(c, constant.NormalUser)
+	resp, token, err := o.parseTokenType(c, constant.NormalUser)
 	if err != nil {
 		c.Abort()
 		apiresp.GinError(c, err)
 		return
 	}
-	if err := o.isValidToken(c, userID, token); err != nil {
+	if err := o.isValidToken(c, resp.UserID, token); err != nil {
 		c.Abort()
 		apiresp.GinError(c, err)
 		return
 	}
-	o.setToken(c, userID, constant.NormalUser)
+	o.setToken(c, resp.UserID, constant.NormalUser)
+	if resp.Impersonator != "" {
+		SetImpersonator(c, resp.Impersonator)
+	}
 }
 
 func (o *MW) CheckAdminOrNil(c *gin.Context) {
 	defer c.Next()
-	userID, userType, _, err := o.parseToken(c)
+	resp, _, err := o.parseToken(c)
 	if err != nil {
 		return
 	}
-	if userType == constant.AdminUser {
-		o.setToken(c, userID, constant.AdminUser)
+	if resp.UserType == constant.AdminUser {
+		o.setToken(c, resp.UserID, constant.AdminUser)
 	}
 }
 
@@ -143,3 +198,26 @@ func SetToken(c *gin.Context, userID string, userType int32) {
 	c.Set(constant.RpcOpUserType, []string{strconv.Itoa(int(userType))})
 	c.Set(constant.RpcCustomHeader, []string{constant.RpcOpUserType})
 }
+
+// SetImpersonator marks the request as made by an admin acting as the user, logs it and passes the admin user ID
+// to the RPCs.
+func SetImpersonator(c *gin.Context, impersonator string) {
+	c.Set(constant.RpcImpersonator, []string{impersonator})
+	headers, _ := c.Value(constant.RpcCustomHeader).([]string)
+	c.Set(constant.RpcCustomHeader, append(headers, constant.RpcImpersonator))
+	logImpersonated(c, impersonator)
+}
+
+// logImpersonated records each request made with an impersonation token, the operationID ties it to the RPC logs.
+var logImpersonated = func(c *gin.Context, impersonator string) {
+	log.ZInfo(c, "impersonated request", "impersonator", impersonator, "userID", c.GetString(constant.RpcOpUserID),
+		"method", c.Request.Method, "uri", c.Request.RequestURI)
+}
+
+// Impersonator returns the admin user ID of a request made with a token an admin uses to act as the user.
+func Impersonator(c *gin.Context) string {
+	if arr := c.GetStringSlice(constant.RpcImpersonator); len(arr) > 0 {
+		return arr[0]
+	}
+	return ""
+}
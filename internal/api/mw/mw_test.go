@@ -0,0 +1,81 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mw
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	constantpb "github.com/openimsdk/protocol/constant"
+	"google.golang.org/grpc"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// userClient accepts any token as one of user u1, made by impersonator when set.
+type userClient struct {
+	admin.AdminClient
+	impersonator string
+}
+
+func (c *userClient) ParseToken(ctx context.Context, req *admin.ParseTokenReq, opts ...grpc.CallOption) (*admin.ParseTokenResp, error) {
+	return &admin.ParseTokenResp{UserID: "u1", UserType: constant.NormalUser, Impersonator: c.impersonator}, nil
+}
+
+func (c *userClient) GetUserToken(ctx context.Context, req *admin.GetUserTokenReq, opts ...grpc.CallOption) (*admin.GetUserTokenResp, error) {
+	return &admin.GetUserTokenResp{TokensMap: map[string]int32{"t": constantpb.NormalToken}}, nil
+}
+
+func TestImpersonatedRequestsLogged(t *testing.T) {
+	type entry struct{ impersonator, userID, uri string }
+	var logged []entry
+	defer func(f func(c *gin.Context, impersonator string)) { logImpersonated = f }(logImpersonated)
+	logImpersonated = func(c *gin.Context, impersonator string) {
+		logged = append(logged, entry{impersonator, c.GetString(constant.RpcOpUserID), c.Request.RequestURI})
+	}
+
+	gin.SetMode(gin.TestMode)
+	for _, impersonator := range []string{"", "a1"} {
+		logged = nil
+		o := New(&userClient{impersonator: impersonator})
+		engine := gin.New()
+		var impersonated []string
+		handler := func(c *gin.Context) { impersonated = append(impersonated, Impersonator(c)) }
+		engine.POST("/token", o.CheckToken, handler)
+		engine.POST("/user", o.CheckUser, handler)
+		for _, path := range []string{"/token", "/user"} {
+			req := httptest.NewRequest(http.MethodPost, path, nil)
+			req.Header.Set("token", "t")
+			engine.ServeHTTP(httptest.NewRecorder(), req)
+		}
+		if impersonator == "" {
+			if len(logged) != 0 {
+				t.Fatalf("plain requests logged as impersonated: %v", logged)
+			}
+			continue
+		}
+		want := []entry{{"a1", "u1", "/token"}, {"a1", "u1", "/user"}}
+		if len(logged) != len(want) || logged[0] != want[0] || logged[1] != want[1] {
+			t.Fatalf("logged %v, want %v", logged, want)
+		}
+		if len(impersonated) != 2 || impersonated[0] != "a1" || impersonated[1] != "a1" {
+			t.Fatalf("impersonator in context = %v", impersonated)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mw
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/tools/apiresp"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/eerrs"
+)
+
+const (
+	RateLimitKeyIP   = "ip"
+	RateLimitKeyUser = "user"
+	RateLimitKeyBoth = "both"
+)
+
+type rateLimitPolicy struct {
+	path   string
+	prefix bool
+	key    string
+	rate   float64
+	burst  int
+}
+
+type RateLimiter struct {
+	mw       *MW
+	limiter  cache.RateLimitInterface
+	clientIP func(c *gin.Context) (string, error)
+	policies []*rateLimitPolicy
+}
+
+// NewRateLimiter builds the per-route limits of conf; clientIP resolves the address IP keys use.
+func (o *MW) NewRateLimiter(conf config.RateLimit, rdb redis.UniversalClient, clientIP func(c *gin.Context) (string, error)) (*RateLimiter, error) {
+	r := &RateLimiter{
+		mw:       o,
+		limiter:  cache.NewRateLimitInterface(rdb),
+		clientIP: clientIP,
+	}
+	for _, route := range conf.Routes {
+		switch route.Key {
+		case RateLimitKeyIP, RateLimitKeyUser, RateLimitKeyBoth:
+		default:
+			return nil, errs.New("invalid rate limit key", "path", route.Path, "key", route.Key)
+		}
+		if route.Rate <= 0 || route.Burst <= 0 {
+			return nil, errs.New("rate limit rate and burst must be positive", "path", route.Path)
+		}
+		policy := &rateLimitPolicy{
+			path:  route.Path,
+			key:   route.Key,
+			rate:  route.Rate,
+			burst: route.Burst,
+		}
+		if strings.HasSuffix(route.Path, "/*") {
+			policy.path = strings.TrimSuffix(route.Path, "*")
+			policy.prefix = true
+		}
+		r.policies = append(r.policies, policy)
+	}
+	return r, nil
+}
+
+func (r *RateLimiter) match(path string) *rateLimitPolicy {
+	for _, policy := range r.policies {
+		if policy.path == path || (policy.prefix && strings.HasPrefix(path, policy.path)) {
+			return policy
+		}
+	}
+	return nil
+}
+
+// keys returns the buckets a request of policy takes from. Requests without a valid
+// token fall back to their IP for user keys.
+func (r *RateLimiter) keys(c *gin.Context, policy *rateLimitPolicy) ([]string, error) {
+	ip, err := r.clientIP(c)
+	if err != nil {
+		return nil, err
+	}
+	if policy.key == RateLimitKeyIP {
+		return []string{"ip:" + ip}, nil
+	}
+	resp, _, err := r.mw.parseToken(c)
+	if err != nil {
+		return []string{"ip:" + ip}, nil
+	}
+	if policy.key == RateLimitKeyUser {
+		return []string{"user:" + resp.UserID}, nil
+	}
+	return []string{"ip:" + ip, "user:" + resp.UserID}, nil
+}
+
+func (r *RateLimiter) Handle(c *gin.Context) {
+	policy := r.match(c.Request.URL.Path)
+	if policy == nil {
+		return
+	}
+	keys, err := r.keys(c, policy)
+	if err != nil {
+		c.Abort()
+		apiresp.GinError(c, err)
+		return
+	}
+	var (
+		wait  time.Duration
+		taken []string
+	)
+	for _, key := range keys {
+		d, err := r.limiter.Take(c, policy.path+":"+key, policy.rate, policy.burst)
+		if err != nil {
+			// let the request through rather than failing the API while redis is unavailable
+			log.ZError(c, "rate limit take failed", err, "path", policy.path, "key", key)
+			continue
+		}
+		if d > 0 {
+			wait = d
+			break
+		}
+		taken = append(taken, key)
+	}
+	if wait > 0 {
+		// a refused request costs none of its buckets
+		for _, key := range taken {
+			if err := r.limiter.Refund(c, policy.path+":"+key, policy.burst); err != nil {
+				log.ZError(c, "rate limit refund failed", err, "path", policy.path, "key", key)
+			}
+		}
+		c.Header("Retry-After", eerrs.RetrySeconds(wait))
+		c.Abort()
+		apiresp.GinError(c, eerrs.TooManyRequests(wait))
+	}
+}
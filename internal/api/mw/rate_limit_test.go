@@ -0,0 +1,109 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mw
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/openimsdk/chat/pkg/common/config"
+)
+
+// memLimiter holds buckets that do not refill.
+type memLimiter struct {
+	tokens map[string]int
+}
+
+func (l *memLimiter) Take(ctx context.Context, key string, rate float64, burst int) (time.Duration, error) {
+	if _, ok := l.tokens[key]; !ok {
+		l.tokens[key] = burst
+	}
+	if l.tokens[key] == 0 {
+		return time.Second, nil
+	}
+	l.tokens[key]--
+	return 0, nil
+}
+
+func (l *memLimiter) Refund(ctx context.Context, key string, burst int) error {
+	l.tokens[key] = min(burst, l.tokens[key]+1)
+	return nil
+}
+
+func TestRateLimiter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	conf := config.RateLimit{Routes: []config.RateLimitRoute{
+		{Path: "/both", Key: RateLimitKeyBoth, Rate: 1, Burst: 2},
+		{Path: "/ip/*", Key: RateLimitKeyIP, Rate: 1, Burst: 1},
+	}}
+	limiter := &memLimiter{tokens: make(map[string]int)}
+	var ip string
+	r, err := New(&userClient{}).NewRateLimiter(conf, nil, func(c *gin.Context) (string, error) { return ip, nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.limiter = limiter
+	engine := gin.New()
+	engine.Use(r.Handle)
+	engine.POST("/*path", func(c *gin.Context) {})
+	post := func(path string, token bool) int {
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+		if token {
+			req.Header.Set("token", "t")
+		}
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+		if w.Header().Get("Retry-After") != "" {
+			return http.StatusTooManyRequests
+		}
+		return http.StatusOK
+	}
+
+	ip = "10.0.0.1"
+	for i, want := range []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests} {
+		if got := post("/both", true); got != want {
+			t.Fatalf("request %d of u1 from %s: %d, want %d", i, ip, got, want)
+		}
+	}
+	// u1 has no tokens left: their requests from another address are refused without spending its bucket
+	ip = "10.0.0.2"
+	for i := 0; i < 3; i++ {
+		if got := post("/both", true); got != http.StatusTooManyRequests {
+			t.Fatalf("request %d of u1 from %s: %d, want refused", i, ip, got)
+		}
+	}
+	if got := limiter.tokens["/both:ip:10.0.0.2"]; got != 2 {
+		t.Fatalf("refused requests spent the ip bucket, %d tokens left", got)
+	}
+	if got := post("/both", false); got != http.StatusOK {
+		t.Fatalf("request without a token from %s: %d, want ok", ip, got)
+	}
+
+	// prefix routes share one bucket
+	if got := post("/ip/a", false); got != http.StatusOK {
+		t.Fatalf("first /ip request: %d", got)
+	}
+	if got := post("/ip/b", false); got != http.StatusTooManyRequests {
+		t.Fatalf("second /ip request: %d, want refused", got)
+	}
+	if got := post("/other", false); got != http.StatusOK {
+		t.Fatalf("unlimited route: %d", got)
+	}
+}
@@ -5,8 +5,13 @@ import (
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/tools/apiresp"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/idutil"
 	"net"
+	"net/http"
 )
 
 type Api struct {
@@ -37,3 +42,17 @@ func (o *Api) GetClientIP(c *gin.Context) (string, error) {
 func (o *Api) GetDefaultIMAdminUserID() string {
 	return o.ImUserID
 }
+
+// JWKS returns the handler serving the public keys of chat tokens; plain GET callers send no
+// operationID, so one is generated.
+func (o *Api) JWKS(client admin.AdminClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		resp, err := client.GetJWKS(mcontext.SetOperationID(c, idutil.OperationIDGenerator()), &admin.GetJWKSReq{})
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		c.Header("Cache-Control", "public, max-age=300")
+		c.Data(http.StatusOK, "application/json", []byte(resp.Jwks))
+	}
+}
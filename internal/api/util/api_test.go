@@ -0,0 +1,53 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/tools/mcontext"
+	"google.golang.org/grpc"
+
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+type jwksClient struct {
+	admin.AdminClient
+	operationID string
+}
+
+func (c *jwksClient) GetJWKS(ctx context.Context, req *admin.GetJWKSReq, opts ...grpc.CallOption) (*admin.GetJWKSResp, error) {
+	c.operationID = mcontext.GetOperationID(ctx)
+	return &admin.GetJWKSResp{Jwks: `{"keys":[]}`}, nil
+}
+
+func TestJWKS(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	client := &jwksClient{}
+	engine := gin.New()
+	engine.GET("/.well-known/jwks.json", (&Api{}).JWKS(client))
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+	if w.Code != http.StatusOK || w.Body.String() != `{"keys":[]}` {
+		t.Fatalf("response = %d %s", w.Code, w.Body.String())
+	}
+	if w.Header().Get("Cache-Control") == "" || client.operationID == "" {
+		t.Fatalf("cache control %q, operationID %q", w.Header().Get("Cache-Control"), client.operationID)
+	}
+}
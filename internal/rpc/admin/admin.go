@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/utils/datautil"
 
@@ -41,14 +42,19 @@ func (o *adminServer) GetAdminInfo(ctx context.Context, req *admin.GetAdminInfoR
 	if err != nil {
 		return nil, err
 	}
+	permissions, err := o.adminPermissions(ctx, a)
+	if err != nil {
+		return nil, err
+	}
 	return &admin.GetAdminInfoResp{
-		Account:    a.Account,
-		Password:   a.Password,
-		FaceURL:    a.FaceURL,
-		Nickname:   a.Nickname,
-		UserID:     a.UserID,
-		Level:      a.Level,
-		CreateTime: a.CreateTime.UnixMilli(),
+		Account:     a.Account,
+		FaceURL:     a.FaceURL,
+		Nickname:    a.Nickname,
+		UserID:      a.UserID,
+		Level:       a.Level,
+		CreateTime:  a.CreateTime.UnixMilli(),
+		RoleIDs:     a.RoleIDs,
+		Permissions: permissions,
 	}, nil
 }
 
@@ -58,33 +64,53 @@ func (o *adminServer) ChangeAdminPassword(ctx context.Context, req *admin.Change
 		return nil, err
 	}
 
-	if user.Password != req.CurrentPassword {
+	match, _, err := o.Passwd.Verify(user.Password, req.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+	if !match {
 		return nil, errs.ErrInternalServer.WrapMsg("password error")
 	}
-
-	if err := o.Database.ChangePassword(ctx, req.UserID, req.NewPassword); err != nil {
+	password, err := o.Passwd.Hash(req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.ChangePassword(ctx, req.UserID, password); err != nil {
 		return nil, err
 	}
 	return &admin.ChangeAdminPasswordResp{}, nil
 }
 
 func (o *adminServer) AddAdminAccount(ctx context.Context, req *admin.AddAdminAccountReq) (*admin.AddAdminAccountResp, error) {
-	if err := o.CheckSuperAdmin(ctx); err != nil {
+	opUserID, err := o.checkPermission(ctx, constant.PermAdminsManage)
+	if err != nil {
+		return nil, err
+	}
+	roleIDs := req.RoleIDs
+	if len(roleIDs) == 0 {
+		roleIDs = []string{constant.RoleAdmin}
+	}
+	if err := o.checkRoleIDs(ctx, opUserID, roleIDs); err != nil {
 		return nil, err
 	}
 
-	_, err := o.Database.GetAdmin(ctx, req.Account)
+	_, err = o.Database.GetAdmin(ctx, req.Account)
 	if err == nil {
 		return nil, errs.ErrDuplicateKey.WrapMsg("the account is registered")
 	}
+	password, err := o.Passwd.Hash(req.Password)
+	if err != nil {
+		return nil, err
+	}
 
 	adm := &admindb.Admin{
 		Account:    req.Account,
-		Password:   req.Password,
+		Password:   password,
 		FaceURL:    req.FaceURL,
 		Nickname:   req.Nickname,
 		UserID:     o.genUserID(),
 		Level:      80,
+		RoleIDs:    roleIDs,
 		CreateTime: time.Now(),
 	}
 	if err = o.Database.AddAdminAccount(ctx, []*admindb.Admin{adm}); err != nil {
@@ -94,7 +120,7 @@ func (o *adminServer) AddAdminAccount(ctx context.Context, req *admin.AddAdminAc
 }
 
 func (o *adminServer) DelAdminAccount(ctx context.Context, req *admin.DelAdminAccountReq) (*admin.DelAdminAccountResp, error) {
-	if err := o.CheckSuperAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermAdminsManage); err != nil {
 		return nil, err
 	}
 
@@ -119,7 +145,7 @@ func (o *adminServer) DelAdminAccount(ctx context.Context, req *admin.DelAdminAc
 }
 
 func (o *adminServer) SearchAdminAccount(ctx context.Context, req *admin.SearchAdminAccountReq) (*admin.SearchAdminAccountResp, error) {
-	if err := o.CheckSuperAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermAdminsManage); err != nil {
 		return nil, err
 	}
 
@@ -136,6 +162,7 @@ func (o *adminServer) SearchAdminAccount(ctx context.Context, req *admin.SearchA
 			UserID:     v.UserID,
 			Level:      v.Level,
 			CreateTime: v.CreateTime.Unix(),
+			RoleIDs:    v.RoleIDs,
 		}
 		accounts = append(accounts, temp)
 	}
@@ -151,6 +178,17 @@ func (o *adminServer) AdminUpdateInfo(ctx context.Context, req *admin.AdminUpdat
 	if err != nil {
 		return nil, err
 	}
+	if req.Level != nil {
+		if err := o.CheckSuperAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if req.Password != nil {
+		update["password"], err = o.Passwd.Hash(req.Password.Value)
+		if err != nil {
+			return nil, err
+		}
+	}
 	info, err := o.Database.GetAdminUserID(ctx, mcontext.GetOpUserID(ctx))
 	if err != nil {
 		return nil, err
@@ -173,17 +211,48 @@ func (o *adminServer) AdminUpdateInfo(ctx context.Context, req *admin.AdminUpdat
 }
 
 func (o *adminServer) Login(ctx context.Context, req *admin.LoginReq) (*admin.LoginResp, error) {
+	if err := o.LoginLock.Check(ctx, req.Account, req.Ip); err != nil {
+		return nil, err
+	}
 	a, err := o.Database.GetAdmin(ctx, req.Account)
 	if err != nil {
 		if dbutil.IsDBNotFound(err) {
-			return nil, eerrs.ErrAccountNotFound.Wrap()
+			return nil, o.LoginLock.Failed(ctx, "", req.Ip, eerrs.ErrAccountNotFound.Wrap())
+		}
+		return nil, err
+	}
+	match, rehash, err := o.Passwd.Verify(a.Password, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !match {
+		return nil, o.LoginLock.Failed(ctx, a.Account, req.Ip, eerrs.ErrPassword.Wrap())
+	}
+	o.LoginLock.Succeeded(ctx, a.Account)
+	if rehash {
+		if password, err := o.Passwd.Hash(req.Password); err == nil {
+			if err := o.Database.ChangePassword(ctx, a.UserID, password); err != nil {
+				log.ZError(ctx, "update rehashed admin password failed", err, "userID", a.UserID)
+			}
 		}
+	}
+	ticket, enroll, err := o.totpChallenge(ctx, a, req.Ip)
+	if err != nil {
 		return nil, err
 	}
-	if a.Password != req.Password {
-		return nil, eerrs.ErrPassword.Wrap()
+	if ticket != "" {
+		return &admin.LoginResp{
+			AdminUserID:        a.UserID,
+			AdminAccount:       a.Account,
+			TotpTicket:         ticket,
+			TotpEnrollRequired: enroll,
+		}, nil
 	}
-	adminToken, err := o.CreateToken(ctx, &admin.CreateTokenReq{UserID: a.UserID, UserType: constant.AdminUser})
+	return o.completeLogin(ctx, a, req.Ip)
+}
+
+func (o *adminServer) completeLogin(ctx context.Context, a *admindb.Admin, ip string) (*admin.LoginResp, error) {
+	adminToken, err := o.CreateToken(ctx, &admin.CreateTokenReq{UserID: a.UserID, UserType: constant.AdminUser, Ip: ip})
 	if err != nil {
 		return nil, err
 	}
@@ -202,7 +271,7 @@ func (o *adminServer) ChangePassword(ctx context.Context, req *admin.ChangePassw
 	if err != nil {
 		return nil, err
 	}
-	a, err := o.Database.GetAdmin(ctx, userID)
+	a, err := o.Database.GetAdminUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -210,6 +279,10 @@ func (o *adminServer) ChangePassword(ctx context.Context, req *admin.ChangePassw
 	if err != nil {
 		return nil, err
 	}
+	update["password"], err = o.Passwd.Hash(req.Password)
+	if err != nil {
+		return nil, err
+	}
 	if err := o.Database.UpdateAdmin(ctx, a.UserID, update); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,264 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// CreateAppealTicket is called by the chat rpc when a blocked user logged in with valid credentials,
+// the ticket is how the user proves ownership when appealing without a token.
+func (o *adminServer) CreateAppealTicket(ctx context.Context, req *admin.CreateAppealTicketReq) (*admin.CreateAppealTicketResp, error) {
+	block, err := o.activeBlock(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, errs.ErrArgs.WrapMsg("user not blocked")
+	}
+	ticket, err := randomToken(16)
+	if err != nil {
+		return nil, err
+	}
+	t := &cache.AppealTicket{UserID: req.UserID, BlockTime: block.CreateTime.UnixMilli()}
+	if err := o.Database.SetAppealTicket(ctx, ticket, t, o.AppealTicketExpire); err != nil {
+		return nil, err
+	}
+	return &admin.CreateAppealTicketResp{
+		Ticket:     ticket,
+		ExpireTime: time.Now().Add(o.AppealTicketExpire).UnixMilli(),
+	}, nil
+}
+
+// GetAppeal shows the block of a ticket and the appeal against it, if any. The block is not set once lifted.
+func (o *adminServer) GetAppeal(ctx context.Context, req *admin.GetAppealReq) (*admin.GetAppealResp, error) {
+	t, block, err := o.takeAppealTicket(ctx, req.Ticket)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.GetAppealResp{}
+	if block != nil {
+		resp.Block = &admin.BlockInfo{
+			UserID:     block.UserID,
+			Reason:     block.Reason,
+			CreateTime: block.CreateTime.UnixMilli(),
+			Category:   block.Category,
+			ExpireTime: expireTimeMilli(block.ExpireTime),
+		}
+	}
+	appeal, err := o.Database.TakeAppealByBlock(ctx, t.UserID, time.UnixMilli(t.BlockTime))
+	if err == nil {
+		// leave out who handles it
+		resp.Appeal = &admin.Appeal{
+			AppealID:    appeal.AppealID,
+			UserID:      appeal.UserID,
+			Content:     appeal.Content,
+			Attachments: appeal.Attachments,
+			Status:      appeal.Status,
+			Response:    appeal.Response,
+			BlockTime:   appeal.BlockTime.UnixMilli(),
+			CreateTime:  appeal.CreateTime.UnixMilli(),
+			HandleTime:  expireTimeMilli(appeal.HandleTime),
+		}
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (o *adminServer) SubmitAppeal(ctx context.Context, req *admin.SubmitAppealReq) (*admin.SubmitAppealResp, error) {
+	t, block, err := o.takeAppealTicket(ctx, req.Ticket)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, errs.ErrArgs.WrapMsg("block already lifted")
+	}
+	if _, err := o.Database.TakeAppealByBlock(ctx, t.UserID, block.CreateTime); err == nil {
+		return nil, errs.ErrDuplicateKey.WrapMsg("appeal already submitted")
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	appealID, err := randomToken(16)
+	if err != nil {
+		return nil, err
+	}
+	appeal := &admindb.Appeal{
+		AppealID:    appealID,
+		UserID:      t.UserID,
+		BlockTime:   block.CreateTime,
+		Content:     req.Content,
+		Attachments: req.Attachments,
+		Status:      constant.AppealStatusPending,
+		IP:          req.Ip,
+		CreateTime:  time.Now(),
+	}
+	if err := o.Database.SubmitAppeal(ctx, req.Ticket, appeal); err != nil {
+		return nil, err
+	}
+	log.ZInfo(ctx, "appeal submitted", "appealID", appealID, "userID", t.UserID)
+	return &admin.SubmitAppealResp{AppealID: appealID}, nil
+}
+
+func (o *adminServer) SearchAppeal(ctx context.Context, req *admin.SearchAppealReq) (*admin.SearchAppealResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermUsersRead); err != nil {
+		return nil, err
+	}
+	total, appeals, err := o.Database.SearchAppeal(ctx, req.Keyword, req.Status, req.AssigneeUserID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.SearchAppealResp{
+		Total:   uint32(total),
+		Appeals: make([]*admin.Appeal, 0, len(appeals)),
+	}
+	for _, appeal := range appeals {
+		resp.Appeals = append(resp.Appeals, toPbAppeal(appeal))
+	}
+	return resp, nil
+}
+
+func (o *adminServer) AssignAppeal(ctx context.Context, req *admin.AssignAppealReq) (*admin.AssignAppealResp, error) {
+	opUserID, err := o.checkPermission(ctx, constant.PermUsersBlock)
+	if err != nil {
+		return nil, err
+	}
+	assignee := req.AssigneeUserID
+	if assignee == "" {
+		assignee = opUserID
+	} else if _, err := o.Database.GetAdminUserID(ctx, assignee); err != nil {
+		return nil, err
+	}
+	if err := o.Database.AssignAppeal(ctx, req.AppealIDs, assignee); err != nil {
+		return nil, err
+	}
+	return &admin.AssignAppealResp{}, nil
+}
+
+// HandleAppeal accepts or rejects a pending appeal and emails the response to the user.
+// Accepting lifts the block appealed against, unless it was already lifted or replaced by a newer one.
+func (o *adminServer) HandleAppeal(ctx context.Context, req *admin.HandleAppealReq) (*admin.HandleAppealResp, error) {
+	opUserID, err := o.checkPermission(ctx, constant.PermUsersBlock)
+	if err != nil {
+		return nil, err
+	}
+	appeal, err := o.Database.TakeAppeal(ctx, req.AppealID)
+	if err != nil {
+		return nil, err
+	}
+	if appeal.Status != constant.AppealStatusPending {
+		return nil, errs.ErrArgs.WrapMsg("appeal already handled")
+	}
+	status := int32(constant.AppealStatusRejected)
+	var unblock *admindb.ForbiddenAccount
+	if req.Accept {
+		status = constant.AppealStatusAccepted
+		block, err := o.Database.GetBlockInfo(ctx, appeal.UserID)
+		if err == nil {
+			if block.CreateTime.UnixMilli() == appeal.BlockTime.UnixMilli() {
+				unblock = block
+			}
+		} else if !dbutil.IsDBNotFound(err) {
+			return nil, err
+		}
+	}
+	update := map[string]any{
+		"status":          status,
+		"response":        req.Response,
+		"handler_user_id": opUserID,
+		"handle_time":     time.Now(),
+	}
+	handled, err := o.Database.HandleAppeal(ctx, appeal.AppealID, update, unblock, opUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !handled {
+		return nil, errs.ErrArgs.WrapMsg("appeal already handled")
+	}
+	log.ZInfo(ctx, "appeal handled", "appealID", appeal.AppealID, "userID", appeal.UserID, "accept", req.Accept, "unblocked", unblock != nil)
+	if unblock != nil {
+		if err := o.unblockInIM(ctx, appeal.UserID); err != nil {
+			return nil, err
+		}
+	}
+	go func(ctx context.Context) {
+		if err := o.Chat.NotifyAppealResult(ctx, appeal.UserID, req.Accept, req.Response); err != nil {
+			log.ZError(ctx, "notify appeal result failed", err, "userID", appeal.UserID)
+		}
+	}(context.WithoutCancel(ctx))
+	return &admin.HandleAppealResp{Unblocked: unblock != nil, UserID: appeal.UserID}, nil
+}
+
+// activeBlock returns the block of userID, or nil if there is none or it has expired.
+func (o *adminServer) activeBlock(ctx context.Context, userID string) (*admindb.ForbiddenAccount, error) {
+	block, err := o.Database.GetBlockInfo(ctx, userID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if block.Expired(time.Now()) {
+		return nil, nil
+	}
+	return block, nil
+}
+
+// takeAppealTicket returns the ticket and the block it was issued for, or a nil block if that was lifted.
+func (o *adminServer) takeAppealTicket(ctx context.Context, ticket string) (*cache.AppealTicket, *admindb.ForbiddenAccount, error) {
+	t, err := o.Database.GetAppealTicket(ctx, ticket)
+	if err != nil {
+		return nil, nil, err
+	}
+	if t == nil {
+		return nil, nil, eerrs.ErrAppealTicketInvalid.Wrap()
+	}
+	block, err := o.activeBlock(ctx, t.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if block != nil && block.CreateTime.UnixMilli() != t.BlockTime {
+		block = nil
+	}
+	return t, block, nil
+}
+
+func toPbAppeal(appeal *admindb.Appeal) *admin.Appeal {
+	return &admin.Appeal{
+		AppealID:       appeal.AppealID,
+		UserID:         appeal.UserID,
+		Content:        appeal.Content,
+		Attachments:    appeal.Attachments,
+		Status:         appeal.Status,
+		AssigneeUserID: appeal.AssigneeUserID,
+		Response:       appeal.Response,
+		HandlerUserID:  appeal.HandlerUserID,
+		Ip:             appeal.IP,
+		BlockTime:      appeal.BlockTime.UnixMilli(),
+		CreateTime:     appeal.CreateTime.UnixMilli(),
+		HandleTime:     expireTimeMilli(appeal.HandleTime),
+	}
+}
@@ -2,8 +2,8 @@ package admin
 
 import (
 	"context"
+	"github.com/openimsdk/chat/pkg/common/constant"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
-	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/utils/datautil"
@@ -48,7 +48,7 @@ func (o *adminServer) LatestApplicationVersion(ctx context.Context, req *admin.L
 }
 
 func (o *adminServer) AddApplicationVersion(ctx context.Context, req *admin.AddApplicationVersionReq) (*admin.AddApplicationVersionResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermApplicationManage); err != nil {
 		return nil, err
 	}
 	val := &admindb.Application{
@@ -69,7 +69,7 @@ func (o *adminServer) AddApplicationVersion(ctx context.Context, req *admin.AddA
 }
 
 func (o *adminServer) UpdateApplicationVersion(ctx context.Context, req *admin.UpdateApplicationVersionReq) (*admin.UpdateApplicationVersionResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermApplicationManage); err != nil {
 		return nil, err
 	}
 	oid, err := primitive.ObjectIDFromHex(req.Id)
@@ -91,7 +91,7 @@ func (o *adminServer) UpdateApplicationVersion(ctx context.Context, req *admin.U
 }
 
 func (o *adminServer) DeleteApplicationVersion(ctx context.Context, req *admin.DeleteApplicationVersionReq) (*admin.DeleteApplicationVersionResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermApplicationManage); err != nil {
 		return nil, err
 	}
 	ids := make([]primitive.ObjectID, 0, len(req.Id))
@@ -0,0 +1,243 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// auditVerifyBatch is how many entries VerifyAuditLog reads at a time.
+const auditVerifyBatch = 1000
+
+// auditOperation records an operation the op user is about to run. A refused permission check
+// is recorded with its error.
+func (o *adminServer) auditOperation(ctx context.Context, opUserID string, op *admin.AuditOperation, checkErr error) error {
+	entry := &admindb.AuditLog{
+		OperatorUserID: opUserID,
+		Action:         op.Action,
+		Target:         op.Target,
+		Diff:           op.Diff,
+		IP:             op.Ip,
+		OperationID:    op.OperationID,
+		CreateTime:     time.Now(),
+	}
+	if checkErr != nil {
+		entry.ErrCode, entry.ErrMsg = errCodeMsg(checkErr)
+	}
+	return o.Database.AppendAuditLog(ctx, entry, o.AuditKey)
+}
+
+func errCodeMsg(err error) (int32, string) {
+	if codeErr, ok := errs.Unwrap(err).(errs.CodeError); ok {
+		return int32(codeErr.Code()), codeErr.Msg()
+	}
+	return int32(errs.ServerInternalError), err.Error()
+}
+
+// AddAuditLog records the result of an operation the op user started through an audited permission
+// check, once. Other entries are only written by the admin rpc itself.
+func (o *adminServer) AddAuditLog(ctx context.Context, req *admin.AddAuditLogReq) (*admin.AddAuditLogResp, error) {
+	opUserID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := o.Database.FindAuditLogByOperationID(ctx, req.OperationID)
+	if err != nil {
+		return nil, err
+	}
+	operation := pendingOperation(logs, opUserID)
+	if operation == nil {
+		return nil, errs.ErrNoPermission.WrapMsg("no audited operation of the admin waiting for its result", "operationID", req.OperationID)
+	}
+	result := &admindb.AuditLog{
+		OperatorUserID: opUserID,
+		Action:         operation.Action,
+		Target:         operation.Target,
+		IP:             operation.IP,
+		OperationID:    operation.OperationID,
+		ErrCode:        req.ErrCode,
+		ErrMsg:         req.ErrMsg,
+		ResultOf:       operation.Seq,
+		CreateTime:     time.Now(),
+	}
+	if err := o.Database.AppendAuditLog(ctx, result, o.AuditKey); err != nil {
+		return nil, err
+	}
+	return &admin.AddAuditLogResp{}, nil
+}
+
+// pendingOperation returns the latest operation entry of opUserID among logs that has no result
+// recorded yet.
+func pendingOperation(logs []*admindb.AuditLog, opUserID string) *admindb.AuditLog {
+	recorded := make(map[int64]bool)
+	for _, l := range logs {
+		if l.ResultOf != 0 {
+			recorded[l.ResultOf] = true
+		}
+	}
+	var operation *admindb.AuditLog
+	for _, l := range logs {
+		if l.ResultOf != 0 || l.ErrCode != 0 || l.OperatorUserID != opUserID || recorded[l.Seq] {
+			continue
+		}
+		if operation == nil || l.Seq > operation.Seq {
+			operation = l
+		}
+	}
+	return operation
+}
+
+func auditLogPB(l *admindb.AuditLog) *admin.AuditLog {
+	return &admin.AuditLog{
+		Seq:            l.Seq,
+		OperatorUserID: l.OperatorUserID,
+		Action:         l.Action,
+		Target:         l.Target,
+		Diff:           l.Diff,
+		Ip:             l.IP,
+		OperationID:    l.OperationID,
+		ErrCode:        l.ErrCode,
+		ErrMsg:         l.ErrMsg,
+		CreateTime:     l.CreateTime.UnixMilli(),
+		PrevHash:       l.PrevHash,
+		Hash:           l.Hash,
+		ResultOf:       l.ResultOf,
+	}
+}
+
+func auditTimeRange(startTime int64, endTime int64) (time.Time, time.Time) {
+	var start, end time.Time
+	if startTime > 0 {
+		start = time.UnixMilli(startTime)
+	}
+	if endTime > 0 {
+		end = time.UnixMilli(endTime)
+	}
+	return start, end
+}
+
+func (o *adminServer) SearchAuditLog(ctx context.Context, req *admin.SearchAuditLogReq) (*admin.SearchAuditLogResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermAuditRead); err != nil {
+		return nil, err
+	}
+	start, end := auditTimeRange(req.StartTime, req.EndTime)
+	total, logs, err := o.Database.SearchAuditLog(ctx, req.OperatorUserIDs, req.Action, req.Target, start, end, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.SearchAuditLogResp{
+		Total: uint32(total),
+		Logs:  make([]*admin.AuditLog, 0, len(logs)),
+	}
+	for _, l := range logs {
+		resp.Logs = append(resp.Logs, auditLogPB(l))
+	}
+	return resp, nil
+}
+
+// ScanAuditLog pages through the matching entries by _id, newest first, for exports too long for
+// page numbers.
+func (o *adminServer) ScanAuditLog(ctx context.Context, req *admin.ScanAuditLogReq) (*admin.ScanAuditLogResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermAuditRead); err != nil {
+		return nil, err
+	}
+	var cursor primitive.ObjectID
+	if req.Cursor != "" {
+		var err error
+		if cursor, err = primitive.ObjectIDFromHex(req.Cursor); err != nil {
+			return nil, errs.ErrArgs.WrapMsg("cursor is invalid")
+		}
+	}
+	start, end := auditTimeRange(req.StartTime, req.EndTime)
+	logs, err := o.Database.ScanAuditLog(ctx, req.OperatorUserIDs, req.Action, req.Target, start, end, cursor, int64(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.ScanAuditLogResp{Logs: make([]*admin.AuditLog, 0, len(logs))}
+	for _, l := range logs {
+		resp.Logs = append(resp.Logs, auditLogPB(l))
+	}
+	if len(logs) == int(req.Limit) {
+		resp.NextCursor = logs[len(logs)-1].ID.Hex()
+	}
+	return resp, nil
+}
+
+// VerifyAuditLog walks the whole chain and reports the first entry that was changed, or that
+// follows deleted entries. The chain head tells whether entries were deleted from the end.
+func (o *adminServer) VerifyAuditLog(ctx context.Context, req *admin.VerifyAuditLogReq) (*admin.VerifyAuditLogResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermAuditRead); err != nil {
+		return nil, err
+	}
+	head, err := o.Database.TakeAuditLogHead(ctx)
+	if err != nil && !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	if head != nil && head.MAC != head.ComputeMAC(o.AuditKey) {
+		return &admin.VerifyAuditLogResp{BrokenSeq: head.Seq, Reason: "chain head does not match its hash"}, nil
+	}
+	var (
+		total    int64
+		seq      int64
+		prevHash string
+	)
+	for {
+		logs, err := o.Database.FindAuditLogAfter(ctx, seq, auditVerifyBatch)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range logs {
+			total++
+			var reason string
+			switch {
+			case l.Seq != seq+1:
+				reason = fmt.Sprintf("entries %d to %d are missing", seq+1, l.Seq-1)
+			case l.PrevHash != prevHash:
+				reason = "previous hash does not match"
+			case l.Hash != l.ComputeHash(o.AuditKey):
+				reason = "content does not match its hash"
+			case head != nil && l.Seq == head.Seq && l.Hash != head.Hash:
+				reason = "entry does not match the chain head"
+			}
+			if reason != "" {
+				return &admin.VerifyAuditLogResp{Total: total, BrokenSeq: l.Seq, Reason: reason}, nil
+			}
+			seq = l.Seq
+			prevHash = l.Hash
+		}
+		if len(logs) < auditVerifyBatch {
+			break
+		}
+	}
+	switch {
+	case head == nil && total > 0:
+		return &admin.VerifyAuditLogResp{Total: total, BrokenSeq: seq, Reason: "chain head is missing"}, nil
+	case head != nil && seq < head.Seq:
+		// entries past the head are fine, an append may not have moved the head yet
+		return &admin.VerifyAuditLogResp{Total: total, BrokenSeq: seq + 1, Reason: fmt.Sprintf("entries %d to %d at the end are missing", seq+1, head.Seq)}, nil
+	}
+	return &admin.VerifyAuditLogResp{Total: total, Intact: true}, nil
+}
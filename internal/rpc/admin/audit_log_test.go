@@ -0,0 +1,172 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/database"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// auditDB keeps the audit log in memory and chains it like AdminDatabase. The admins hold all
+// permissions.
+type auditDB struct {
+	database.AdminDatabaseInterface
+	logs    []*admindb.AuditLog
+	head    *admindb.AuditLogHead
+	failing bool
+}
+
+func (d *auditDB) GetAdminUserID(ctx context.Context, userID string) (*admindb.Admin, error) {
+	return &admindb.Admin{UserID: userID, Level: constant.AdvancedUserLevel}, nil
+}
+
+func (d *auditDB) AppendAuditLog(ctx context.Context, log *admindb.AuditLog, key []byte) error {
+	if d.failing {
+		return errors.New("audit log down")
+	}
+	log.Seq, log.PrevHash = 1, ""
+	if n := len(d.logs); n > 0 {
+		log.Seq, log.PrevHash = d.logs[n-1].Seq+1, d.logs[n-1].Hash
+	}
+	log.CreateTime = log.CreateTime.Truncate(time.Millisecond)
+	log.Hash = log.ComputeHash(key)
+	d.logs = append(d.logs, log)
+	d.head = &admindb.AuditLogHead{Seq: log.Seq, Hash: log.Hash}
+	d.head.MAC = d.head.ComputeMAC(key)
+	return nil
+}
+
+func (d *auditDB) FindAuditLogAfter(ctx context.Context, seq int64, limit int64) ([]*admindb.AuditLog, error) {
+	var logs []*admindb.AuditLog
+	for _, l := range d.logs {
+		if l.Seq > seq && int64(len(logs)) < limit {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+func (d *auditDB) FindAuditLogByOperationID(ctx context.Context, operationID string) ([]*admindb.AuditLog, error) {
+	var logs []*admindb.AuditLog
+	for _, l := range d.logs {
+		if l.OperationID == operationID {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+func (d *auditDB) TakeAuditLogHead(ctx context.Context) (*admindb.AuditLogHead, error) {
+	if d.head == nil {
+		return nil, mongo.ErrNoDocuments
+	}
+	return d.head, nil
+}
+
+func auditOp(operationID string) *admin.AuditOperation {
+	return &admin.AuditOperation{Action: "/user/block", Target: `userID="u1"`, OperationID: operationID}
+}
+
+func TestCheckAdminPermissionAudit(t *testing.T) {
+	db := &auditDB{}
+	o := &adminServer{Database: db, AuditKey: []byte("key")}
+	ctx := mctx.WithAdminUser(context.Background(), "a1")
+
+	db.failing = true
+	if _, err := o.CheckAdminPermission(ctx, &admin.CheckAdminPermissionReq{Permissions: []string{constant.PermUsersBlock}, Audit: auditOp("op1")}); err == nil {
+		t.Fatal("operation allowed while the audit log is down")
+	}
+	db.failing = false
+	if _, err := o.CheckAdminPermission(ctx, &admin.CheckAdminPermissionReq{Permissions: []string{constant.PermUsersBlock}, Audit: auditOp("op1")}); err != nil {
+		t.Fatal(err)
+	}
+	if len(db.logs) != 1 || db.logs[0].OperatorUserID != "a1" || db.logs[0].Action != "/user/block" {
+		t.Fatalf("operation not recorded: %+v", db.logs)
+	}
+
+	other := mctx.WithAdminUser(context.Background(), "a2")
+	if _, err := o.AddAuditLog(other, &admin.AddAuditLogReq{OperationID: "op1"}); err == nil {
+		t.Fatal("result recorded for the operation of another admin")
+	}
+	if _, err := o.AddAuditLog(ctx, &admin.AddAuditLogReq{OperationID: "op2"}); err == nil {
+		t.Fatal("result recorded without an operation")
+	}
+	if _, err := o.AddAuditLog(ctx, &admin.AddAuditLogReq{OperationID: "op1", ErrCode: 1001, ErrMsg: "ArgsError"}); err != nil {
+		t.Fatal(err)
+	}
+	if result := db.logs[len(db.logs)-1]; result.ResultOf != 1 || result.ErrCode != 1001 {
+		t.Fatalf("result = %+v", result)
+	}
+	if _, err := o.AddAuditLog(ctx, &admin.AddAuditLogReq{OperationID: "op1"}); err == nil {
+		t.Fatal("result recorded twice")
+	}
+}
+
+func TestVerifyAuditLog(t *testing.T) {
+	newChain := func() (*adminServer, *auditDB) {
+		db := &auditDB{}
+		o := &adminServer{Database: db, AuditKey: []byte("key")}
+		ctx := mctx.WithAdminUser(context.Background(), "a1")
+		for _, id := range []string{"op1", "op2", "op3"} {
+			if _, err := o.CheckAdminPermission(ctx, &admin.CheckAdminPermissionReq{Audit: auditOp(id)}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return o, db
+	}
+	tests := []struct {
+		name      string
+		tamper    func(o *adminServer, db *auditDB)
+		brokenSeq int64
+	}{
+		{name: "intact", tamper: func(o *adminServer, db *auditDB) {}},
+		{name: "changed", tamper: func(o *adminServer, db *auditDB) { db.logs[1].Target = `userID="u2"` }, brokenSeq: 2},
+		{name: "rehashed without the key", tamper: func(o *adminServer, db *auditDB) {
+			db.logs[2].Target = `userID="u2"`
+			db.logs[2].Hash = db.logs[2].ComputeHash(nil)
+		}, brokenSeq: 3},
+		{name: "deleted in the middle", tamper: func(o *adminServer, db *auditDB) { db.logs = append(db.logs[:1], db.logs[2:]...) }, brokenSeq: 3},
+		{name: "deleted at the end", tamper: func(o *adminServer, db *auditDB) { db.logs = db.logs[:2] }, brokenSeq: 3},
+		{name: "head deleted", tamper: func(o *adminServer, db *auditDB) { db.head = nil }, brokenSeq: 3},
+		{name: "head moved back", tamper: func(o *adminServer, db *auditDB) {
+			db.logs = db.logs[:2]
+			db.head = &admindb.AuditLogHead{Seq: 2, Hash: db.logs[1].Hash}
+		}, brokenSeq: 2},
+		{name: "other key", tamper: func(o *adminServer, db *auditDB) { o.AuditKey = []byte("other") }, brokenSeq: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o, db := newChain()
+			tt.tamper(o, db)
+			resp, err := o.VerifyAuditLog(mctx.WithAdminUser(context.Background(), "a1"), &admin.VerifyAuditLogReq{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Intact != (tt.brokenSeq == 0) || resp.BrokenSeq != tt.brokenSeq {
+				t.Fatalf("intact = %v, brokenSeq = %d (%s), want brokenSeq %d", resp.Intact, resp.BrokenSeq, resp.Reason, tt.brokenSeq)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+)
+
+// blockExpireBatch is how many expired blocks expireBlocks lifts per query.
+const blockExpireBatch = 100
+
+// Operator and action of the audit log entries of blocks lifted by the expiry job.
+const (
+	blockExpireOperator = "system"
+	blockExpireAction   = "/block/expire"
+)
+
+// liftExpiredBlock removes an expired block and records it in the audit log, unless another instance
+// lifted it first.
+func (o *adminServer) liftExpiredBlock(ctx context.Context, block *admindb.ForbiddenAccount) (bool, error) {
+	lifted, err := o.Database.LiftExpiredBlock(ctx, block)
+	if err != nil || !lifted {
+		return lifted, err
+	}
+	log.ZInfo(ctx, "account block expired", "userID", block.UserID, "category", block.Category, "expireTime", block.ExpireTime)
+	auditLog := &admindb.AuditLog{
+		OperatorUserID: blockExpireOperator,
+		Action:         blockExpireAction,
+		Target:         "userID=" + block.UserID,
+		OperationID:    mcontext.GetOperationID(ctx),
+		CreateTime:     time.Now(),
+	}
+	if err := o.Database.AppendAuditLog(ctx, auditLog, o.AuditKey); err != nil {
+		log.ZError(ctx, "append block expire audit log failed", err, "userID", block.UserID)
+	}
+	return true, nil
+}
+
+// blockExpireNotice is sent to a user in OpenIM once their block has ended.
+const blockExpireNotice = "Your account block has ended, you can log in again."
+
+// notifyBlockExpired tells a user in OpenIM that their block has ended.
+func (o *adminServer) notifyBlockExpired(ctx context.Context, userID string) error {
+	imToken, err := o.IM.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	return o.IM.SendTextMessage(mctx.WithApiToken(ctx, imToken), userID, blockExpireNotice)
+}
+
+// expireBlocks lifts the blocks whose end time passed every interval.
+func (o *adminServer) expireBlocks(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx := mcontext.SetOperationID(context.Background(), "expire_blocks_"+time.Now().Format("20060102150405"))
+		o.liftExpiredBlocks(ctx)
+	}
+}
+
+// liftExpiredBlocks lifts every block whose end time passed and notifies the users.
+func (o *adminServer) liftExpiredBlocks(ctx context.Context) {
+	for {
+		blocks, err := o.Database.FindExpiredBlock(ctx, time.Now(), blockExpireBatch)
+		if err != nil {
+			log.ZError(ctx, "find expired blocks failed", err)
+			return
+		}
+		var failed bool
+		for _, block := range blocks {
+			lifted, err := o.liftExpiredBlock(ctx, block)
+			if err != nil {
+				log.ZError(ctx, "lift expired block failed", err, "userID", block.UserID)
+				failed = true
+				continue
+			}
+			if !lifted {
+				continue
+			}
+			if err := o.notifyBlockExpired(ctx, block.UserID); err != nil {
+				log.ZError(ctx, "notify block expired failed", err, "userID", block.UserID)
+			}
+		}
+		// retry the failed ones on the next tick rather than fetching them again now
+		if failed || len(blocks) < blockExpireBatch {
+			return
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+)
+
+// expireDB finds the expired blocks of blockDB, plus stale ones another instance already lifted.
+type expireDB struct {
+	*blockDB
+	stale  []*admindb.ForbiddenAccount
+	audits []*admindb.AuditLog
+}
+
+func (d *expireDB) FindExpiredBlock(ctx context.Context, now time.Time, limit int64) ([]*admindb.ForbiddenAccount, error) {
+	blocks := d.stale
+	d.stale = nil
+	for _, b := range d.blocks {
+		if b.Expired(now) {
+			blocks = append(blocks, b)
+		}
+	}
+	return blocks, nil
+}
+
+func (d *expireDB) LiftExpiredBlock(ctx context.Context, f *admindb.ForbiddenAccount) (bool, error) {
+	if _, ok := d.blocks[f.UserID]; !ok {
+		return false, nil
+	}
+	delete(d.blocks, f.UserID)
+	return true, nil
+}
+
+func (d *expireDB) AppendAuditLog(ctx context.Context, log *admindb.AuditLog, key []byte) error {
+	d.audits = append(d.audits, log)
+	return nil
+}
+
+func TestLiftExpiredBlocks(t *testing.T) {
+	now := time.Now()
+	db := &expireDB{blockDB: newBlockDB()}
+	db.blocks["expired"] = &admindb.ForbiddenAccount{UserID: "expired", ExpireTime: now.Add(-time.Minute)}
+	db.blocks["later"] = &admindb.ForbiddenAccount{UserID: "later", ExpireTime: now.Add(time.Hour)}
+	db.blocks["forever"] = &admindb.ForbiddenAccount{UserID: "forever"}
+	db.stale = []*admindb.ForbiddenAccount{{UserID: "lifted", ExpireTime: now.Add(-time.Minute)}}
+	im := &imCaller{}
+	o := &adminServer{Database: db, IM: im}
+	o.liftExpiredBlocks(context.Background())
+
+	if _, ok := db.blocks["expired"]; ok {
+		t.Fatal("expired block not lifted")
+	}
+	if len(db.blocks) != 2 {
+		t.Fatalf("%d blocks left, want later and forever", len(db.blocks))
+	}
+	if want := []string{"message expired"}; fmt.Sprint(im.calls) != fmt.Sprint(want) {
+		t.Fatalf("im calls = %v, want %v", im.calls, want)
+	}
+	if len(db.audits) != 1 || db.audits[0].Action != blockExpireAction || db.audits[0].Target != "userID=expired" {
+		t.Fatalf("audit logs = %v, want one %s of expired", db.audits, blockExpireAction)
+	}
+}
@@ -16,51 +16,63 @@ package admin
 
 import (
 	"context"
+	"time"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	"github.com/openimsdk/chat/pkg/eerrs"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 )
 
 func (o *adminServer) CheckRegisterForbidden(ctx context.Context, req *admin.CheckRegisterForbiddenReq) (*admin.CheckRegisterForbiddenResp, error) {
-	forbiddens, err := o.Database.FindIPForbidden(ctx, []string{req.Ip})
-	if err != nil {
-		return nil, err
-	}
-	for _, forbidden := range forbiddens {
+	for _, forbidden := range o.matchIPForbidden(req.Ip) {
 		if forbidden.LimitRegister {
 			return nil, eerrs.ErrForbidden.Wrap()
 		}
 	}
-	return &admin.CheckRegisterForbiddenResp{}, nil
+	loc := o.locate(ctx, req.Ip)
+	resp := &admin.CheckRegisterForbiddenResp{Country: loc.Country, Region: loc.Region}
+	if rule := o.geoRule(loc); rule != nil {
+		switch rule.Register {
+		case constant.GeoActionBlock:
+			return nil, eerrs.ErrForbidden.WrapMsg("region forbidden", "country", loc.Country)
+		case constant.GeoActionVerify:
+			resp.Verify = true
+		}
+	}
+	return resp, nil
 }
 
 func (o *adminServer) CheckLoginForbidden(ctx context.Context, req *admin.CheckLoginForbiddenReq) (*admin.CheckLoginForbiddenResp, error) {
-	forbiddens, err := o.Database.FindIPForbidden(ctx, []string{req.Ip})
-	if err != nil {
-		return nil, err
-	}
-	for _, forbidden := range forbiddens {
+	for _, forbidden := range o.matchIPForbidden(req.Ip) {
 		if forbidden.LimitLogin {
 			return nil, eerrs.ErrForbidden.WrapMsg("ip forbidden")
 		}
 	}
-	if _, err := o.Database.GetLimitUserLoginIP(ctx, req.UserID, req.Ip); err != nil {
-		if !dbutil.IsDBNotFound(err) {
-			return nil, err
-		}
-		count, err := o.Database.CountLimitUserLoginIP(ctx, req.UserID)
-		if err != nil {
-			return nil, err
-		}
-		if count > 0 {
-			return nil, eerrs.ErrForbidden.WrapMsg("user ip forbidden")
+	if !o.userLoginIPAllowed(req.UserID, req.Ip) {
+		return nil, eerrs.ErrForbidden.WrapMsg("user ip forbidden")
+	}
+	loc := o.locate(ctx, req.Ip)
+	resp := &admin.CheckLoginForbiddenResp{Country: loc.Country, Region: loc.Region}
+	if rule := o.geoRule(loc); rule != nil {
+		switch rule.Login {
+		case constant.GeoActionBlock:
+			return nil, eerrs.ErrForbidden.WrapMsg("region forbidden", "country", loc.Country)
+		case constant.GeoActionVerify:
+			resp.Verify = true
 		}
 	}
 	if forbiddenAccount, err := o.Database.GetBlockInfo(ctx, req.UserID); err == nil {
-		return nil, eerrs.ErrForbidden.WrapMsg("account forbidden", "reason", forbiddenAccount.Reason)
+		if forbiddenAccount.Expired(time.Now()) {
+			return resp, nil
+		}
+		if req.AllowBlocked {
+			resp.Blocked = true
+			return resp, nil
+		}
+		return nil, eerrs.ErrAccountBlocked.WrapMsg("account blocked", "reason", forbiddenAccount.Reason)
 	} else if !dbutil.IsDBNotFound(err) {
 		return nil, err
 	}
-	return &admin.CheckLoginForbiddenResp{}, nil
+	return resp, nil
 }
@@ -0,0 +1,127 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/geoip"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+func geoRuleKey(country string, region string) string {
+	if region == "" {
+		return country
+	}
+	return country + "-" + region
+}
+
+// locate resolves ip with the local GeoIP database; without one, or on a lookup error, the location is unknown.
+func (o *adminServer) locate(ctx context.Context, ip string) geoip.Location {
+	if o.GeoIP == nil || ip == "" {
+		return geoip.Location{}
+	}
+	loc, err := o.GeoIP.Locate(ip)
+	if err != nil {
+		log.ZWarn(ctx, "geoip lookup failed", err, "ip", ip)
+	}
+	return loc
+}
+
+// geoRule returns the rule of the region of loc, falling back to the rule of its country.
+func (o *adminServer) geoRule(loc geoip.Location) *admindb.GeoRule {
+	if loc.Country == "" {
+		return nil
+	}
+	rules := o.ipRules.Load().geo
+	if loc.Region != "" {
+		if rule, ok := rules[geoRuleKey(loc.Country, loc.Region)]; ok {
+			return rule
+		}
+	}
+	return rules[geoRuleKey(loc.Country, "")]
+}
+
+func (o *adminServer) AddGeoRule(ctx context.Context, req *admin.AddGeoRuleReq) (*admin.AddGeoRuleResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermForbiddenManage); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	rules := make([]*admindb.GeoRule, 0, len(req.Rules))
+	for _, rule := range req.Rules {
+		rules = append(rules, &admindb.GeoRule{
+			Country:    strings.ToUpper(rule.Country),
+			Region:     strings.ToUpper(rule.Region),
+			Register:   rule.Register,
+			Login:      rule.Login,
+			Note:       rule.Note,
+			CreateTime: now,
+		})
+	}
+	if err := o.Database.AddGeoRule(ctx, rules); err != nil {
+		return nil, err
+	}
+	o.reloadIPRules(ctx)
+	return &admin.AddGeoRuleResp{}, nil
+}
+
+func (o *adminServer) DelGeoRule(ctx context.Context, req *admin.DelGeoRuleReq) (*admin.DelGeoRuleResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermForbiddenManage); err != nil {
+		return nil, err
+	}
+	rules := make([]*admindb.GeoRule, 0, len(req.Rules))
+	for _, rule := range req.Rules {
+		rules = append(rules, &admindb.GeoRule{
+			Country: strings.ToUpper(rule.Country),
+			Region:  strings.ToUpper(rule.Region),
+		})
+	}
+	if err := o.Database.DelGeoRule(ctx, rules); err != nil {
+		return nil, err
+	}
+	o.reloadIPRules(ctx)
+	return &admin.DelGeoRuleResp{}, nil
+}
+
+func (o *adminServer) SearchGeoRule(ctx context.Context, req *admin.SearchGeoRuleReq) (*admin.SearchGeoRuleResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermForbiddenManage); err != nil {
+		return nil, err
+	}
+	total, rules, err := o.Database.SearchGeoRule(ctx, req.Keyword, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.SearchGeoRuleResp{
+		Total: uint32(total),
+		Rules: make([]*admin.GeoRule, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, &admin.GeoRule{
+			Country:    rule.Country,
+			Region:     rule.Region,
+			Register:   rule.Register,
+			Login:      rule.Login,
+			Note:       rule.Note,
+			CreateTime: rule.CreateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,110 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// ImpersonateUser issues a token for an admin to act as the user. The token names the admin,
+// lives for the impersonation expire time and comes without a refresh token. An IM token the
+// admin api gets along with it is signed out once the impersonation ends, see TakeExpiredImpersonation.
+// Blocked users cannot be impersonated.
+func (o *adminServer) ImpersonateUser(ctx context.Context, req *admin.ImpersonateUserReq) (*admin.ImpersonateUserResp, error) {
+	opUserID, err := o.checkPermission(ctx, constant.PermUsersImpersonate)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := o.Chat.GetUserPublicInfo(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	block, err := o.Database.GetBlockInfo(ctx, req.UserID)
+	if err == nil {
+		if !block.Expired(now) {
+			return nil, eerrs.ErrAccountBlocked.WrapMsg("blocked users cannot be impersonated")
+		}
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	sessionID, err := randomToken(16)
+	if err != nil {
+		return nil, err
+	}
+	token, err := o.Token.CreateImpersonationToken(req.UserID, opUserID, sessionID, o.ImpersonationExpire)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.CacheToken(ctx, req.UserID, token, o.ImpersonationExpire); err != nil {
+		return nil, err
+	}
+	session := &cache.Session{
+		SessionID:    sessionID,
+		Token:        token,
+		Platform:     req.Platform,
+		IP:           req.Ip,
+		CreateTime:   now,
+		LastSeenTime: now,
+		ExpireTime:   now.Add(o.ImpersonationExpire),
+		Impersonator: opUserID,
+	}
+	if err := o.Database.SetSession(ctx, req.UserID, session); err != nil {
+		return nil, err
+	}
+	if req.ImToken {
+		imToken := &cache.ImpersonationIMToken{UserID: req.UserID, Platform: req.Platform}
+		if err := o.Database.AddImpersonationIMToken(ctx, imToken, session.ExpireTime); err != nil {
+			return nil, err
+		}
+	}
+	log.ZInfo(ctx, "impersonation token issued", "impersonator", opUserID, "userID", req.UserID, "reason", req.Reason, "sessionID", sessionID)
+	if o.ImpersonationNotify {
+		go func(ctx context.Context) {
+			if err := o.Chat.NotifyImpersonation(ctx, req.UserID, opUserID, session.ExpireTime.UnixMilli()); err != nil {
+				log.ZError(ctx, "notify impersonation failed", err, "userID", req.UserID)
+			}
+		}(context.WithoutCancel(ctx))
+	}
+	return &admin.ImpersonateUserResp{
+		Token:        token,
+		ExpireTime:   session.ExpireTime.UnixMilli(),
+		Impersonator: opUserID,
+	}, nil
+}
+
+func (o *adminServer) TakeExpiredImpersonation(ctx context.Context, req *admin.TakeExpiredImpersonationReq) (*admin.TakeExpiredImpersonationResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermUsersImpersonate); err != nil {
+		return nil, err
+	}
+	tokens, err := o.Database.TakeExpiredImpersonationIMToken(ctx, time.Now(), int64(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return &admin.TakeExpiredImpersonationResp{
+		Tokens: datautil.Slice(tokens, func(t *cache.ImpersonationIMToken) *admin.ImpersonationIMToken {
+			return &admin.ImpersonationIMToken{UserID: t.UserID, Platform: t.Platform}
+		}),
+	}, nil
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/openimsdk/tools/errs"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/eerrs"
@@ -31,7 +32,7 @@ import (
 )
 
 func (o *adminServer) AddInvitationCode(ctx context.Context, req *admin.AddInvitationCodeReq) (*admin.AddInvitationCodeResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermInvitationManage); err != nil {
 		return nil, err
 	}
 	if len(req.Codes) == 0 {
@@ -64,7 +65,7 @@ func (o *adminServer) AddInvitationCode(ctx context.Context, req *admin.AddInvit
 }
 
 func (o *adminServer) GenInvitationCode(ctx context.Context, req *admin.GenInvitationCodeReq) (*admin.GenInvitationCodeResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermInvitationManage); err != nil {
 		return nil, err
 	}
 	if req.Num <= 0 || req.Len <= 0 {
@@ -160,7 +161,7 @@ func (o *adminServer) UseInvitationCode(ctx context.Context, req *admin.UseInvit
 }
 
 func (o *adminServer) DelInvitationCode(ctx context.Context, req *admin.DelInvitationCodeReq) (*admin.DelInvitationCodeResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermInvitationManage); err != nil {
 		return nil, err
 	}
 	if len(req.Codes) == 0 {
@@ -184,7 +185,7 @@ func (o *adminServer) DelInvitationCode(ctx context.Context, req *admin.DelInvit
 }
 
 func (o *adminServer) SearchInvitationCode(ctx context.Context, req *admin.SearchInvitationCodeReq) (*admin.SearchInvitationCodeResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermInvitationManage); err != nil {
 		return nil, err
 	}
 	total, list, err := o.Database.SearchInvitationRegister(ctx, req.Keyword, req.Status, req.UserIDs, req.Codes, req.Pagination)
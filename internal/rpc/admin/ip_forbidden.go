@@ -18,13 +18,14 @@ import (
 	"context"
 	"time"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
-	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/common/iptrie"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 )
 
 func (o *adminServer) SearchIPForbidden(ctx context.Context, req *admin.SearchIPForbiddenReq) (*admin.SearchIPForbiddenResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermForbiddenManage); err != nil {
 		return nil, err
 	}
 	total, forbiddens, err := o.Database.SearchIPForbidden(ctx, req.Keyword, req.Status, req.Pagination)
@@ -41,37 +42,73 @@ func (o *adminServer) SearchIPForbidden(ctx context.Context, req *admin.SearchIP
 			LimitLogin:    forbidden.LimitLogin,
 			LimitRegister: forbidden.LimitRegister,
 			CreateTime:    forbidden.CreateTime.UnixMilli(),
+			Note:          forbidden.Note,
+			ExpireTime:    expireTimeMilli(forbidden.ExpireTime),
 		})
 	}
 	return resp, nil
 }
 
 func (o *adminServer) AddIPForbidden(ctx context.Context, req *admin.AddIPForbiddenReq) (*admin.AddIPForbiddenResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermForbiddenManage); err != nil {
 		return nil, err
 	}
 	now := time.Now()
 	tables := make([]*admindb.IPForbidden, 0, len(req.Forbiddens))
 	for _, forbidden := range req.Forbiddens {
+		ip, err := iptrie.Canonical(forbidden.Ip)
+		if err != nil {
+			return nil, err
+		}
 		tables = append(tables, &admindb.IPForbidden{
-			IP:            forbidden.Ip,
+			IP:            ip,
 			LimitLogin:    forbidden.LimitLogin,
 			LimitRegister: forbidden.LimitRegister,
+			Note:          forbidden.Note,
+			ExpireTime:    expireTimeFromMilli(forbidden.ExpireTime),
 			CreateTime:    now,
 		})
 	}
 	if err := o.Database.AddIPForbidden(ctx, tables); err != nil {
 		return nil, err
 	}
+	o.reloadIPRules(ctx)
 	return &admin.AddIPForbiddenResp{}, nil
 }
 
 func (o *adminServer) DelIPForbidden(ctx context.Context, req *admin.DelIPForbiddenReq) (*admin.DelIPForbiddenResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermForbiddenManage); err != nil {
 		return nil, err
 	}
-	if err := o.Database.DelIPForbidden(ctx, req.Ips); err != nil {
+	if err := o.Database.DelIPForbidden(ctx, canonicalIPs(req.Ips)); err != nil {
 		return nil, err
 	}
+	o.reloadIPRules(ctx)
 	return &admin.DelIPForbiddenResp{}, nil
 }
+
+// canonicalIPs maps ips to their stored form; unparsable entries are kept so old rows can still be deleted.
+func canonicalIPs(ips []string) []string {
+	res := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		if c, err := iptrie.Canonical(ip); err == nil {
+			ip = c
+		}
+		res = append(res, ip)
+	}
+	return res
+}
+
+func expireTimeFromMilli(ms int64) time.Time {
+	if ms <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+func expireTimeMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
@@ -0,0 +1,153 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/iptrie"
+)
+
+// ipRules is a snapshot of the IP forbidden, user login IP and geo rules, rebuilt from mongo
+// and swapped in whole so checks never wait on a reload.
+type ipRules struct {
+	forbidden iptrie.Trie[*admindb.IPForbidden]
+	userLimit map[string]*iptrie.Trie[*admindb.LimitUserLoginIP]
+	geo       map[string]*admindb.GeoRule
+}
+
+func ruleExpired(expireTime time.Time, now time.Time) bool {
+	return !expireTime.IsZero() && !expireTime.After(now)
+}
+
+func (o *adminServer) loadIPRules(ctx context.Context) error {
+	forbiddens, err := o.Database.FindAllIPForbidden(ctx)
+	if err != nil {
+		return err
+	}
+	limits, err := o.Database.FindAllUserLimitLogin(ctx)
+	if err != nil {
+		return err
+	}
+	geoRules, err := o.Database.FindAllGeoRule(ctx)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	rules := &ipRules{
+		userLimit: make(map[string]*iptrie.Trie[*admindb.LimitUserLoginIP]),
+		geo:       make(map[string]*admindb.GeoRule, len(geoRules)),
+	}
+	for _, rule := range geoRules {
+		rules.geo[geoRuleKey(rule.Country, rule.Region)] = rule
+	}
+	for _, forbidden := range forbiddens {
+		if ruleExpired(forbidden.ExpireTime, now) {
+			continue
+		}
+		prefix, err := iptrie.ParsePrefix(forbidden.IP)
+		if err != nil {
+			log.ZWarn(ctx, "skip invalid ip forbidden rule", err, "ip", forbidden.IP)
+			continue
+		}
+		rules.forbidden.Insert(prefix, forbidden)
+	}
+	for _, limit := range limits {
+		if ruleExpired(limit.ExpireTime, now) {
+			continue
+		}
+		prefix, err := iptrie.ParsePrefix(limit.IP)
+		if err != nil {
+			log.ZWarn(ctx, "skip invalid user login ip rule", err, "userID", limit.UserID, "ip", limit.IP)
+			continue
+		}
+		trie := rules.userLimit[limit.UserID]
+		if trie == nil {
+			trie = &iptrie.Trie[*admindb.LimitUserLoginIP]{}
+			rules.userLimit[limit.UserID] = trie
+		}
+		trie.Insert(prefix, limit)
+	}
+	o.ipRules.Store(rules)
+	return nil
+}
+
+// refreshIPRules reloads the rules every interval, so changes made through other instances take effect.
+func (o *adminServer) refreshIPRules(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx := mcontext.SetOperationID(context.Background(), "refresh_ip_rules_"+time.Now().Format("20060102150405"))
+		if err := o.loadIPRules(ctx); err != nil {
+			log.ZError(ctx, "refresh ip rules failed", err)
+		}
+	}
+}
+
+// reloadIPRules applies a change made through this instance right away.
+func (o *adminServer) reloadIPRules(ctx context.Context) {
+	if err := o.loadIPRules(ctx); err != nil {
+		log.ZError(ctx, "reload ip rules failed", err)
+	}
+}
+
+// matchIPForbidden returns the rules whose network contains ip and that have not expired.
+func (o *adminServer) matchIPForbidden(ip string) []*admindb.IPForbidden {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil
+	}
+	now := time.Now()
+	var res []*admindb.IPForbidden
+	for _, forbidden := range o.ipRules.Load().forbidden.Match(addr) {
+		if !ruleExpired(forbidden.ExpireTime, now) {
+			res = append(res, forbidden)
+		}
+	}
+	return res
+}
+
+// userLoginIPAllowed reports whether the user has no login IP rules, or one of them contains ip.
+func (o *adminServer) userLoginIPAllowed(userID string, ip string) bool {
+	trie := o.ipRules.Load().userLimit[userID]
+	if trie == nil {
+		return true
+	}
+	now := time.Now()
+	if addr, err := netip.ParseAddr(ip); err == nil {
+		for _, limit := range trie.Match(addr) {
+			if !ruleExpired(limit.ExpireTime, now) {
+				return true
+			}
+		}
+	}
+	// every rule of the user may have expired since the last reload
+	return !hasUserLoginIPRule(trie, now)
+}
+
+func hasUserLoginIPRule(trie *iptrie.Trie[*admindb.LimitUserLoginIP], now time.Time) bool {
+	for _, limit := range trie.All() {
+		if !ruleExpired(limit.ExpireTime, now) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/common/db/database"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+)
+
+// ipRuleDB returns fixed IP forbidden and user login IP rules.
+type ipRuleDB struct {
+	database.AdminDatabaseInterface
+	forbiddens []*admindb.IPForbidden
+	limits     []*admindb.LimitUserLoginIP
+}
+
+func (d *ipRuleDB) FindAllIPForbidden(ctx context.Context) ([]*admindb.IPForbidden, error) {
+	return d.forbiddens, nil
+}
+
+func (d *ipRuleDB) FindAllUserLimitLogin(ctx context.Context) ([]*admindb.LimitUserLoginIP, error) {
+	return d.limits, nil
+}
+
+func (d *ipRuleDB) FindAllGeoRule(ctx context.Context) ([]*admindb.GeoRule, error) {
+	return nil, nil
+}
+
+func TestIPRulesSkipExpired(t *testing.T) {
+	now := time.Now()
+	db := &ipRuleDB{
+		forbiddens: []*admindb.IPForbidden{
+			{IP: "10.0.0.0/8", ExpireTime: now.Add(-time.Minute)},
+			{IP: "10.1.0.0/16"},
+			{IP: "10.1.2.0/24", ExpireTime: now.Add(time.Hour)},
+		},
+		limits: []*admindb.LimitUserLoginIP{
+			{UserID: "expired", IP: "10.0.0.1", ExpireTime: now.Add(-time.Minute)},
+			{UserID: "limited", IP: "10.0.0.1", ExpireTime: now.Add(time.Hour)},
+			{UserID: "limited", IP: "10.0.0.2", ExpireTime: now.Add(-time.Minute)},
+		},
+	}
+	o := &adminServer{Database: db}
+	if err := o.loadIPRules(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		ip   string
+		want []string
+	}{
+		{ip: "10.1.2.3", want: []string{"10.1.0.0/16", "10.1.2.0/24"}},
+		{ip: "10.1.9.9", want: []string{"10.1.0.0/16"}},
+		{ip: "10.2.0.1", want: nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, forbidden := range o.matchIPForbidden(tt.ip) {
+			got = append(got, forbidden.IP)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("matchIPForbidden(%s) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+
+	// expired after the last reload
+	db.forbiddens[2].ExpireTime = now.Add(-time.Second)
+	if got := o.matchIPForbidden("10.1.2.3"); len(got) != 1 || got[0].IP != "10.1.0.0/16" {
+		t.Errorf("rule expired since the reload still matched: %v", got)
+	}
+
+	ipTests := []struct {
+		userID string
+		ip     string
+		ok     bool
+	}{
+		{userID: "expired", ip: "192.168.0.1", ok: true},
+		{userID: "limited", ip: "10.0.0.1", ok: true},
+		{userID: "limited", ip: "10.0.0.2", ok: false},
+		{userID: "nobody", ip: "10.0.0.2", ok: true},
+	}
+	for _, tt := range ipTests {
+		if ok := o.userLoginIPAllowed(tt.userID, tt.ip); ok != tt.ok {
+			t.Errorf("userLoginIPAllowed(%s, %s) = %v, want %v", tt.userID, tt.ip, ok, tt.ok)
+		}
+	}
+	db.limits[1].ExpireTime = now.Add(-time.Second)
+	if !o.userLoginIPAllowed("limited", "10.0.0.2") {
+		t.Error("user whose every login IP rule expired since the reload still limited")
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+func (o *adminServer) SearchLoginLock(ctx context.Context, req *admin.SearchLoginLockReq) (*admin.SearchLoginLockResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermUsersRead); err != nil {
+		return nil, err
+	}
+	var (
+		total int64
+		locks []*cache.LoginLock
+		err   error
+	)
+	if len(req.Targets) > 0 {
+		locks, err = o.Database.GetLoginLocks(ctx, req.Admin, req.Targets)
+		total = int64(len(locks))
+	} else {
+		total, locks, err = o.Database.SearchLoginLocks(ctx, req.Admin, req.Pagination)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SearchLoginLockResp{
+		Total: uint32(total),
+		Locks: datautil.Slice(locks, func(lock *cache.LoginLock) *admin.LoginLock {
+			res := &admin.LoginLock{
+				Target:   lock.Target,
+				Failures: lock.Failures,
+				Level:    lock.Level,
+			}
+			if !lock.LockUntil.IsZero() {
+				res.LockUntil = lock.LockUntil.UnixMilli()
+			}
+			return res
+		}),
+	}, nil
+}
+
+func (o *adminServer) ClearLoginLock(ctx context.Context, req *admin.ClearLoginLockReq) (*admin.ClearLoginLockResp, error) {
+	opUserID, err := o.checkPermission(ctx, constant.PermUsersBlock)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.ClearLoginLocks(ctx, req.Admin, req.Targets); err != nil {
+		return nil, err
+	}
+	log.ZInfo(ctx, "clear login lock", "opUserID", opUserID, "admin", req.Admin, "targets", req.Targets)
+	return &admin.ClearLoginLockResp{}, nil
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/openimsdk/tools/errs"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
@@ -28,7 +29,7 @@ import (
 )
 
 func (o *adminServer) AddDefaultFriend(ctx context.Context, req *admin.AddDefaultFriendReq) (*admin.AddDefaultFriendResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermDefaultManage); err != nil {
 		return nil, err
 	}
 	if len(req.UserIDs) == 0 {
@@ -66,7 +67,7 @@ func (o *adminServer) AddDefaultFriend(ctx context.Context, req *admin.AddDefaul
 }
 
 func (o *adminServer) DelDefaultFriend(ctx context.Context, req *admin.DelDefaultFriendReq) (*admin.DelDefaultFriendResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermDefaultManage); err != nil {
 		return nil, err
 	}
 	if len(req.UserIDs) == 0 {
@@ -108,7 +109,7 @@ func (o *adminServer) FindDefaultFriend(ctx context.Context, req *admin.FindDefa
 }
 
 func (o *adminServer) SearchDefaultFriend(ctx context.Context, req *admin.SearchDefaultFriendReq) (*admin.SearchDefaultFriendResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermDefaultManage); err != nil {
 		return nil, err
 	}
 	total, infos, err := o.Database.SearchDefaultFriend(ctx, req.Keyword, req.Pagination)
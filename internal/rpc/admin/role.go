@@ -0,0 +1,353 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// initRoles creates the built-in roles and keeps their permissions in step with the code.
+func (o *adminServer) initRoles(ctx context.Context) error {
+	roleIDs := datautil.Keys(constant.BuiltinRoles)
+	roles, err := o.Database.FindRole(ctx, roleIDs)
+	if err != nil {
+		return err
+	}
+	exist := datautil.SliceSetAny(roles, func(r *admindb.Role) string { return r.RoleID })
+	now := time.Now()
+	var create []*admindb.Role
+	for roleID, permissions := range constant.BuiltinRoles {
+		if _, ok := exist[roleID]; ok {
+			if err := o.Database.UpdateRole(ctx, roleID, map[string]any{"permissions": permissions, "builtin": true}); err != nil {
+				return err
+			}
+			continue
+		}
+		create = append(create, &admindb.Role{
+			RoleID:      roleID,
+			Name:        roleID,
+			Permissions: permissions,
+			Builtin:     true,
+			CreateTime:  now,
+			ChangeTime:  now,
+		})
+	}
+	if len(create) == 0 {
+		return nil
+	}
+	return o.Database.AddRole(ctx, create)
+}
+
+// adminPermissions returns the union of the permissions of the admin's roles.
+func (o *adminServer) adminPermissions(ctx context.Context, a *admindb.Admin) ([]string, error) {
+	if a.Level == constant.AdvancedUserLevel {
+		return constant.AllPermissions, nil
+	}
+	roleIDs := a.RoleIDs
+	if roleIDs == nil {
+		roleIDs = []string{constant.RoleAdmin}
+	}
+	roles, err := o.Database.FindRole(ctx, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+	var permissions []string
+	for _, role := range roles {
+		permissions = append(permissions, role.Permissions...)
+	}
+	return datautil.Distinct(permissions), nil
+}
+
+// checkPermission requires the op user to be an admin holding any of the permissions.
+func (o *adminServer) checkPermission(ctx context.Context, permissions ...string) (string, error) {
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return "", err
+	}
+	a, err := o.Database.GetAdminUserID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	has, err := o.adminPermissions(ctx, a)
+	if err != nil {
+		return "", err
+	}
+	for _, permission := range permissions {
+		if datautil.Contain(permission, has...) {
+			return userID, nil
+		}
+	}
+	return "", errs.ErrNoPermission.WrapMsg("permission denied", "permissions", permissions)
+}
+
+// checkGrant requires the op user opUserID to hold every one of the permissions, so that no
+// admin can hand out more than they have.
+func (o *adminServer) checkGrant(ctx context.Context, opUserID string, permissions []string) error {
+	a, err := o.Database.GetAdminUserID(ctx, opUserID)
+	if err != nil {
+		return err
+	}
+	has, err := o.adminPermissions(ctx, a)
+	if err != nil {
+		return err
+	}
+	var missing []string
+	for _, permission := range permissions {
+		if !datautil.Contain(permission, has...) {
+			missing = append(missing, permission)
+		}
+	}
+	if len(missing) > 0 {
+		return errs.ErrNoPermission.WrapMsg("permissions the operator does not hold", "permissions", datautil.Distinct(missing))
+	}
+	return nil
+}
+
+// checkAdmin requires the op user to be an existing admin.
+func (o *adminServer) checkAdmin(ctx context.Context) (string, error) {
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return "", err
+	}
+	if _, err := o.Database.GetAdminUserID(ctx, userID); err != nil {
+		return "", err
+	}
+	return userID, nil
+}
+
+func (o *adminServer) checkPermissionNames(permissions []string) error {
+	for _, permission := range permissions {
+		if !datautil.Contain(permission, constant.AllPermissions...) {
+			return errs.ErrArgs.WrapMsg("unknown permission " + permission)
+		}
+	}
+	return nil
+}
+
+// CheckAdminPermission checks the permissions of the op user. With audit, the operation is
+// recorded before the caller runs it and the check fails when it cannot be, a refused check is
+// recorded too.
+func (o *adminServer) CheckAdminPermission(ctx context.Context, req *admin.CheckAdminPermissionReq) (*admin.CheckAdminPermissionResp, error) {
+	var (
+		userID string
+		err    error
+	)
+	if len(req.Permissions) == 0 {
+		userID, err = o.checkAdmin(ctx)
+	} else {
+		userID, err = o.checkPermission(ctx, req.Permissions...)
+	}
+	if req.Audit == nil {
+		if err != nil {
+			return nil, err
+		}
+		return &admin.CheckAdminPermissionResp{UserID: userID}, nil
+	}
+	if err != nil {
+		if opUserID, adminErr := mctx.CheckAdmin(ctx); adminErr == nil {
+			if err := o.auditOperation(ctx, opUserID, req.Audit, err); err != nil {
+				log.ZError(ctx, "audit refused operation failed", err, "action", req.Audit.Action)
+			}
+		}
+		return nil, err
+	}
+	if err := o.auditOperation(ctx, userID, req.Audit, nil); err != nil {
+		return nil, errs.WrapMsg(err, "audit log unavailable, operation refused")
+	}
+	return &admin.CheckAdminPermissionResp{UserID: userID}, nil
+}
+
+func (o *adminServer) ListPermission(ctx context.Context, req *admin.ListPermissionReq) (*admin.ListPermissionResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return &admin.ListPermissionResp{Permissions: constant.AllPermissions}, nil
+}
+
+func (o *adminServer) AddRole(ctx context.Context, req *admin.AddRoleReq) (*admin.AddRoleResp, error) {
+	opUserID, err := o.checkPermission(ctx, constant.PermRolesManage)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkPermissionNames(req.Permissions); err != nil {
+		return nil, err
+	}
+	if err := o.checkGrant(ctx, opUserID, req.Permissions); err != nil {
+		return nil, err
+	}
+	roles, err := o.Database.FindRole(ctx, []string{req.RoleID})
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) > 0 {
+		return nil, errs.ErrDuplicateKey.WrapMsg("role already exists")
+	}
+	now := time.Now()
+	role := &admindb.Role{
+		RoleID:      req.RoleID,
+		Name:        req.Name,
+		Permissions: datautil.Distinct(req.Permissions),
+		Note:        req.Note,
+		CreateTime:  now,
+		ChangeTime:  now,
+	}
+	if err := o.Database.AddRole(ctx, []*admindb.Role{role}); err != nil {
+		return nil, err
+	}
+	return &admin.AddRoleResp{}, nil
+}
+
+func (o *adminServer) UpdateRole(ctx context.Context, req *admin.UpdateRoleReq) (*admin.UpdateRoleResp, error) {
+	opUserID, err := o.checkPermission(ctx, constant.PermRolesManage)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := constant.BuiltinRoles[req.RoleID]; ok {
+		return nil, errs.ErrNoPermission.WrapMsg("built-in roles cannot be changed")
+	}
+	roles, err := o.Database.FindRole(ctx, []string{req.RoleID})
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return nil, errs.ErrArgs.WrapMsg("role not found")
+	}
+	// changing a role changes the admins holding it, the operator needs the permissions of both
+	permissions := append(append([]string{}, roles[0].Permissions...), req.Permissions...)
+	if err := o.checkGrant(ctx, opUserID, permissions); err != nil {
+		return nil, err
+	}
+	update := map[string]any{"change_time": time.Now()}
+	if req.Name != nil {
+		update["name"] = req.Name.Value
+	}
+	if req.Note != nil {
+		update["note"] = req.Note.Value
+	}
+	if len(req.Permissions) > 0 {
+		if err := o.checkPermissionNames(req.Permissions); err != nil {
+			return nil, err
+		}
+		update["permissions"] = datautil.Distinct(req.Permissions)
+	}
+	if err := o.Database.UpdateRole(ctx, req.RoleID, update); err != nil {
+		return nil, err
+	}
+	return &admin.UpdateRoleResp{}, nil
+}
+
+func (o *adminServer) DelRole(ctx context.Context, req *admin.DelRoleReq) (*admin.DelRoleResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermRolesManage); err != nil {
+		return nil, err
+	}
+	for _, roleID := range req.RoleIDs {
+		if _, ok := constant.BuiltinRoles[roleID]; ok {
+			return nil, errs.ErrNoPermission.WrapMsg("built-in roles cannot be deleted", "roleID", roleID)
+		}
+	}
+	used, err := o.Database.ExistAdminRole(ctx, req.RoleIDs)
+	if err != nil {
+		return nil, err
+	}
+	if used {
+		return nil, errs.ErrArgs.WrapMsg("role is still assigned to admins")
+	}
+	if err := o.Database.DelRole(ctx, req.RoleIDs); err != nil {
+		return nil, err
+	}
+	return &admin.DelRoleResp{}, nil
+}
+
+func (o *adminServer) SearchRole(ctx context.Context, req *admin.SearchRoleReq) (*admin.SearchRoleResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermRolesManage, constant.PermAdminsManage); err != nil {
+		return nil, err
+	}
+	total, roles, err := o.Database.SearchRole(ctx, req.Keyword, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.SearchRoleResp{
+		Total: uint32(total),
+		Roles: make([]*admin.Role, 0, len(roles)),
+	}
+	for _, role := range roles {
+		resp.Roles = append(resp.Roles, &admin.Role{
+			RoleID:      role.RoleID,
+			Name:        role.Name,
+			Permissions: role.Permissions,
+			Note:        role.Note,
+			Builtin:     role.Builtin,
+			CreateTime:  role.CreateTime.UnixMilli(),
+			ChangeTime:  role.ChangeTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+// checkRoleIDs makes sure every role exists and that the op user opUserID holds all of their
+// permissions.
+func (o *adminServer) checkRoleIDs(ctx context.Context, opUserID string, roleIDs []string) error {
+	if datautil.Duplicate(roleIDs) {
+		return errs.ErrArgs.WrapMsg("role ids is duplicate")
+	}
+	roles, err := o.Database.FindRole(ctx, roleIDs)
+	if err != nil {
+		return err
+	}
+	if len(roles) != len(roleIDs) {
+		return errs.ErrArgs.WrapMsg("role not found")
+	}
+	var permissions []string
+	for _, role := range roles {
+		permissions = append(permissions, role.Permissions...)
+	}
+	return o.checkGrant(ctx, opUserID, permissions)
+}
+
+func (o *adminServer) AssignAdminRole(ctx context.Context, req *admin.AssignAdminRoleReq) (*admin.AssignAdminRoleResp, error) {
+	opUserID, err := o.checkPermission(ctx, constant.PermRolesManage)
+	if err != nil {
+		return nil, err
+	}
+	target, err := o.Database.GetAdminUserID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	// the roles of an admin holding more than the operator are out of reach
+	has, err := o.adminPermissions(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkGrant(ctx, opUserID, has); err != nil {
+		return nil, err
+	}
+	if err := o.checkRoleIDs(ctx, opUserID, req.RoleIDs); err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateAdmin(ctx, req.UserID, map[string]any{"role_ids": req.RoleIDs}); err != nil {
+		return nil, err
+	}
+	return &admin.AssignAdminRoleResp{}, nil
+}
@@ -0,0 +1,178 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/db/database"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// roleDB holds admins and roles in memory.
+type roleDB struct {
+	database.AdminDatabaseInterface
+	admins map[string]*admindb.Admin
+	roles  map[string]*admindb.Role
+}
+
+func newRoleDB() *roleDB {
+	d := &roleDB{admins: make(map[string]*admindb.Admin), roles: make(map[string]*admindb.Role)}
+	for roleID, permissions := range map[string][]string{
+		"helpdesk":         {constant.PermUsersRead, constant.PermRolesManage},
+		"moderator":        {constant.PermUsersRead, constant.PermUsersBlock},
+		"owner":            constant.AllPermissions,
+		constant.RoleAdmin: constant.BuiltinRoles[constant.RoleAdmin],
+	} {
+		d.roles[roleID] = &admindb.Role{RoleID: roleID, Permissions: permissions}
+	}
+	d.admins["super"] = &admindb.Admin{UserID: "super", Level: constant.AdvancedUserLevel}
+	d.admins["lead"] = &admindb.Admin{UserID: "lead", Level: constant.NormalAdmin, RoleIDs: []string{"helpdesk", "moderator"}}
+	d.admins["helper"] = &admindb.Admin{UserID: "helper", Level: constant.NormalAdmin, RoleIDs: []string{"helpdesk"}}
+	d.admins["mod"] = &admindb.Admin{UserID: "mod", Level: constant.NormalAdmin, RoleIDs: []string{"moderator"}}
+	return d
+}
+
+func (d *roleDB) GetAdminUserID(ctx context.Context, userID string) (*admindb.Admin, error) {
+	if a, ok := d.admins[userID]; ok {
+		return a, nil
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (d *roleDB) FindRole(ctx context.Context, roleIDs []string) ([]*admindb.Role, error) {
+	var roles []*admindb.Role
+	for _, roleID := range roleIDs {
+		if role, ok := d.roles[roleID]; ok {
+			roles = append(roles, role)
+		}
+	}
+	return roles, nil
+}
+
+func (d *roleDB) UpdateAdmin(ctx context.Context, userID string, update map[string]any) error {
+	d.admins[userID].RoleIDs = update["role_ids"].([]string)
+	return nil
+}
+
+func (d *roleDB) AddRole(ctx context.Context, roles []*admindb.Role) error {
+	for _, role := range roles {
+		d.roles[role.RoleID] = role
+	}
+	return nil
+}
+
+func (d *roleDB) UpdateRole(ctx context.Context, roleID string, update map[string]any) error {
+	if permissions, ok := update["permissions"].([]string); ok {
+		d.roles[roleID].Permissions = permissions
+	}
+	return nil
+}
+
+func (d *roleDB) GetSessions(ctx context.Context, userID string) ([]*cache.Session, error) {
+	return nil, nil
+}
+
+func TestAssignAdminRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		op      string
+		userID  string
+		roleIDs []string
+		ok      bool
+	}{
+		{name: "target holds more", op: "helper", userID: "mod", roleIDs: []string{"helpdesk"}, ok: false}, // mod holds users.block
+		{name: "subset", op: "lead", userID: "helper", roleIDs: []string{"helpdesk", "moderator"}, ok: true},
+		{name: "escalate self", op: "helper", userID: "helper", roleIDs: []string{"owner"}, ok: false},
+		{name: "escalate other", op: "lead", userID: "helper", roleIDs: []string{"owner"}, ok: false},
+		{name: "super admin", op: "super", userID: "helper", roleIDs: []string{"owner"}, ok: true},
+		{name: "unknown role", op: "super", userID: "helper", roleIDs: []string{"nobody"}, ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &adminServer{Database: newRoleDB()}
+			ctx := mctx.WithAdminUser(context.Background(), tt.op)
+			_, err := o.AssignAdminRole(ctx, &admin.AssignAdminRoleReq{UserID: tt.userID, RoleIDs: tt.roleIDs})
+			if (err == nil) != tt.ok {
+				t.Fatalf("err = %v, want ok %v", err, tt.ok)
+			}
+		})
+	}
+}
+
+func TestRolePermissionsWithinOperator(t *testing.T) {
+	o := &adminServer{Database: newRoleDB()}
+	ctx := mctx.WithAdminUser(context.Background(), "helper")
+	if _, err := o.AddRole(ctx, &admin.AddRoleReq{RoleID: "reader", Permissions: []string{constant.PermUsersRead}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := o.AddRole(ctx, &admin.AddRoleReq{RoleID: "admins", Permissions: []string{constant.PermAdminsManage}}); err == nil {
+		t.Fatal("role created with a permission the operator does not hold")
+	}
+	if _, err := o.UpdateRole(ctx, &admin.UpdateRoleReq{RoleID: "reader", Permissions: []string{constant.PermUsersRead, constant.PermRolesManage}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := o.UpdateRole(ctx, &admin.UpdateRoleReq{RoleID: "helpdesk", Permissions: constant.AllPermissions}); err == nil {
+		t.Fatal("own role extended with permissions the operator does not hold")
+	}
+	if _, err := o.UpdateRole(ctx, &admin.UpdateRoleReq{RoleID: "moderator", Permissions: []string{constant.PermUsersRead}}); err == nil {
+		t.Fatal("role holding permissions the operator does not hold changed")
+	}
+	if err := o.checkRoleIDs(ctx, "helper", []string{constant.RoleAdmin}); err == nil {
+		t.Fatal("admin account creatable with the built-in admin role")
+	}
+}
+
+func TestUserSessionsPermission(t *testing.T) {
+	o := &adminServer{Database: newRoleDB()}
+	user := mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser)
+	if _, err := o.GetUserSessions(user, &admin.GetUserSessionsReq{UserID: "u1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := o.GetUserSessions(user, &admin.GetUserSessionsReq{UserID: "u2"}); err == nil {
+		t.Fatal("user listed the sessions of another user")
+	}
+	if _, err := o.GetUserSessions(mctx.WithAdminUser(context.Background(), "mod"), &admin.GetUserSessionsReq{UserID: "u1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := o.RevokeUserSession(mctx.WithAdminUser(context.Background(), "helper"), &admin.RevokeUserSessionReq{UserID: "u1", SessionID: "s1"}); err == nil {
+		t.Fatal("admin without users.block revoked a session")
+	}
+}
+
+// The configuration holds the token, audit log, OpenIM and provider secrets, only roles that may change it read it.
+func TestBuiltinRolesReadingConfig(t *testing.T) {
+	d := newRoleDB()
+	for roleID, permissions := range constant.BuiltinRoles {
+		d.roles[roleID] = &admindb.Role{RoleID: roleID, Permissions: permissions}
+		d.admins[roleID] = &admindb.Admin{UserID: roleID, Level: constant.NormalAdmin, RoleIDs: []string{roleID}}
+	}
+	o := &adminServer{Database: d}
+	for roleID := range constant.BuiltinRoles {
+		ctx := mctx.WithAdminUser(context.Background(), roleID)
+		_, err := o.checkPermission(ctx, constant.PermConfigRead, constant.PermConfigWrite)
+		if want := roleID == constant.RoleAdmin; (err == nil) != want {
+			t.Errorf("%s reads the configuration: %v, want %v", roleID, err == nil, want)
+		}
+	}
+}
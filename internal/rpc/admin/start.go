@@ -5,14 +5,19 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"math/rand"
+	"sync/atomic"
 	"time"
 
 	"github.com/openimsdk/chat/pkg/common/config"
 	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
 	"github.com/openimsdk/chat/pkg/common/db/database"
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	"github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/imapi"
+	"github.com/openimsdk/chat/pkg/common/passwd"
 	"github.com/openimsdk/chat/pkg/common/tokenverify"
+	"github.com/openimsdk/chat/pkg/geoip"
 	adminpb "github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 	chatClient "github.com/openimsdk/chat/pkg/rpclient/chat"
@@ -52,6 +57,10 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		return err
 	}
 	var srv adminServer
+	srv.Passwd, err = passwd.New(config.RpcConfig.PasswordHash)
+	if err != nil {
+		return err
+	}
 	srv.Database, err = database.NewAdminDatabase(mgocli, rdb)
 	if err != nil {
 		return err
@@ -61,22 +70,95 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		return err
 	}
 	srv.Chat = chatClient.NewChatClient(chat.NewChatClient(conn))
+	srv.IM = imapi.New(config.Share.OpenIM.ApiURL, config.Share.OpenIM.Secret, config.Share.OpenIM.AdminUserID)
+	keys, err := tokenverify.NewKeySet(config.RpcConfig.TokenSigning)
+	if err != nil {
+		return err
+	}
 	srv.Token = &tokenverify.Token{
-		Expires: time.Duration(config.RpcConfig.TokenPolicy.Expire) * time.Hour * 24,
-		Secret:  config.RpcConfig.Secret,
+		Expires:     time.Duration(config.RpcConfig.TokenPolicy.Expire) * time.Hour * 24,
+		UserExpires: time.Duration(config.RpcConfig.TokenPolicy.AccessExpire) * time.Minute,
+		Secret:      config.RpcConfig.Secret,
+		Keys:        keys,
+	}
+	srv.TOTP = config.RpcConfig.TOTP
+	switch srv.TOTP.Require {
+	case "":
+		srv.TOTP.Require = constant.AdminTOTPRequireNone
+	case constant.AdminTOTPRequireNone, constant.AdminTOTPRequireSuper, constant.AdminTOTPRequireAll:
+	default:
+		return errs.New("invalid admin totp require policy", "require", srv.TOTP.Require)
+	}
+	if srv.TOTP.Issuer == "" {
+		srv.TOTP.Issuer = "OpenIM Admin"
+	}
+	if srv.TOTP.TicketExpire <= 0 {
+		srv.TOTP.TicketExpire = 300
+	}
+	if srv.TOTP.MaxAttempts <= 0 {
+		srv.TOTP.MaxAttempts = 5
+	}
+	srv.ImpersonationExpire = time.Duration(config.RpcConfig.Impersonation.Expire) * time.Minute
+	if srv.ImpersonationExpire <= 0 {
+		srv.ImpersonationExpire = 30 * time.Minute
+	}
+	srv.ImpersonationNotify = config.RpcConfig.Impersonation.Notify
+	srv.AppealTicketExpire = time.Duration(config.RpcConfig.Appeal.TicketExpire) * time.Second
+	if srv.AppealTicketExpire <= 0 {
+		srv.AppealTicketExpire = 30 * time.Minute
+	}
+	srv.LoginLock = cache.NewLoginLocker(srv.Database, cache.NewLoginLockPolicy(config.RpcConfig.LoginLock), "account")
+	srv.AuditKey = []byte(config.RpcConfig.AuditLog.HashKey)
+	if len(srv.AuditKey) == 0 {
+		srv.AuditKey = []byte(config.RpcConfig.Secret)
 	}
+	if file := config.RpcConfig.GeoIP.File; file != "" {
+		srv.GeoIP, err = geoip.Open(file)
+		if err != nil {
+			return err
+		}
+	}
+	if err := srv.loadIPRules(ctx); err != nil {
+		return err
+	}
+	refresh := time.Duration(config.RpcConfig.IPRule.Refresh) * time.Second
+	if refresh <= 0 {
+		refresh = 30 * time.Second
+	}
+	go srv.refreshIPRules(refresh)
+	blockExpire := time.Duration(config.RpcConfig.Block.ExpireCheck) * time.Second
+	if blockExpire <= 0 {
+		blockExpire = time.Minute
+	}
+	srv.BlockMuteGroups = config.RpcConfig.Block.MuteGroups
+	go srv.expireBlocks(blockExpire)
 	if err := srv.initAdmin(ctx, config.Share.ChatAdmin, config.Share.OpenIM.AdminUserID); err != nil {
 		return err
 	}
+	if err := srv.initRoles(ctx); err != nil {
+		return err
+	}
 	adminpb.RegisterAdminServer(server, &srv)
 	return nil
 }
 
 type adminServer struct {
 	adminpb.UnimplementedAdminServer
-	Database database.AdminDatabaseInterface
-	Chat     *chatClient.ChatClient
-	Token    *tokenverify.Token
+	Database  database.AdminDatabaseInterface
+	Chat      *chatClient.ChatClient
+	IM        imapi.CallerInterface
+	Token     *tokenverify.Token
+	Passwd    *passwd.Hasher
+	TOTP      config.AdminTOTP
+	LoginLock *cache.LoginLocker
+	GeoIP     *geoip.Reader
+	ipRules   atomic.Pointer[ipRules]
+
+	ImpersonationExpire time.Duration
+	ImpersonationNotify bool
+	AppealTicketExpire  time.Duration
+	BlockMuteGroups     bool   // mute blocked users in their groups
+	AuditKey            []byte // of the audit log HMAC chain
 }
 
 func (o *adminServer) initAdmin(ctx context.Context, admins []string, imUserID string) error {
@@ -87,10 +169,14 @@ func (o *adminServer) initAdmin(ctx context.Context, admins []string, imUserID s
 			return err
 		}
 		sum := md5.Sum([]byte(account))
+		password, err := o.Passwd.Hash(hex.EncodeToString(sum[:]))
+		if err != nil {
+			return err
+		}
 		a := admin.Admin{
 			Account:    account,
 			UserID:     imUserID,
-			Password:   hex.EncodeToString(sum[:]),
+			Password:   password,
 			Level:      constant.DefaultAdminLevel,
 			CreateTime: time.Now(),
 		}
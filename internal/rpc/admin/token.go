@@ -16,34 +16,138 @@ package admin
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
 
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/redis/go-redis/v9"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/eerrs"
 	adminpb "github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/tools/log"
 )
 
+// sessionTouchInterval limits how often ParseToken writes the last seen time of a session.
+const sessionTouchInterval = time.Minute
+
 func (o *adminServer) CreateToken(ctx context.Context, req *adminpb.CreateTokenReq) (*adminpb.CreateTokenResp, error) {
-	token, expire, err := o.Token.CreateToken(req.UserID, req.UserType)
+	sessionID, err := randomToken(16)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	session := &cache.Session{
+		SessionID:  sessionID,
+		Platform:   req.Platform,
+		DeviceID:   req.DeviceID,
+		IP:         req.Ip,
+		CreateTime: now,
+		ExpireTime: now.Add(o.Token.Expires),
+	}
+	return o.issueToken(ctx, req.UserID, req.UserType, session)
+}
+
+// issueToken signs a new token for session, replacing the token the session held before.
+// User tokens are short-lived and come with a refresh token of the same family, which never
+// outlives the expire time set when the session was created.
+func (o *adminServer) issueToken(ctx context.Context, userID string, userType int32, session *cache.Session) (*adminpb.CreateTokenResp, error) {
+	token, expire, err := o.Token.CreateToken(userID, userType, session.SessionID)
 
 	if err != nil {
 		return nil, err
 	}
-	err = o.Database.CacheToken(ctx, req.UserID, token, expire)
+	err = o.Database.CacheToken(ctx, userID, token, expire)
 	if err != nil {
 		return nil, err
 	}
+	if session.Token != "" {
+		if err := o.Database.DeleteTokens(ctx, userID, session.Token); err != nil {
+			return nil, err
+		}
+	}
+	var refreshToken string
+	if userType == constant.NormalUser && o.Token.UserExpires > 0 {
+		refreshToken, err = randomToken(32)
+		if err != nil {
+			return nil, err
+		}
+		expire = time.Until(session.ExpireTime)
+		if expire <= 0 {
+			return nil, eerrs.ErrRefreshTokenInvalid.WrapMsg("refresh token family expired")
+		}
+		rt := &cache.RefreshToken{UserID: userID, SessionID: session.SessionID}
+		if err := o.Database.SetRefreshToken(ctx, refreshToken, rt, expire); err != nil {
+			return nil, err
+		}
+	}
+	session.Token = token
+	session.LastSeenTime = time.Now()
+	if err := o.Database.SetSession(ctx, userID, session); err != nil {
+		return nil, err
+	}
 	return &adminpb.CreateTokenResp{
-		Token: token,
+		Token:        token,
+		RefreshToken: refreshToken,
 	}, nil
 }
 
+func (o *adminServer) RefreshToken(ctx context.Context, req *adminpb.RefreshTokenReq) (*adminpb.RefreshTokenResp, error) {
+	rt, reused, err := o.Database.UseRefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if rt == nil {
+		return nil, eerrs.ErrRefreshTokenInvalid.Wrap()
+	}
+	session, err := o.Database.GetSession(ctx, rt.UserID, rt.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if reused {
+		// an old refresh token came back, so one of its holders is not the user: drop the whole family
+		log.ZWarn(ctx, "refresh token reused, revoke session", nil, "userID", rt.UserID, "sessionID", rt.SessionID)
+		if session != nil {
+			if err := o.Database.RevokeSession(ctx, rt.UserID, session); err != nil {
+				return nil, err
+			}
+		}
+		return nil, eerrs.ErrRefreshTokenReused.Wrap()
+	}
+	if session == nil {
+		return nil, eerrs.ErrRefreshTokenInvalid.WrapMsg("session revoked")
+	}
+	if !time.Now().Before(session.ExpireTime) {
+		return nil, eerrs.ErrRefreshTokenInvalid.WrapMsg("refresh token family expired")
+	}
+	resp, err := o.issueToken(ctx, rt.UserID, constant.NormalUser, session)
+	if err != nil {
+		return nil, err
+	}
+	return &adminpb.RefreshTokenResp{
+		Token:        resp.Token,
+		RefreshToken: resp.RefreshToken,
+	}, nil
+}
+
+func (o *adminServer) GetJWKS(ctx context.Context, req *adminpb.GetJWKSReq) (*adminpb.GetJWKSResp, error) {
+	jwks, err := o.Token.JWKS()
+	if err != nil {
+		return nil, err
+	}
+	return &adminpb.GetJWKSResp{Jwks: string(jwks)}, nil
+}
+
 func (o *adminServer) ParseToken(ctx context.Context, req *adminpb.ParseTokenReq) (*adminpb.ParseTokenResp, error) {
-	userID, userType, err := o.Token.GetToken(req.Token)
+	info, err := o.Token.Parse(req.Token)
 	if err != nil {
 		return nil, err
 	}
+	userID := info.UserID
 	m, err := o.Database.GetTokens(ctx, userID)
 	if err != nil && err != redis.Nil {
 		return nil, err
@@ -54,10 +158,14 @@ func (o *adminServer) ParseToken(ctx context.Context, req *adminpb.ParseTokenReq
 	if _, ok := m[req.Token]; !ok {
 		return nil, eerrs.ErrTokenNotExist.Wrap()
 	}
+	if info.SessionID != "" {
+		o.touchSession(ctx, userID, info.SessionID)
+	}
 
 	return &adminpb.ParseTokenResp{
-		UserID:   userID,
-		UserType: userType,
+		UserID:       userID,
+		UserType:     info.UserType,
+		Impersonator: info.Impersonator,
 	}, nil
 }
 
@@ -77,3 +185,87 @@ func (o *adminServer) InvalidateToken(ctx context.Context, req *adminpb.Invalida
 	log.ZDebug(ctx, "delete token from redis", "userID", req.UserID)
 	return &adminpb.InvalidateTokenResp{}, nil
 }
+
+// checkUserOrPermission lets users reach their own sessions, admins need any of the permissions.
+func (o *adminServer) checkUserOrPermission(ctx context.Context, userID string, permissions ...string) error {
+	opUserID, userType, err := mctx.Check(ctx)
+	if err != nil {
+		return err
+	}
+	if opUserID == userID {
+		return nil
+	}
+	if userType != constant.AdminUser {
+		return errs.ErrNoPermission.WrapMsg("not admin or not the user")
+	}
+	_, err = o.checkPermission(ctx, permissions...)
+	return err
+}
+
+func (o *adminServer) GetUserSessions(ctx context.Context, req *adminpb.GetUserSessionsReq) (*adminpb.GetUserSessionsResp, error) {
+	if err := o.checkUserOrPermission(ctx, req.UserID, constant.PermUsersRead); err != nil {
+		return nil, err
+	}
+	sessions, err := o.Database.GetSessions(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &adminpb.GetUserSessionsResp{
+		Sessions: datautil.Slice(sessions, toPbUserSession),
+	}, nil
+}
+
+func (o *adminServer) RevokeUserSession(ctx context.Context, req *adminpb.RevokeUserSessionReq) (*adminpb.RevokeUserSessionResp, error) {
+	if err := o.checkUserOrPermission(ctx, req.UserID, constant.PermUsersBlock); err != nil {
+		return nil, err
+	}
+	session, err := o.Database.GetSession(ctx, req.UserID, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, errs.ErrRecordNotFound.WrapMsg("session not found")
+	}
+	if err := o.Database.RevokeSession(ctx, req.UserID, session); err != nil {
+		return nil, err
+	}
+	log.ZDebug(ctx, "revoke session", "userID", req.UserID, "sessionID", req.SessionID)
+	return &adminpb.RevokeUserSessionResp{Session: toPbUserSession(session)}, nil
+}
+
+// touchSession refreshes the last seen time of a session, at most once per sessionTouchInterval.
+func (o *adminServer) touchSession(ctx context.Context, userID string, sessionID string) {
+	session, err := o.Database.GetSession(ctx, userID, sessionID)
+	if err != nil {
+		log.ZWarn(ctx, "get session failed", err, "userID", userID, "sessionID", sessionID)
+		return
+	}
+	if session == nil || time.Since(session.LastSeenTime) < sessionTouchInterval {
+		return
+	}
+	session.LastSeenTime = time.Now()
+	if err := o.Database.SetSession(ctx, userID, session); err != nil {
+		log.ZWarn(ctx, "update session last seen time failed", err, "userID", userID, "sessionID", sessionID)
+	}
+}
+
+func randomToken(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func toPbUserSession(session *cache.Session) *adminpb.UserSession {
+	return &adminpb.UserSession{
+		SessionID:    session.SessionID,
+		Platform:     session.Platform,
+		DeviceID:     session.DeviceID,
+		Ip:           session.IP,
+		CreateTime:   session.CreateTime.UnixMilli(),
+		LastSeenTime: session.LastSeenTime.UnixMilli(),
+		ExpireTime:   session.ExpireTime.UnixMilli(),
+		Impersonator: session.Impersonator,
+	}
+}
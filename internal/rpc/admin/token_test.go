@@ -0,0 +1,122 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/db/database"
+	"github.com/openimsdk/chat/pkg/common/tokenverify"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+type refreshToken struct {
+	rt     *cache.RefreshToken
+	expire time.Duration
+	used   bool
+}
+
+// tokenDB holds sessions and refresh tokens in memory.
+type tokenDB struct {
+	database.AdminDatabaseInterface
+	sessions map[string]*cache.Session
+	refresh  map[string]*refreshToken
+}
+
+func newTokenDB() *tokenDB {
+	return &tokenDB{sessions: make(map[string]*cache.Session), refresh: make(map[string]*refreshToken)}
+}
+
+func (d *tokenDB) CacheToken(ctx context.Context, userID string, token string, expire time.Duration) error {
+	return nil
+}
+
+func (d *tokenDB) DeleteTokens(ctx context.Context, userID string, tokens ...string) error {
+	return nil
+}
+
+func (d *tokenDB) SetSession(ctx context.Context, userID string, session *cache.Session) error {
+	s := *session
+	d.sessions[session.SessionID] = &s
+	return nil
+}
+
+func (d *tokenDB) GetSession(ctx context.Context, userID string, sessionID string) (*cache.Session, error) {
+	s, ok := d.sessions[sessionID]
+	if !ok {
+		return nil, nil
+	}
+	c := *s
+	return &c, nil
+}
+
+func (d *tokenDB) SetRefreshToken(ctx context.Context, token string, rt *cache.RefreshToken, expire time.Duration) error {
+	d.refresh[token] = &refreshToken{rt: rt, expire: expire}
+	return nil
+}
+
+func (d *tokenDB) UseRefreshToken(ctx context.Context, token string) (*cache.RefreshToken, bool, error) {
+	r, ok := d.refresh[token]
+	if !ok {
+		return nil, false, nil
+	}
+	reused := r.used
+	r.used = true
+	return r.rt, reused, nil
+}
+
+func TestRefreshTokenFamilyExpire(t *testing.T) {
+	ctx := context.Background()
+	db := newTokenDB()
+	o := &adminServer{
+		Database: db,
+		Token:    &tokenverify.Token{Expires: time.Hour, UserExpires: time.Minute, Secret: "secret"},
+	}
+	resp, err := o.CreateToken(ctx, &admin.CreateTokenReq{UserID: "u1", UserType: constant.NormalUser})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var session *cache.Session
+	for _, s := range db.sessions {
+		session = s
+	}
+	if until := time.Until(session.ExpireTime); until <= 59*time.Minute || until > time.Hour {
+		t.Fatalf("family expires in %s, want %s", until, time.Hour)
+	}
+
+	// the family is near its end: rotation keeps the original expiry
+	session.ExpireTime = time.Now().Add(10 * time.Minute)
+	familyExpire := session.ExpireTime
+	refreshed, err := o.RefreshToken(ctx, &admin.RefreshTokenReq{RefreshToken: resp.RefreshToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := db.sessions[session.SessionID].ExpireTime; !got.Equal(familyExpire) {
+		t.Fatalf("session expire time moved from %s to %s", familyExpire, got)
+	}
+	if expire := db.refresh[refreshed.RefreshToken].expire; expire > 10*time.Minute {
+		t.Fatalf("rotated refresh token lives %s past the family expiry", expire)
+	}
+
+	db.sessions[session.SessionID].ExpireTime = time.Now().Add(-time.Second)
+	if _, err := o.RefreshToken(ctx, &admin.RefreshTokenReq{RefreshToken: refreshed.RefreshToken}); !eerrs.ErrRefreshTokenInvalid.Is(err) {
+		t.Fatalf("refresh past the family expiry: got %v, want ErrRefreshTokenInvalid", err)
+	}
+}
@@ -0,0 +1,309 @@
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/common/totp"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// totpRequired reports whether the policy forces a second factor for a.
+func (o *adminServer) totpRequired(a *admindb.Admin) bool {
+	switch o.TOTP.Require {
+	case constant.AdminTOTPRequireAll:
+		return true
+	case constant.AdminTOTPRequireSuper:
+		return a.Level == constant.AdvancedUserLevel
+	default:
+		return false
+	}
+}
+
+func (o *adminServer) takeEnabledTOTP(ctx context.Context, userID string) (*admindb.AdminTOTP, error) {
+	t, err := o.Database.TakeAdminTOTP(ctx, userID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, errs.ErrArgs.WrapMsg("totp is not enabled")
+		}
+		return nil, err
+	}
+	if !t.Enabled {
+		return nil, errs.ErrArgs.WrapMsg("totp is not enabled")
+	}
+	return t, nil
+}
+
+// checkTOTPCode accepts either a TOTP code or an unused recovery code, which is consumed.
+func (o *adminServer) checkTOTPCode(ctx context.Context, t *admindb.AdminTOTP, code string) error {
+	if counter, ok := totp.Validate(t.Secret, code, time.Now(), t.LastCounter); ok {
+		ok, err := o.Database.UpdateAdminTOTPCounter(ctx, t.UserID, counter)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return eerrs.ErrTOTPNotMatch.WrapMsg("code already used")
+		}
+		return nil
+	}
+	if totp.MatchRecoveryCode(t.RecoveryCodes, code) >= 0 {
+		ok, err := o.Database.UseAdminTOTPRecoveryCode(ctx, t.UserID, totp.HashRecoveryCode(code))
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+	}
+	return eerrs.ErrTOTPNotMatch.Wrap()
+}
+
+// enableTOTP turns on a pending enrollment with its first code and returns the recovery codes.
+func (o *adminServer) enableTOTP(ctx context.Context, t *admindb.AdminTOTP, code string) ([]string, error) {
+	counter, ok := totp.Validate(t.Secret, code, time.Now(), t.LastCounter)
+	if !ok {
+		return nil, eerrs.ErrTOTPNotMatch.Wrap()
+	}
+	codes, hashes, err := totp.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	err = o.Database.UpdateAdminTOTP(ctx, t.UserID, map[string]any{
+		"enabled":        true,
+		"recovery_codes": hashes,
+		"last_counter":   counter,
+		"enable_time":    time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// totpChallenge returns a login ticket when a needs a second factor, and whether a must enroll first.
+func (o *adminServer) totpChallenge(ctx context.Context, a *admindb.Admin, ip string) (string, bool, error) {
+	var enroll bool
+	t, err := o.Database.TakeAdminTOTP(ctx, a.UserID)
+	if err == nil {
+		enroll = !t.Enabled
+	} else if dbutil.IsDBNotFound(err) {
+		enroll = true
+	} else {
+		return "", false, err
+	}
+	if enroll && !o.totpRequired(a) {
+		return "", false, nil
+	}
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", false, errs.Wrap(err)
+	}
+	ticket := hex.EncodeToString(buf)
+	challenge := &cache.LoginChallenge{UserID: a.UserID, IP: ip}
+	if err := o.Database.SetLoginChallenge(ctx, ticket, challenge, time.Duration(o.TOTP.TicketExpire)*time.Second); err != nil {
+		return "", false, err
+	}
+	return ticket, enroll, nil
+}
+
+func (o *adminServer) LoginAdminTOTP(ctx context.Context, req *admin.LoginAdminTOTPReq) (*admin.LoginResp, error) {
+	challenge, err := o.Database.GetLoginChallenge(ctx, req.Ticket)
+	if err != nil {
+		return nil, err
+	}
+	if challenge == nil {
+		return nil, eerrs.ErrTOTPTicketInvalid.Wrap()
+	}
+	attempts, err := o.Database.IncrLoginChallengeAttempts(ctx, req.Ticket)
+	if err != nil {
+		return nil, err
+	}
+	if attempts > int64(o.TOTP.MaxAttempts) {
+		if err := o.Database.DelLoginChallenge(ctx, req.Ticket); err != nil {
+			return nil, err
+		}
+		return nil, eerrs.ErrTOTPTicketInvalid.WrapMsg("too many attempts")
+	}
+	a, err := o.Database.GetAdminUserID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.LoginLock.Check(ctx, a.Account, challenge.IP); err != nil {
+		return nil, err
+	}
+	t, err := o.Database.TakeAdminTOTP(ctx, a.UserID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, errs.ErrArgs.WrapMsg("totp is not enrolled")
+		}
+		return nil, err
+	}
+	var recoveryCodes []string
+	if t.Enabled {
+		if err := o.checkTOTPCode(ctx, t, req.Code); err != nil {
+			if errors.Is(err, eerrs.ErrTOTPNotMatch) {
+				return nil, o.LoginLock.Failed(ctx, a.Account, challenge.IP, err)
+			}
+			return nil, err
+		}
+		o.LoginLock.Succeeded(ctx, a.Account)
+	} else {
+		recoveryCodes, err = o.enableTOTP(ctx, t, req.Code)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := o.Database.DelLoginChallenge(ctx, req.Ticket); err != nil {
+		return nil, err
+	}
+	resp, err := o.completeLogin(ctx, a, challenge.IP)
+	if err != nil {
+		return nil, err
+	}
+	resp.RecoveryCodes = recoveryCodes
+	return resp, nil
+}
+
+func (o *adminServer) EnrollAdminTOTP(ctx context.Context, req *admin.EnrollAdminTOTPReq) (*admin.EnrollAdminTOTPResp, error) {
+	var userID string
+	if req.Ticket == "" {
+		var err error
+		userID, err = mctx.CheckAdmin(ctx)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		challenge, err := o.Database.GetLoginChallenge(ctx, req.Ticket)
+		if err != nil {
+			return nil, err
+		}
+		if challenge == nil {
+			return nil, eerrs.ErrTOTPTicketInvalid.Wrap()
+		}
+		userID = challenge.UserID
+	}
+	a, err := o.Database.GetAdminUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	t, err := o.Database.TakeAdminTOTP(ctx, userID)
+	if err == nil {
+		if t.Enabled {
+			return nil, errs.ErrArgs.WrapMsg("totp is already enabled")
+		}
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	err = o.Database.SetAdminTOTP(ctx, &admindb.AdminTOTP{
+		UserID:        userID,
+		Secret:        secret,
+		Enabled:       false,
+		RecoveryCodes: []string{},
+		CreateTime:    time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &admin.EnrollAdminTOTPResp{
+		Secret: secret,
+		Url:    totp.URL(o.TOTP.Issuer, a.Account, secret),
+	}, nil
+}
+
+func (o *adminServer) ConfirmAdminTOTP(ctx context.Context, req *admin.ConfirmAdminTOTPReq) (*admin.ConfirmAdminTOTPResp, error) {
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := o.Database.TakeAdminTOTP(ctx, userID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, errs.ErrArgs.WrapMsg("totp is not enrolled")
+		}
+		return nil, err
+	}
+	if t.Enabled {
+		return nil, errs.ErrArgs.WrapMsg("totp is already enabled")
+	}
+	codes, err := o.enableTOTP(ctx, t, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.ConfirmAdminTOTPResp{RecoveryCodes: codes}, nil
+}
+
+func (o *adminServer) DisableAdminTOTP(ctx context.Context, req *admin.DisableAdminTOTPReq) (*admin.DisableAdminTOTPResp, error) {
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a, err := o.Database.GetAdminUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if o.totpRequired(a) {
+		return nil, errs.ErrNoPermission.WrapMsg("totp is required by policy")
+	}
+	t, err := o.takeEnabledTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkTOTPCode(ctx, t, req.Code); err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelAdminTOTP(ctx, []string{userID}); err != nil {
+		return nil, err
+	}
+	return &admin.DisableAdminTOTPResp{}, nil
+}
+
+func (o *adminServer) RegenerateAdminTOTPRecoveryCodes(ctx context.Context, req *admin.RegenerateAdminTOTPRecoveryCodesReq) (*admin.RegenerateAdminTOTPRecoveryCodesResp, error) {
+	opUserID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID := req.UserID
+	if userID == "" {
+		userID = opUserID
+	}
+	if userID != opUserID {
+		if err := o.CheckSuperAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+	t, err := o.takeEnabledTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if userID == opUserID {
+		if req.Code == "" {
+			return nil, errs.ErrArgs.WrapMsg("code is empty")
+		}
+		if err := o.checkTOTPCode(ctx, t, req.Code); err != nil {
+			return nil, err
+		}
+	}
+	codes, hashes, err := totp.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateAdminTOTP(ctx, userID, map[string]any{"recovery_codes": hashes}); err != nil {
+		return nil, err
+	}
+	return &admin.RegenerateAdminTOTPRecoveryCodesResp{RecoveryCodes: codes}, nil
+}
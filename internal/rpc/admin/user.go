@@ -16,22 +16,27 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"github.com/openimsdk/protocol/wrapperspb"
 	"github.com/openimsdk/tools/utils/datautil"
+	"math"
 	"strings"
 	"time"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
 	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/mcontext"
+	"github.com/redis/go-redis/v9"
 )
 
 func (o *adminServer) CancellationUser(ctx context.Context, req *admin.CancellationUserReq) (*admin.CancellationUserResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermUsersWrite); err != nil {
 		return nil, err
 	}
 	empty := wrapperspb.String("")
@@ -43,31 +48,94 @@ func (o *adminServer) CancellationUser(ctx context.Context, req *admin.Cancellat
 }
 
 func (o *adminServer) BlockUser(ctx context.Context, req *admin.BlockUserReq) (*admin.BlockUserResp, error) {
-	_, err := mctx.CheckAdmin(ctx)
+	_, err := o.checkPermission(ctx, constant.PermUsersBlock)
 	if err != nil {
 		return nil, err
 	}
-	_, err = o.Database.GetBlockInfo(ctx, req.UserID)
+	now := time.Now()
+	expireTime := expireTimeFromMilli(req.ExpireTime)
+	if ruleExpired(expireTime, now) {
+		return nil, errs.ErrArgs.WrapMsg("expireTime is in the past")
+	}
+	block, err := o.Database.GetBlockInfo(ctx, req.UserID)
 	if err == nil {
-		return nil, errs.ErrArgs.WrapMsg("user already blocked")
+		if !block.Expired(now) {
+			return nil, eerrs.ErrAlreadyBlocked.WrapMsg("user already blocked")
+		}
+		// the expiry job has not lifted it yet
+		if _, err := o.liftExpiredBlock(ctx, block); err != nil {
+			return nil, err
+		}
 	} else if !dbutil.IsDBNotFound(err) {
 		return nil, err
 	}
-
+	category := req.Category
+	if category == "" {
+		category = constant.BlockCategoryOther
+	}
 	t := &admindb.ForbiddenAccount{
 		UserID:         req.UserID,
 		Reason:         req.Reason,
+		Category:       category,
 		OperatorUserID: mcontext.GetOpUserID(ctx),
-		CreateTime:     time.Now(),
+		ExpireTime:     expireTime,
+		CreateTime:     now,
 	}
 	if err := o.Database.BlockUser(ctx, []*admindb.ForbiddenAccount{t}); err != nil {
 		return nil, err
 	}
+	// drop every chat token and session, the next request of the user fails right away
+	if err := o.Database.DeleteToken(ctx, req.UserID); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+	if err := o.blockInIM(ctx, req.UserID, expireTime); err != nil {
+		return nil, err
+	}
 	return &admin.BlockUserResp{}, nil
 }
 
+// blockInIM signs a blocked user out of OpenIM and, if configured, mutes them in their groups until the block ends.
+func (o *adminServer) blockInIM(ctx context.Context, userID string, expireTime time.Time) error {
+	imToken, err := o.IM.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	imCtx := mctx.WithApiToken(ctx, imToken)
+	if err := o.IM.ForceOffLine(imCtx, userID); err != nil {
+		return err
+	}
+	if !o.BlockMuteGroups {
+		return nil
+	}
+	mutedSeconds := uint32(math.MaxUint32)
+	if !expireTime.IsZero() {
+		mutedSeconds = uint32(time.Until(expireTime).Seconds()) + 1
+	}
+	return o.IM.MuteInJoinedGroups(imCtx, userID, mutedSeconds)
+}
+
+// unblockInIM lifts the group mutes blockInIM set.
+func (o *adminServer) unblockInIM(ctx context.Context, userIDs ...string) error {
+	if !o.BlockMuteGroups {
+		return nil
+	}
+	imToken, err := o.IM.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	imCtx := mctx.WithApiToken(ctx, imToken)
+	var errList []error
+	for _, userID := range userIDs {
+		if err := o.IM.CancelMuteInJoinedGroups(imCtx, userID); err != nil {
+			errList = append(errList, err)
+		}
+	}
+	return errors.Join(errList...)
+}
+
 func (o *adminServer) UnblockUser(ctx context.Context, req *admin.UnblockUserReq) (*admin.UnblockUserResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	opUserID, err := o.checkPermission(ctx, constant.PermUsersBlock)
+	if err != nil {
 		return nil, err
 	}
 	if len(req.UserIDs) == 0 {
@@ -84,17 +152,20 @@ func (o *adminServer) UnblockUser(ctx context.Context, req *admin.UnblockUserReq
 		ids := datautil.Single(req.UserIDs, datautil.Slice(bs, func(info *admindb.ForbiddenAccount) string { return info.UserID }))
 		return nil, errs.ErrArgs.WrapMsg("user not blocked " + strings.Join(ids, ", "))
 	}
-	if err := o.Database.DelBlockUser(ctx, req.UserIDs); err != nil {
+	if err := o.Database.DelBlockUser(ctx, bs, opUserID); err != nil {
+		return nil, err
+	}
+	if err := o.unblockInIM(ctx, req.UserIDs...); err != nil {
 		return nil, err
 	}
 	return &admin.UnblockUserResp{}, nil
 }
 
 func (o *adminServer) SearchBlockUser(ctx context.Context, req *admin.SearchBlockUserReq) (*admin.SearchBlockUserResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermUsersRead); err != nil {
 		return nil, err
 	}
-	total, infos, err := o.Database.SearchBlockUser(ctx, req.Keyword, req.Pagination)
+	total, infos, err := o.Database.SearchBlockUser(ctx, req.Keyword, req.Category, req.State, req.Pagination)
 	if err != nil {
 		return nil, err
 	}
@@ -110,6 +181,8 @@ func (o *adminServer) SearchBlockUser(ctx context.Context, req *admin.SearchBloc
 			Reason:     info.Reason,
 			OpUserID:   info.OperatorUserID,
 			CreateTime: info.CreateTime.UnixMilli(),
+			Category:   info.Category,
+			ExpireTime: expireTimeMilli(info.ExpireTime),
 		}
 		if userFull := userMap[info.UserID]; userFull != nil {
 			user.Account = userFull.Account
@@ -126,7 +199,7 @@ func (o *adminServer) SearchBlockUser(ctx context.Context, req *admin.SearchBloc
 }
 
 func (o *adminServer) FindUserBlockInfo(ctx context.Context, req *admin.FindUserBlockInfoReq) (*admin.FindUserBlockInfoResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermUsersRead); err != nil {
 		return nil, err
 	}
 	list, err := o.Database.FindBlockUser(ctx, req.UserIDs)
@@ -140,7 +213,35 @@ func (o *adminServer) FindUserBlockInfo(ctx context.Context, req *admin.FindUser
 			Reason:     info.Reason,
 			OpUserID:   info.OperatorUserID,
 			CreateTime: info.CreateTime.UnixMilli(),
+			Category:   info.Category,
+			ExpireTime: expireTimeMilli(info.ExpireTime),
 		})
 	}
 	return &admin.FindUserBlockInfoResp{Blocks: blocks}, nil
 }
+
+func (o *adminServer) SearchBlockHistory(ctx context.Context, req *admin.SearchBlockHistoryReq) (*admin.SearchBlockHistoryResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermUsersRead); err != nil {
+		return nil, err
+	}
+	total, histories, err := o.Database.SearchBlockHistory(ctx, req.UserIDs, req.Action, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.SearchBlockHistoryResp{
+		Total:     uint32(total),
+		Histories: make([]*admin.BlockHistory, 0, len(histories)),
+	}
+	for _, history := range histories {
+		resp.Histories = append(resp.Histories, &admin.BlockHistory{
+			UserID:     history.UserID,
+			Action:     history.Action,
+			Reason:     history.Reason,
+			Category:   history.Category,
+			OpUserID:   history.OperatorUserID,
+			ExpireTime: expireTimeMilli(history.ExpireTime),
+			CreateTime: history.CreateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
@@ -20,14 +20,15 @@ import (
 
 	"github.com/openimsdk/tools/utils/datautil"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
-	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/common/iptrie"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/tools/errs"
 )
 
 func (o *adminServer) SearchUserIPLimitLogin(ctx context.Context, req *admin.SearchUserIPLimitLoginReq) (*admin.SearchUserIPLimitLoginResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermForbiddenManage); err != nil {
 		return nil, err
 	}
 	total, list, err := o.Database.SearchUserLimitLogin(ctx, req.Keyword, req.Pagination)
@@ -46,13 +47,15 @@ func (o *adminServer) SearchUserIPLimitLogin(ctx context.Context, req *admin.Sea
 			Ip:         info.IP,
 			CreateTime: info.CreateTime.UnixMilli(),
 			User:       userMap[info.UserID],
+			Note:       info.Note,
+			ExpireTime: expireTimeMilli(info.ExpireTime),
 		})
 	}
 	return &admin.SearchUserIPLimitLoginResp{Total: uint32(total), Limits: limits}, nil
 }
 
 func (o *adminServer) AddUserIPLimitLogin(ctx context.Context, req *admin.AddUserIPLimitLoginReq) (*admin.AddUserIPLimitLoginResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermForbiddenManage); err != nil {
 		return nil, err
 	}
 	if len(req.Limits) == 0 {
@@ -61,20 +64,30 @@ func (o *adminServer) AddUserIPLimitLogin(ctx context.Context, req *admin.AddUse
 	now := time.Now()
 	ts := make([]*admindb.LimitUserLoginIP, 0, len(req.Limits))
 	for _, limit := range req.Limits {
+		if limit.UserID == "" {
+			return nil, errs.ErrArgs.WrapMsg("user_id is empty")
+		}
+		ip, err := iptrie.Canonical(limit.Ip)
+		if err != nil {
+			return nil, err
+		}
 		ts = append(ts, &admindb.LimitUserLoginIP{
 			UserID:     limit.UserID,
-			IP:         limit.Ip,
+			IP:         ip,
+			Note:       limit.Note,
+			ExpireTime: expireTimeFromMilli(limit.ExpireTime),
 			CreateTime: now,
 		})
 	}
 	if err := o.Database.AddUserLimitLogin(ctx, ts); err != nil {
 		return nil, err
 	}
+	o.reloadIPRules(ctx)
 	return &admin.AddUserIPLimitLoginResp{}, nil
 }
 
 func (o *adminServer) DelUserIPLimitLogin(ctx context.Context, req *admin.DelUserIPLimitLoginReq) (*admin.DelUserIPLimitLoginResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermForbiddenManage); err != nil {
 		return nil, err
 	}
 	if len(req.Limits) == 0 {
@@ -87,11 +100,12 @@ func (o *adminServer) DelUserIPLimitLogin(ctx context.Context, req *admin.DelUse
 		}
 		ts = append(ts, &admindb.LimitUserLoginIP{
 			UserID: limit.UserID,
-			IP:     limit.Ip,
+			IP:     canonicalIPs([]string{limit.Ip})[0],
 		})
 	}
 	if err := o.Database.DelUserLimitLogin(ctx, ts); err != nil {
 		return nil, err
 	}
+	o.reloadIPRules(ctx)
 	return &admin.DelUserIPLimitLoginResp{}, nil
 }
@@ -0,0 +1,170 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/imapi"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// blockDB holds blocks in memory, on top of the admins and roles of roleDB.
+type blockDB struct {
+	*roleDB
+	blocks map[string]*admindb.ForbiddenAccount
+}
+
+func newBlockDB() *blockDB {
+	return &blockDB{roleDB: newRoleDB(), blocks: make(map[string]*admindb.ForbiddenAccount)}
+}
+
+func (d *blockDB) GetBlockInfo(ctx context.Context, userID string) (*admindb.ForbiddenAccount, error) {
+	if b, ok := d.blocks[userID]; ok {
+		return b, nil
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (d *blockDB) FindBlockInfo(ctx context.Context, userIDs []string) ([]*admindb.ForbiddenAccount, error) {
+	var bs []*admindb.ForbiddenAccount
+	for _, userID := range userIDs {
+		if b, ok := d.blocks[userID]; ok {
+			bs = append(bs, b)
+		}
+	}
+	return bs, nil
+}
+
+func (d *blockDB) BlockUser(ctx context.Context, f []*admindb.ForbiddenAccount) error {
+	for _, b := range f {
+		d.blocks[b.UserID] = b
+	}
+	return nil
+}
+
+func (d *blockDB) DelBlockUser(ctx context.Context, f []*admindb.ForbiddenAccount, operatorUserID string) error {
+	for _, b := range f {
+		delete(d.blocks, b.UserID)
+	}
+	return nil
+}
+
+func (d *blockDB) DeleteToken(ctx context.Context, userID string) error {
+	return nil
+}
+
+// imCaller records the OpenIM calls of blocks.
+type imCaller struct {
+	imapi.CallerInterface
+	calls []string
+}
+
+func (c *imCaller) ImAdminTokenWithDefaultAdmin(ctx context.Context) (string, error) {
+	return "imAdmin", nil
+}
+
+func (c *imCaller) ForceOffLine(ctx context.Context, userID string) error {
+	c.calls = append(c.calls, "offline "+userID)
+	return nil
+}
+
+func (c *imCaller) MuteInJoinedGroups(ctx context.Context, userID string, mutedSeconds uint32) error {
+	c.calls = append(c.calls, fmt.Sprintf("mute %s %d", userID, mutedSeconds))
+	return nil
+}
+
+func (c *imCaller) SendTextMessage(ctx context.Context, recvID string, text string) error {
+	c.calls = append(c.calls, "message "+recvID)
+	return nil
+}
+
+func (c *imCaller) CancelMuteInJoinedGroups(ctx context.Context, userID string) error {
+	c.calls = append(c.calls, "unmute "+userID)
+	return nil
+}
+
+func TestBlockUserInIM(t *testing.T) {
+	ctx := mctx.WithAdminUser(context.Background(), "mod")
+	expire := time.Now().Add(time.Hour)
+	tests := []struct {
+		name   string
+		mute   bool
+		expire int64
+		want   []string
+	}{
+		{name: "offline only", want: []string{"offline u1"}},
+		{name: "muted until the block ends", mute: true, expire: expire.UnixMilli(), want: []string{"offline u1", "mute u1 3600"}},
+		{name: "muted for good", mute: true, want: []string{"offline u1", fmt.Sprintf("mute u1 %d", uint32(1<<32-1))}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			im := &imCaller{}
+			o := &adminServer{Database: newBlockDB(), IM: im, BlockMuteGroups: tt.mute}
+			if _, err := o.BlockUser(ctx, &admin.BlockUserReq{UserID: "u1", ExpireTime: tt.expire}); err != nil {
+				t.Fatal(err)
+			}
+			if fmt.Sprint(im.calls) != fmt.Sprint(tt.want) {
+				t.Fatalf("im calls = %v, want %v", im.calls, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlockUserAlreadyBlocked(t *testing.T) {
+	ctx := mctx.WithAdminUser(context.Background(), "mod")
+	im := &imCaller{}
+	o := &adminServer{Database: newBlockDB(), IM: im, BlockMuteGroups: true}
+	expire := time.Now().Add(time.Hour).UnixMilli()
+	if _, err := o.BlockUser(ctx, &admin.BlockUserReq{UserID: "u1", ExpireTime: expire}); err != nil {
+		t.Fatal(err)
+	}
+	im.calls = nil
+	_, err := o.BlockUser(ctx, &admin.BlockUserReq{UserID: "u1", ExpireTime: time.Now().Add(time.Minute).UnixMilli()})
+	if !eerrs.ErrAlreadyBlocked.Is(err) {
+		t.Fatalf("err = %v, want ErrAlreadyBlocked", err)
+	}
+	if len(im.calls) != 0 {
+		t.Fatalf("already blocked user touched in OpenIM: %v", im.calls)
+	}
+	if b, _ := o.Database.GetBlockInfo(ctx, "u1"); b.ExpireTime.UnixMilli() != expire {
+		t.Fatalf("block expire time changed to %s", b.ExpireTime)
+	}
+}
+
+func TestUnblockUserInIM(t *testing.T) {
+	ctx := mctx.WithAdminUser(context.Background(), "mod")
+	im := &imCaller{}
+	o := &adminServer{Database: newBlockDB(), IM: im, BlockMuteGroups: true}
+	if _, err := o.BlockUser(ctx, &admin.BlockUserReq{UserID: "u1"}); err != nil {
+		t.Fatal(err)
+	}
+	im.calls = nil
+	if _, err := o.UnblockUser(ctx, &admin.UnblockUserReq{UserIDs: []string{"u1"}}); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"unmute u1"}; fmt.Sprint(im.calls) != fmt.Sprint(want) {
+		t.Fatalf("im calls = %v, want %v", im.calls, want)
+	}
+}
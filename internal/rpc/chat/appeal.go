@@ -0,0 +1,64 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+// blockedLogin refuses the login of a blocked user who passed the credential checks.
+// The error detail is the ticket to appeal the block with.
+func (o *chatSvr) blockedLogin(ctx context.Context, userID string) error {
+	ticket, err := o.Admin.CreateAppealTicket(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return eerrs.ErrAccountBlocked.WithDetail(ticket).Wrap()
+}
+
+// NotifyAppealResult emails the user the decision on their appeal.
+func (o *chatSvr) NotifyAppealResult(ctx context.Context, req *chat.NotifyAppealResultReq) (*chat.NotifyAppealResultResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermUsersBlock); err != nil {
+		return nil, err
+	}
+	attribute, err := o.Database.TakeAttributeByUserID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if attribute.Email == "" || o.Mail == nil {
+		log.ZInfo(ctx, "appeal result not notified, no email", "userID", req.UserID, "accepted", req.Accepted)
+		return &chat.NotifyAppealResultResp{}, nil
+	}
+	subject := "Your appeal was rejected"
+	body := "We reviewed your appeal and your account stays blocked."
+	if req.Accepted {
+		subject = "Your appeal was accepted"
+		body = "We reviewed your appeal and lifted the block, you can log in again."
+	}
+	if req.Response != "" {
+		body = fmt.Sprintf("%s\n\n%s", body, req.Response)
+	}
+	if err := o.deliverMailNotice(ctx, req.UserID, attribute.Email, subject, body); err != nil {
+		return nil, err
+	}
+	return &chat.NotifyAppealResultResp{}, nil
+}
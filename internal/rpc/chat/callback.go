@@ -24,6 +24,8 @@ import (
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 	constantpb "github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
 )
 
 type CallbackBeforeAddFriendReq struct {
@@ -34,6 +36,45 @@ type CallbackBeforeAddFriendReq struct {
 	OperationID     string `json:"operationID"`
 }
 
+// CallbackMsgReq is the request of the before send single or group message and message modify callbacks.
+type CallbackMsgReq struct {
+	CallbackCommand `json:"callbackCommand"`
+	SendID          string `json:"sendID"`
+	ClientMsgID     string `json:"clientMsgID"`
+	OperationID     string `json:"operationID"`
+	SessionType     int32  `json:"sessionType"`
+	ContentType     int32  `json:"contentType"`
+	Content         string `json:"content"`
+	RecvID          string `json:"recvID"`
+	GroupID         string `json:"groupID"`
+}
+
+type CallbackBeforeUpdateUserInfoReq struct {
+	CallbackCommand `json:"callbackCommand"`
+	OperationID     string  `json:"operationID"`
+	UserID          string  `json:"userID"`
+	Nickname        *string `json:"nickName"`
+}
+
+// CallbackResp is the common part of the replies OpenIM reads fields from.
+type CallbackResp struct {
+	ActionCode int32  `json:"actionCode"`
+	ErrCode    int32  `json:"errCode"`
+	ErrMsg     string `json:"errMsg"`
+	ErrDlt     string `json:"errDlt"`
+	NextCode   int32  `json:"nextCode"`
+}
+
+type CallbackMsgModifyResp struct {
+	CallbackResp
+	Content *string `json:"content"`
+}
+
+type CallbackBeforeUpdateUserInfoResp struct {
+	CallbackResp
+	Nickname *string `json:"nickName"`
+}
+
 type CallbackCommand string
 
 func (c CallbackCommand) GetCallbackCommand() string {
@@ -55,7 +96,113 @@ func (o *chatSvr) OpenIMCallback(ctx context.Context, req *chat.OpenIMCallbackRe
 			return nil, eerrs.ErrRefuseFriend.WrapMsg(fmt.Sprintf("state %d", user.AllowAddFriend))
 		}
 		return &chat.OpenIMCallbackResp{}, nil
+	case constantpb.CallbackBeforeSendSingleMsgCommand, constantpb.CallbackBeforeSendGroupMsgCommand:
+		var data CallbackMsgReq
+		if err := json.Unmarshal([]byte(req.Body), &data); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		if err := o.checkMsgContent(ctx, &data); err != nil {
+			return nil, err
+		}
+		return &chat.OpenIMCallbackResp{}, nil
+	case constantpb.CallbackMsgModifyCommand:
+		var data CallbackMsgReq
+		if err := json.Unmarshal([]byte(req.Body), &data); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		return o.maskMsgContent(&data)
+	case constantpb.CallbackBeforeUpdateUserInfoCommand:
+		var data CallbackBeforeUpdateUserInfoReq
+		if err := json.Unmarshal([]byte(req.Body), &data); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		return o.moderateUserInfo(ctx, &data)
 	default:
 		return nil, errs.ErrArgs.WrapMsg(fmt.Sprintf("invalid command %s", req.Command))
 	}
 }
+
+// msgTextKey returns the key of the text in the content of text messages, which are all that is moderated.
+func msgTextKey(contentType int32) string {
+	switch contentType {
+	case constantpb.Text:
+		return "content"
+	case constantpb.AtText:
+		return "text"
+	default:
+		return ""
+	}
+}
+
+func msgText(data *CallbackMsgReq) (map[string]any, string) {
+	key := msgTextKey(data.ContentType)
+	if key == "" {
+		return nil, ""
+	}
+	var content map[string]any
+	if err := json.Unmarshal([]byte(data.Content), &content); err != nil {
+		return nil, ""
+	}
+	text, _ := content[key].(string)
+	return content, text
+}
+
+func msgWhere(data *CallbackMsgReq) string {
+	if data.GroupID != "" {
+		return "a message in group " + data.GroupID
+	}
+	return "a message to user " + data.RecvID
+}
+
+// checkMsgContent refuses a message matching a reject rule and reports the sender for flag rules.
+// Mask rules are applied by the message modify callback that OpenIM calls next.
+func (o *chatSvr) checkMsgContent(ctx context.Context, data *CallbackMsgReq) error {
+	_, text := msgText(data)
+	res := o.moderate(data.SendID, constant.ModerationScopeMessage, text)
+	if res.reject != nil {
+		o.reportModerationHits(ctx, data.SendID, msgWhere(data), text, append(res.flags, res.reject))
+		return eerrs.ErrContentRejected.WrapMsg("message rejected by content moderation")
+	}
+	o.reportModerationHits(ctx, data.SendID, msgWhere(data), text, res.flags)
+	return nil
+}
+
+func (o *chatSvr) maskMsgContent(data *CallbackMsgReq) (*chat.OpenIMCallbackResp, error) {
+	content, text := msgText(data)
+	res := o.moderate(data.SendID, constant.ModerationScopeMessage, text)
+	if res.masked == text {
+		return &chat.OpenIMCallbackResp{}, nil
+	}
+	content[msgTextKey(data.ContentType)] = res.masked
+	masked, err := json.Marshal(content)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	body, err := json.Marshal(CallbackMsgModifyResp{Content: datautil.ToPtr(string(masked))})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &chat.OpenIMCallbackResp{Body: string(body)}, nil
+}
+
+func (o *chatSvr) moderateUserInfo(ctx context.Context, data *CallbackBeforeUpdateUserInfoReq) (*chat.OpenIMCallbackResp, error) {
+	if data.Nickname == nil {
+		return &chat.OpenIMCallbackResp{}, nil
+	}
+	nickname := *data.Nickname
+	res := o.moderate(data.UserID, constant.ModerationScopeUserInfo, nickname)
+	if res.reject != nil {
+		o.reportModerationHits(ctx, data.UserID, "the nickname", nickname, append(res.flags, res.reject))
+		return nil, eerrs.ErrContentRejected.WrapMsg("nickname rejected by content moderation")
+	}
+	o.reportModerationHits(ctx, data.UserID, "the nickname", nickname, res.flags)
+	if res.masked == nickname {
+		return &chat.OpenIMCallbackResp{}, nil
+	}
+	log.ZInfo(ctx, "nickname masked by content moderation", "userID", data.UserID)
+	body, err := json.Marshal(CallbackBeforeUpdateUserInfoResp{Nickname: &res.masked})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &chat.OpenIMCallbackResp{Body: string(body)}, nil
+}
@@ -0,0 +1,79 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+func (o *chatSvr) GetCaptcha(ctx context.Context, req *chat.GetCaptchaReq) (*chat.GetCaptchaResp, error) {
+	c, err := o.Captcha.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.GetCaptchaResp{
+		CaptchaID:  c.ID,
+		Type:       c.Type,
+		Data:       c.Data,
+		Difficulty: int32(c.Difficulty),
+		ExpireTime: c.ExpireTime.UnixMilli(),
+	}, nil
+}
+
+// captchaRequired reports whether the client config turns on the captcha for key.
+func captchaRequired(conf map[string]string, key string) bool {
+	return datautil.Contain(strings.ToLower(conf[key]), "1", "true", "yes")
+}
+
+// checkCaptcha verifies the solved challenge; a challenge can only be used once.
+func (o *chatSvr) checkCaptcha(ctx context.Context, captchaID string, answer string) error {
+	if captchaID == "" || answer == "" {
+		return eerrs.ErrCaptchaRequired.Wrap()
+	}
+	ok, err := o.Captcha.Verify(ctx, captchaID, answer)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return eerrs.ErrCaptchaInvalid.Wrap()
+	}
+	return nil
+}
+
+// loginCaptchaRequired reports whether the failed logins of the user or the IP reached the client config limit.
+func (o *chatSvr) loginCaptchaRequired(ctx context.Context, conf map[string]string, userID string, ip string) (bool, error) {
+	limit, _ := strconv.ParseInt(conf[constant.CaptchaLoginFailuresConfigKey], 10, 64)
+	if limit <= 0 {
+		return false, nil
+	}
+	for _, target := range o.LoginLock.Targets(userID, ip) {
+		failures, err := o.Database.GetLoginFailures(ctx, target)
+		if err != nil {
+			return false, err
+		}
+		if failures >= limit {
+			return true, nil
+		}
+	}
+	return false, nil
+}
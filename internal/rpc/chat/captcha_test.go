@@ -0,0 +1,158 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"crypto/sha256"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/openimsdk/chat/pkg/captcha"
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/db/database"
+	"github.com/openimsdk/chat/pkg/eerrs"
+)
+
+// captchaDB keeps captcha answers in memory and login failures in redis.
+type captchaDB struct {
+	database.ChatDatabaseInterface
+	locks   *cache.LoginLockRedis
+	answers map[string]string
+}
+
+func (d *captchaDB) SetCaptcha(ctx context.Context, id string, answer string, expire time.Duration) error {
+	d.answers[id] = answer
+	return nil
+}
+
+func (d *captchaDB) TakeCaptcha(ctx context.Context, id string) (string, error) {
+	answer := d.answers[id]
+	delete(d.answers, id)
+	return answer, nil
+}
+
+func (d *captchaDB) GetLoginFailures(ctx context.Context, target string) (int64, error) {
+	lock, err := d.locks.GetLoginLock(ctx, target)
+	if err != nil {
+		return 0, err
+	}
+	return lock.Recent, nil
+}
+
+func newCaptchaSvr(t *testing.T, policy cache.LockPolicy) *chatSvr {
+	s := miniredis.RunT(t)
+	db := &captchaDB{
+		locks:   cache.NewLoginLockInterface(redis.NewClient(&redis.Options{Addr: s.Addr()})),
+		answers: make(map[string]string),
+	}
+	return &chatSvr{
+		Database:  db,
+		Captcha:   captcha.NewPoW(db, time.Minute, 4),
+		LoginLock: cache.NewLoginLocker(db.locks, cache.LoginLockPolicy{Account: policy, IP: policy}, "user"),
+	}
+}
+
+// solvePoW finds an answer whose hash with seed has difficulty leading zero bits.
+func solvePoW(seed string, difficulty int) string {
+	for i := 0; ; i++ {
+		answer := strconv.Itoa(i)
+		sum := sha256.Sum256([]byte(seed + answer))
+		if int(sum[0]>>(8-difficulty)) == 0 {
+			return answer
+		}
+	}
+}
+
+func TestCheckCaptchaOnce(t *testing.T) {
+	ctx := context.Background()
+	o := newCaptchaSvr(t, cache.LockPolicy{MaxFailures: 5, Window: time.Minute, LockTime: time.Minute, MaxLockTime: time.Hour})
+	if err := o.checkCaptcha(ctx, "", ""); !eerrs.ErrCaptchaRequired.Is(err) {
+		t.Fatalf("no captcha: %v, want ErrCaptchaRequired", err)
+	}
+	c, err := o.GetCaptcha(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	answer := solvePoW(c.Data, int(c.Difficulty))
+	if err := o.checkCaptcha(ctx, c.CaptchaID, answer); err != nil {
+		t.Fatalf("solved captcha: %v", err)
+	}
+	if err := o.checkCaptcha(ctx, c.CaptchaID, answer); !eerrs.ErrCaptchaInvalid.Is(err) {
+		t.Fatalf("reused captcha: %v, want ErrCaptchaInvalid", err)
+	}
+
+	c, err = o.GetCaptcha(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := o.checkCaptcha(ctx, c.CaptchaID, "wrong"); !eerrs.ErrCaptchaInvalid.Is(err) {
+		t.Fatalf("wrong answer: %v, want ErrCaptchaInvalid", err)
+	}
+	// a failed attempt uses the challenge up as well
+	if err := o.checkCaptcha(ctx, c.CaptchaID, solvePoW(c.Data, int(c.Difficulty))); !eerrs.ErrCaptchaInvalid.Is(err) {
+		t.Fatalf("captcha reused after a wrong answer: %v, want ErrCaptchaInvalid", err)
+	}
+}
+
+func TestLoginCaptchaRequired(t *testing.T) {
+	ctx := context.Background()
+	// the account locks after 2 failures, the captcha turns on after 3 in the window regardless
+	o := newCaptchaSvr(t, cache.LockPolicy{MaxFailures: 2, Window: time.Minute, LockTime: time.Millisecond, MaxLockTime: time.Millisecond})
+	conf := map[string]string{constant.CaptchaLoginFailuresConfigKey: "3"}
+	required := func(userID string, ip string) bool {
+		t.Helper()
+		need, err := o.loginCaptchaRequired(ctx, conf, userID, ip)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return need
+	}
+	fail := func(userID string, ip string) {
+		t.Helper()
+		_ = o.LoginLock.Failed(ctx, userID, ip, eerrs.ErrPassword.Wrap())
+	}
+
+	for i := 0; i < 2; i++ {
+		fail("u1", "10.0.0.1")
+	}
+	if required("u1", "10.0.0.1") {
+		t.Fatal("captcha required after 2 failures")
+	}
+	fail("u1", "10.0.0.2")
+	if !required("u1", "10.0.0.3") {
+		t.Fatal("captcha not required after 3 failures of the account, across a lock")
+	}
+	if required("u2", "10.0.0.1") {
+		t.Fatal("captcha required for an IP with 2 failures")
+	}
+	// unknown accounts count against the IP only
+	fail("", "10.0.0.1")
+	if !required("u2", "10.0.0.1") {
+		t.Fatal("captcha not required after 3 failures of the IP")
+	}
+	if need, err := o.loginCaptchaRequired(ctx, map[string]string{}, "u1", "10.0.0.1"); err != nil || need {
+		t.Fatalf("captcha required without the client config: %v, %v", need, err)
+	}
+	o.LoginLock.Succeeded(ctx, "u1")
+	if required("u1", "10.0.0.3") {
+		t.Fatal("captcha still required after the account logged in")
+	}
+}
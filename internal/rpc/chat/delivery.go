@@ -0,0 +1,368 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/idutil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/email"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/chat/pkg/sms"
+)
+
+// deliveryLease is how long an attempt holds a delivery before another instance may retry it.
+const deliveryLease = 2 * time.Minute
+
+// deliver sends d right away and queues it for retries if the provider fails, so callers only
+// see an error when the delivery could not be stored.
+func (o *chatSvr) deliver(ctx context.Context, d *chatdb.Delivery) error {
+	now := time.Now()
+	d.DeliveryID = idutil.OperationIDGenerator()
+	d.Status = constant.DeliveryStatusQueued
+	d.NextTime = now.Add(deliveryLease)
+	d.CreateTime = now
+	d.UpdateTime = now
+	if err := o.Database.AddDelivery(ctx, d); err != nil {
+		return err
+	}
+	o.attemptDelivery(ctx, d)
+	return nil
+}
+
+// deliverCode sends a verification code to an email, or to a phone when email is empty. The message
+// is rendered from the template of usedFor best matching lang on each attempt.
+func (o *chatSvr) deliverCode(ctx context.Context, account string, areaCode string, phoneNumber string, emailAddr string, code string, usedFor int32, lang string) error {
+	sealed, err := o.sealCode(account, code)
+	if err != nil {
+		return err
+	}
+	d := &chatdb.Delivery{
+		Kind:        constant.DeliveryKindCode,
+		Account:     account,
+		AreaCode:    areaCode,
+		PhoneNumber: phoneNumber,
+		Email:       emailAddr,
+		Payload:     map[string]string{"sealedCode": sealed, "usedFor": strconv.Itoa(int(usedFor)), "language": lang},
+		ExpireTime:  time.Now().Add(o.Code.ValidTime),
+	}
+	if emailAddr != "" {
+		d.Channel = constant.DeliveryChannelEmail
+	} else {
+		d.Channel = constant.DeliveryChannelSMS
+	}
+	return o.deliver(ctx, d)
+}
+
+// deliverMailNotice emails the user a notice.
+func (o *chatSvr) deliverMailNotice(ctx context.Context, userID string, emailAddr string, subject string, body string) error {
+	return o.deliver(ctx, &chatdb.Delivery{
+		Channel:    constant.DeliveryChannelEmail,
+		Kind:       constant.DeliveryKindNotice,
+		Account:    emailAddr,
+		UserID:     userID,
+		Email:      emailAddr,
+		Payload:    map[string]string{"subject": subject, "body": body},
+		ExpireTime: time.Now().Add(time.Duration(o.Delivery.NoticeExpire) * time.Second),
+	})
+}
+
+// deliverSMSLoginNotice sends the user an SMS login notice; params are platform, ip and country.
+func (o *chatSvr) deliverSMSLoginNotice(ctx context.Context, userID string, areaCode string, phoneNumber string, params map[string]string) error {
+	return o.deliver(ctx, &chatdb.Delivery{
+		Channel:     constant.DeliveryChannelSMS,
+		Kind:        constant.DeliveryKindNotice,
+		Account:     o.verifyCodeJoin(areaCode, phoneNumber),
+		UserID:      userID,
+		AreaCode:    areaCode,
+		PhoneNumber: phoneNumber,
+		Payload:     params,
+		ExpireTime:  time.Now().Add(time.Duration(o.Delivery.NoticeExpire) * time.Second),
+	})
+}
+
+// deliverSMSTextNotice texts the user a notice, through providers sending free text only.
+func (o *chatSvr) deliverSMSTextNotice(ctx context.Context, userID string, areaCode string, phoneNumber string, text string) error {
+	return o.deliver(ctx, &chatdb.Delivery{
+		Channel:     constant.DeliveryChannelSMS,
+		Kind:        constant.DeliveryKindNotice,
+		Account:     o.verifyCodeJoin(areaCode, phoneNumber),
+		UserID:      userID,
+		AreaCode:    areaCode,
+		PhoneNumber: phoneNumber,
+		Payload:     map[string]string{"text": text},
+		ExpireTime:  time.Now().Add(time.Duration(o.Delivery.NoticeExpire) * time.Second),
+	})
+}
+
+// deliveryProvider picks the provider of an attempt: the primary first, then the failover and
+// the primary in turn.
+func deliveryProvider[T any](attempts int32, primary T, failover T, hasFailover bool) T {
+	if hasFailover && attempts%2 == 1 {
+		return failover
+	}
+	return primary
+}
+
+// send makes one attempt and returns the provider and the message ID it gave.
+func (o *chatSvr) send(ctx context.Context, d *chatdb.Delivery) (string, string, error) {
+	switch d.Channel {
+	case constant.DeliveryChannelSMS:
+		if o.SMS == nil {
+			return "", "", errs.ErrInternalServer.WrapMsg("sms is not enabled")
+		}
+		// the name is that of the provider routed to for the area code, not of the router
+		provider := sms.Route(deliveryProvider[sms.SMS](d.Attempts, o.SMS, o.SMSFailover, o.SMSFailover != nil), d.AreaCode)
+		var (
+			msgID string
+			err   error
+		)
+		if d.Kind == constant.DeliveryKindCode {
+			msgID, err = o.sendSMSCode(ctx, provider, d)
+		} else if text, ok := d.Payload["text"]; ok {
+			textProvider, ok := provider.(sms.TextSMS)
+			if !ok {
+				return provider.Name(), "", errs.ErrInternalServer.WrapMsg("sms provider does not send free text", "provider", provider.Name())
+			}
+			msgID, err = textProvider.SendText(ctx, d.AreaCode, d.PhoneNumber, text)
+		} else {
+			msgID, err = provider.SendLoginNotice(ctx, d.AreaCode, d.PhoneNumber, d.Payload)
+		}
+		return provider.Name(), msgID, err
+	case constant.DeliveryChannelEmail:
+		if o.Mail == nil {
+			return "", "", errs.ErrInternalServer.WrapMsg("mail is not enabled")
+		}
+		provider := deliveryProvider[email.Mail](d.Attempts, o.Mail, o.MailFailover, o.MailFailover != nil)
+		var (
+			msgID string
+			err   error
+		)
+		if d.Kind == constant.DeliveryKindCode {
+			msgID, err = o.sendMailCode(ctx, provider, d)
+		} else {
+			msgID, err = provider.SendNotice(ctx, d.Email, d.Payload["subject"], d.Payload["body"])
+		}
+		return provider.Name(), msgID, err
+	default:
+		return "", "", errs.ErrInternalServer.WrapMsg("unknown delivery channel", "channel", d.Channel)
+	}
+}
+
+// sendSMSCode sends the code in the localized template when there is one and the provider sends
+// free text, else in the provider's own template.
+func (o *chatSvr) sendSMSCode(ctx context.Context, provider sms.SMS, d *chatdb.Delivery) (string, error) {
+	code, err := o.openCode(d.Account, d.Payload["sealedCode"])
+	if err != nil {
+		return "", err
+	}
+	if texter, ok := sms.AsText(provider, d.AreaCode); ok {
+		usedFor, _ := strconv.Atoi(d.Payload["usedFor"])
+		msg, ok, err := o.renderMessage(constant.DeliveryChannelSMS, int32(usedFor), d.Payload["language"], code)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return texter.SendText(ctx, d.AreaCode, d.PhoneNumber, msg.text)
+		}
+	}
+	return provider.SendCode(ctx, d.AreaCode, d.PhoneNumber, code)
+}
+
+func (o *chatSvr) sendMailCode(ctx context.Context, provider email.Mail, d *chatdb.Delivery) (string, error) {
+	code, err := o.openCode(d.Account, d.Payload["sealedCode"])
+	if err != nil {
+		return "", err
+	}
+	usedFor, _ := strconv.Atoi(d.Payload["usedFor"])
+	msg, ok, err := o.renderMessage(constant.DeliveryChannelEmail, int32(usedFor), d.Payload["language"], code)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", errs.ErrInternalServer.WrapMsg("no verification mail template")
+	}
+	return provider.SendMail(ctx, d.Email, msg.subject, msg.html, msg.text)
+}
+
+// codeCipher is the AES-GCM cipher codes waiting for delivery are stored with, keyed from the code hash key.
+func (o *chatSvr) codeCipher() (cipher.AEAD, error) {
+	h := hmac.New(sha256.New, o.Code.HashKey)
+	h.Write([]byte("delivery code"))
+	block, err := aes.NewCipher(h.Sum(nil))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return aead, nil
+}
+
+// sealCode encrypts a code for the delivery payload, bound to the account it is sent to.
+func (o *chatSvr) sealCode(account string, code string) (string, error) {
+	aead, err := o.codeCipher()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return base64.RawStdEncoding.EncodeToString(aead.Seal(nonce, nonce, []byte(code), []byte(account))), nil
+}
+
+// openCode decrypts a code sealed by sealCode.
+func (o *chatSvr) openCode(account string, sealed string) (string, error) {
+	aead, err := o.codeCipher()
+	if err != nil {
+		return "", err
+	}
+	data, err := base64.RawStdEncoding.DecodeString(sealed)
+	if err != nil || len(data) < aead.NonceSize() {
+		return "", errs.ErrInternalServer.WrapMsg("sealed verification code is invalid")
+	}
+	code, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(account))
+	if err != nil {
+		return "", errs.ErrInternalServer.WrapMsg("sealed verification code does not open")
+	}
+	return string(code), nil
+}
+
+// backoff is the wait after the given number of failed attempts.
+func (o *chatSvr) backoff(attempts int32) time.Duration {
+	wait := time.Duration(o.Delivery.Backoff) * time.Second
+	limit := time.Duration(o.Delivery.MaxBackoff) * time.Second
+	for i := int32(1); i < attempts && wait < limit; i++ {
+		wait *= 2
+	}
+	return min(wait, limit)
+}
+
+// attemptDelivery makes one attempt and records the outcome: sent, queued for the next attempt,
+// or failed. The payload is cleared once the delivery ends, it may hold a verification code.
+func (o *chatSvr) attemptDelivery(ctx context.Context, d *chatdb.Delivery) {
+	provider, msgID, err := o.send(ctx, d)
+	now := time.Now()
+	d.Attempts++
+	update := map[string]any{
+		"attempts": d.Attempts,
+		"provider": provider,
+	}
+	switch next := now.Add(o.backoff(d.Attempts)); {
+	case err == nil:
+		update["status"] = constant.DeliveryStatusSent
+		update["provider_msg_id"] = msgID
+		update["last_error"] = ""
+		update["sent_time"] = now
+		update["payload"] = nil
+	case d.Attempts >= int32(o.Delivery.Attempts) || next.After(d.ExpireTime):
+		log.ZError(ctx, "delivery failed", err, "deliveryID", d.DeliveryID, "channel", d.Channel, "account", d.Account, "attempts", d.Attempts)
+		update["status"] = constant.DeliveryStatusFailed
+		update["last_error"] = err.Error()
+		update["payload"] = nil
+	default:
+		log.ZWarn(ctx, "delivery attempt failed, retry later", err, "deliveryID", d.DeliveryID, "channel", d.Channel, "provider", provider, "next", next)
+		update["last_error"] = err.Error()
+		update["next_time"] = next
+	}
+	if err := o.Database.UpdateDelivery(ctx, d.DeliveryID, update); err != nil {
+		log.ZError(ctx, "update delivery failed", err, "deliveryID", d.DeliveryID)
+	}
+}
+
+// retryDeliveries makes the next attempt of queued deliveries every interval.
+func (o *chatSvr) retryDeliveries(interval time.Duration) {
+	const batch = 100
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx := mcontext.SetOperationID(context.Background(), "retry_deliveries_"+time.Now().Format("20060102150405"))
+		for i := 0; i < batch; i++ {
+			now := time.Now()
+			d, err := o.Database.ClaimDelivery(ctx, now, now.Add(deliveryLease))
+			if err != nil {
+				if !dbutil.IsDBNotFound(err) {
+					log.ZError(ctx, "claim delivery failed", err)
+				}
+				break
+			}
+			if !d.ExpireTime.After(now) {
+				err := o.Database.UpdateDelivery(ctx, d.DeliveryID, map[string]any{
+					"status":     constant.DeliveryStatusFailed,
+					"last_error": "expired",
+					"payload":    nil,
+				})
+				if err != nil {
+					log.ZError(ctx, "expire delivery failed", err, "deliveryID", d.DeliveryID)
+				}
+				continue
+			}
+			o.attemptDelivery(ctx, d)
+		}
+	}
+}
+
+// SearchDelivery shows admins what was sent to an account; the payload is never returned.
+func (o *chatSvr) SearchDelivery(ctx context.Context, req *chat.SearchDeliveryReq) (*chat.SearchDeliveryResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermUsersRead); err != nil {
+		return nil, err
+	}
+	total, deliveries, err := o.Database.SearchDelivery(ctx, req.Account, req.UserID, req.Channel, req.Status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &chat.SearchDeliveryResp{
+		Total:      uint32(total),
+		Deliveries: make([]*chat.Delivery, 0, len(deliveries)),
+	}
+	for _, d := range deliveries {
+		pb := &chat.Delivery{
+			DeliveryID:    d.DeliveryID,
+			Channel:       d.Channel,
+			Kind:          d.Kind,
+			Account:       d.Account,
+			UserID:        d.UserID,
+			Status:        d.Status,
+			Attempts:      d.Attempts,
+			Provider:      d.Provider,
+			ProviderMsgID: d.ProviderMsgID,
+			LastError:     d.LastError,
+			CreateTime:    d.CreateTime.UnixMilli(),
+			SentTime:      timeMilli(d.SentTime),
+		}
+		if d.Status == constant.DeliveryStatusQueued {
+			pb.NextTime = d.NextTime.UnixMilli()
+		}
+		resp.Deliveries = append(resp.Deliveries, pb)
+	}
+	return resp, nil
+}
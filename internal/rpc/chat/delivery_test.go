@@ -0,0 +1,45 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSealCode(t *testing.T) {
+	o := &chatSvr{Code: verifyCode{HashKey: []byte("secret")}}
+	sealed, err := o.sealCode("user@example.com", "123456")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(sealed, "123456") {
+		t.Fatalf("sealed code %q holds the code", sealed)
+	}
+	code, err := o.openCode("user@example.com", sealed)
+	if err != nil || code != "123456" {
+		t.Fatalf("openCode = %q, %v, want 123456", code, err)
+	}
+	if _, err := o.openCode("other@example.com", sealed); err == nil {
+		t.Fatal("code opened for another account")
+	}
+	other := &chatSvr{Code: verifyCode{HashKey: []byte("other secret")}}
+	if _, err := other.openCode("user@example.com", sealed); err == nil {
+		t.Fatal("code opened with another key")
+	}
+	if _, err := o.openCode("user@example.com", "bm90IHNlYWxlZA"); err == nil {
+		t.Fatal("unsealed value opened")
+	}
+}
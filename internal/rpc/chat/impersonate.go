@@ -0,0 +1,49 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+// NotifyImpersonation emails the user that an administrator logged in as them.
+// Users without an email are only recorded in the log, there is no SMS template for it.
+func (o *chatSvr) NotifyImpersonation(ctx context.Context, req *chat.NotifyImpersonationReq) (*chat.NotifyImpersonationResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermUsersImpersonate); err != nil {
+		return nil, err
+	}
+	attribute, err := o.Database.TakeAttributeByUserID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if attribute.Email == "" || o.Mail == nil {
+		log.ZInfo(ctx, "impersonation not notified, no email", "userID", req.UserID, "impersonator", req.Impersonator)
+		return &chat.NotifyImpersonationResp{}, nil
+	}
+	body := fmt.Sprintf("A support administrator signed in to your account to help with your request.\n\n"+
+		"Administrator: %s\nAccess ends: %s\n\nIf you did not ask for support, contact us.",
+		req.Impersonator, time.UnixMilli(req.ExpireTime).UTC().Format(time.RFC1123))
+	if err := o.deliverMailNotice(ctx, req.UserID, attribute.Email, "Support signed in to your account", body); err != nil {
+		return nil, err
+	}
+	return &chat.NotifyImpersonationResp{}, nil
+}
@@ -2,6 +2,10 @@ package chat
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -34,11 +38,27 @@ func (o *chatSvr) verifyCodeJoin(areaCode, phoneNumber string) string {
 }
 
 func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeReq) (*chat.SendVerifyCodeResp, error) {
+	conf, err := o.Admin.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	captchaChecked := captchaRequired(conf, constant.CaptchaCodeSendConfigKey)
+	if captchaChecked {
+		if err := o.checkCaptcha(ctx, req.CaptchaID, req.CaptchaAnswer); err != nil {
+			return nil, err
+		}
+	}
 	switch int(req.UsedFor) {
 	case constant.VerificationCodeForRegister:
-		if err := o.Admin.CheckRegister(ctx, req.Ip); err != nil {
+		check, err := o.Admin.CheckRegister(ctx, req.Ip)
+		if err != nil {
 			return nil, err
 		}
+		if check.Verify && !captchaChecked {
+			if err := o.checkCaptcha(ctx, req.CaptchaID, req.CaptchaAnswer); err != nil {
+				return nil, err
+			}
+		}
 		if req.Email == "" {
 			if req.AreaCode == "" || req.PhoneNumber == "" {
 				return nil, errs.ErrArgs.WrapMsg("area code or phone number is empty")
@@ -57,10 +77,6 @@ func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeRe
 				return nil, errs.ErrArgs.WrapMsg("email must be right")
 			}
 		}
-		conf, err := o.Admin.GetConfig(ctx)
-		if err != nil {
-			return nil, err
-		}
 		if val := conf[constant.NeedInvitationCodeRegisterConfigKey]; datautil.Contain(strings.ToLower(val), "1", "true", "yes") {
 			if req.InvitationCode == "" {
 				return nil, errs.ErrArgs.WrapMsg("invitation code is empty")
@@ -93,40 +109,26 @@ func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeRe
 		return &chat.SendVerifyCodeResp{}, nil // super code
 	}
 	if req.Email != "" {
-		switch o.conf.Mail.Use {
-		case constant.VerifySuperCode:
+		if o.conf.Mail.Use == constant.VerifySuperCode {
 			return &chat.SendVerifyCodeResp{}, nil // super code
-		case constant.Email:
-		default:
+		}
+		if o.Mail == nil {
 			return nil, errs.ErrInternalServer.WrapMsg("email verification code is not enabled")
 		}
 	}
 
 	if req.AreaCode != "" {
-		switch o.conf.Phone.Use {
-		case constant.VerifySuperCode:
+		if o.conf.Phone.Use == constant.VerifySuperCode {
 			return &chat.SendVerifyCodeResp{}, nil // super code
-		case constant.VerifyALi:
-		default:
+		}
+		if o.SMS == nil {
 			return nil, errs.ErrInternalServer.WrapMsg("phone verification code is not enabled")
 		}
 	}
 
-	isEmail := req.Email != ""
-	var (
-		code     = o.genVerifyCode()
-		account  string
-		sendCode func() error
-	)
-	if isEmail {
-		sendCode = func() error {
-			return o.Mail.SendMail(ctx, req.Email, code)
-		}
-		account = req.Email
-	} else {
-		sendCode = func() error {
-			return o.SMS.SendCode(ctx, req.AreaCode, req.PhoneNumber, code)
-		}
+	code := o.genVerifyCode()
+	account := req.Email
+	if account == "" {
 		account = o.verifyCodeJoin(req.AreaCode, req.PhoneNumber)
 	}
 	now := time.Now()
@@ -143,50 +145,65 @@ func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeRe
 	}
 	vc := &chatdb.VerifyCode{
 		Account:    account,
-		Code:       code,
 		Platform:   platformName,
+		UsedFor:    req.UsedFor,
+		CodeHash:   o.hashVerifyCode(account, req.UsedFor, code),
 		Duration:   uint(o.Code.ValidTime / time.Second),
 		Count:      0,
 		Used:       false,
 		CreateTime: now,
 	}
-	if err := o.Database.AddVerifyCode(ctx, vc, sendCode); err != nil {
+	if err := o.Database.AddVerifyCode(ctx, vc, nil); err != nil {
 		return nil, err
 	}
-	log.ZDebug(ctx, "send code success", "account", account, "code", code, "platform", platformName)
+	// delivered once the code is committed, a failed send is retried from the delivery queue and
+	// the code stays valid meanwhile
+	if err := o.deliverCode(ctx, account, req.AreaCode, req.PhoneNumber, req.Email, code, req.UsedFor, req.Language); err != nil {
+		if delErr := o.Database.DelVerifyCode(ctx, vc.ID); delErr != nil {
+			log.ZError(ctx, "delete undelivered verify code failed", delErr, "account", account)
+		}
+		return nil, err
+	}
+	log.ZDebug(ctx, "send code success", "account", account, "usedFor", req.UsedFor, "platform", platformName)
 	return &chat.SendVerifyCodeResp{}, nil
 }
 
-func (o *chatSvr) verifyCode(ctx context.Context, account string, verifyCode string, type_ verifyType) (string, error) {
+// hashVerifyCode is the keyed hash a code is stored as, bound to the account and purpose it was sent for.
+func (o *chatSvr) hashVerifyCode(account string, usedFor int32, code string) string {
+	h := hmac.New(sha256.New, o.Code.HashKey)
+	h.Write([]byte(account + "\x00" + strconv.Itoa(int(usedFor)) + "\x00" + code))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// verifyCode checks a code sent to the account for the purpose usedFor.
+func (o *chatSvr) verifyCode(ctx context.Context, account string, verifyCode string, type_ verifyType, usedFor int32) (string, error) {
 	if verifyCode == "" {
 		return "", errs.ErrArgs.WrapMsg("verify code is empty")
 	}
 	switch type_ {
 	case phone:
-		switch o.conf.Phone.Use {
-		case constant.VerifySuperCode:
+		if o.conf.Phone.Use == constant.VerifySuperCode {
 			if o.Code.SuperCode != verifyCode {
 				return "", eerrs.ErrVerifyCodeNotMatch.Wrap()
 			}
 			return "", nil
-		case constant.VerifyALi:
-		default:
+		}
+		if o.SMS == nil {
 			return "", errs.ErrInternalServer.WrapMsg("phone verification code is not enabled", "use", o.conf.Phone.Use)
 		}
 	case mail:
-		switch o.conf.Mail.Use {
-		case constant.VerifySuperCode:
+		if o.conf.Mail.Use == constant.VerifySuperCode {
 			if o.Code.SuperCode != verifyCode {
 				return "", eerrs.ErrVerifyCodeNotMatch.Wrap()
 			}
 			return "", nil
-		case constant.VerifyMail:
-		default:
-			return "", errs.ErrInternalServer.WrapMsg("email verification code is not enabled")
+		}
+		if o.Mail == nil {
+			return "", errs.ErrInternalServer.WrapMsg("email verification code is not enabled", "use", o.conf.Mail.Use)
 		}
 	}
 
-	last, err := o.Database.TakeLastVerifyCode(ctx, account)
+	last, err := o.Database.TakeLastVerifyCode(ctx, account, usedFor)
 	if err != nil {
 		if dbutil.IsDBNotFound(err) {
 			return "", eerrs.ErrVerifyCodeExpired.Wrap()
@@ -199,17 +216,18 @@ func (o *chatSvr) verifyCode(ctx context.Context, account string, verifyCode str
 	if last.Used {
 		return last.ID, eerrs.ErrVerifyCodeUsed.Wrap()
 	}
+	match := hmac.Equal([]byte(last.CodeHash), []byte(o.hashVerifyCode(account, usedFor, verifyCode)))
 	if n := o.Code.ValidCount; n > 0 {
 		if last.Count >= n {
 			return last.ID, eerrs.ErrVerifyCodeMaxCount.Wrap()
 		}
-		if last.Code != verifyCode {
+		if !match {
 			if err := o.Database.UpdateVerifyCodeIncrCount(ctx, last.ID); err != nil {
 				return last.ID, err
 			}
 		}
 	}
-	if last.Code != verifyCode {
+	if !match {
 		return last.ID, eerrs.ErrVerifyCodeNotMatch.Wrap()
 	}
 	return last.ID, nil
@@ -219,12 +237,12 @@ func (o *chatSvr) VerifyCode(ctx context.Context, req *chat.VerifyCodeReq) (*cha
 	var account string
 	if req.PhoneNumber != "" {
 		account = o.verifyCodeJoin(req.AreaCode, req.PhoneNumber)
-		if _, err := o.verifyCode(ctx, account, req.VerifyCode, phone); err != nil {
+		if _, err := o.verifyCode(ctx, account, req.VerifyCode, phone, req.UsedFor); err != nil {
 			return nil, err
 		}
 	} else {
 		account = req.Email
-		if _, err := o.verifyCode(ctx, account, req.VerifyCode, mail); err != nil {
+		if _, err := o.verifyCode(ctx, account, req.VerifyCode, mail, req.UsedFor); err != nil {
 			return nil, err
 		}
 	}
@@ -266,7 +284,10 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 	if err = o.checkRegisterInfo(ctx, req.User, isAdmin); err != nil {
 		return nil, err
 	}
-	var usedInvitationCode bool
+	var (
+		usedInvitationCode bool
+		country            string
+	)
 	if !isAdmin {
 		if !o.AllowRegister {
 			return nil, errs.ErrNoPermission.WrapMsg("register user is disabled")
@@ -274,13 +295,20 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 		if req.User.UserID != "" {
 			return nil, errs.ErrNoPermission.WrapMsg("only admin can set user id")
 		}
-		if err := o.Admin.CheckRegister(ctx, req.Ip); err != nil {
+		check, err := o.Admin.CheckRegister(ctx, req.Ip)
+		if err != nil {
 			return nil, err
 		}
+		country = check.Country
 		conf, err := o.Admin.GetConfig(ctx)
 		if err != nil {
 			return nil, err
 		}
+		if check.Verify || captchaRequired(conf, constant.CaptchaRegisterConfigKey) {
+			if err := o.checkCaptcha(ctx, req.CaptchaID, req.CaptchaAnswer); err != nil {
+				return nil, err
+			}
+		}
 		if val := conf[constant.NeedInvitationCodeRegisterConfigKey]; datautil.Contain(strings.ToLower(val), "1", "true", "yes") {
 			usedInvitationCode = true
 			if req.InvitationCode == "" {
@@ -291,11 +319,11 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 			}
 		}
 		if req.User.Email == "" {
-			if _, err := o.verifyCode(ctx, o.verifyCodeJoin(req.User.AreaCode, req.User.PhoneNumber), req.VerifyCode, phone); err != nil {
+			if _, err := o.verifyCode(ctx, o.verifyCodeJoin(req.User.AreaCode, req.User.PhoneNumber), req.VerifyCode, phone, constant.VerificationCodeForRegister); err != nil {
 				return nil, err
 			}
 		} else {
-			if _, err := o.verifyCode(ctx, req.User.Email, req.VerifyCode, mail); err != nil {
+			if _, err := o.verifyCode(ctx, req.User.Email, req.VerifyCode, mail, constant.VerificationCodeForRegister); err != nil {
 				return nil, err
 			}
 		}
@@ -362,14 +390,19 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 		UserID:      req.User.UserID,
 		DeviceID:    req.DeviceID,
 		IP:          req.Ip,
+		Country:     country,
 		Platform:    constantpb.PlatformID2Name[int(req.Platform)],
 		AccountType: "",
 		Mode:        constant.UserMode,
 		CreateTime:  time.Now(),
 	}
+	password, err := o.hashPassword(req.User.Password)
+	if err != nil {
+		return nil, err
+	}
 	account := &chatdb.Account{
 		UserID:         req.User.UserID,
-		Password:       req.User.Password,
+		Password:       password,
 		OperatorUserID: mcontext.GetOpUserID(ctx),
 		ChangeTime:     register.CreateTime,
 		CreateTime:     register.CreateTime,
@@ -402,9 +435,10 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 	}
 	var resp chat.RegisterUserResp
 	if req.AutoLogin {
-		chatToken, err := o.Admin.CreateToken(ctx, req.User.UserID, constant.NormalUser)
+		chatToken, err := o.Admin.CreateToken(ctx, req.User.UserID, constant.NormalUser, req.Platform, req.DeviceID, req.Ip)
 		if err == nil {
 			resp.ChatToken = chatToken.Token
+			resp.RefreshToken = chatToken.RefreshToken
 		} else {
 			log.ZError(ctx, "Admin CreateToken Failed", err, "userID", req.User.UserID, "platform", req.Platform)
 		}
@@ -443,16 +477,48 @@ func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginRes
 	default:
 		return nil, errs.ErrArgs.WrapMsg("account or phone number or email must be set")
 	}
+	if err := o.LoginLock.Check(ctx, "", req.Ip); err != nil {
+		return nil, err
+	}
+	conf, err := o.Admin.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
 	credential, err = o.Database.TakeCredentialByAccount(ctx, acc)
 	if err != nil {
 		if dbutil.IsDBNotFound(err) {
-			return nil, eerrs.ErrAccountNotFound.WrapMsg("user unregistered")
+			if need, err := o.loginCaptchaRequired(ctx, conf, "", req.Ip); err != nil {
+				return nil, err
+			} else if need {
+				if err := o.checkCaptcha(ctx, req.CaptchaID, req.CaptchaAnswer); err != nil {
+					return nil, err
+				}
+			}
+			return nil, o.LoginLock.Failed(ctx, "", req.Ip, eerrs.ErrAccountNotFound.WrapMsg("user unregistered"))
 		}
 		return nil, err
 	}
-	if err := o.Admin.CheckLogin(ctx, credential.UserID, req.Ip); err != nil {
+	if err := o.LoginLock.Check(ctx, credential.UserID, ""); err != nil {
 		return nil, err
 	}
+	// a blocked user still has to prove the credentials, and pass the captcha of a geo rule,
+	// before getting an appeal ticket
+	check, err := o.Admin.CheckLoginBlocked(ctx, credential.UserID, req.Ip)
+	if err != nil {
+		return nil, err
+	}
+	needCaptcha := check.Verify
+	if !needCaptcha {
+		needCaptcha, err = o.loginCaptchaRequired(ctx, conf, credential.UserID, req.Ip)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if needCaptcha {
+		if err := o.checkCaptcha(ctx, req.CaptchaID, req.CaptchaAnswer); err != nil {
+			return nil, err
+		}
+	}
 	var verifyCodeID *string
 	if req.Password == "" {
 		var (
@@ -461,16 +527,16 @@ func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginRes
 
 		if req.Email == "" {
 			account := o.verifyCodeJoin(req.AreaCode, req.PhoneNumber)
-			id, err = o.verifyCode(ctx, account, req.VerifyCode, phone)
-			if err != nil {
-				return nil, err
-			}
+			id, err = o.verifyCode(ctx, account, req.VerifyCode, phone, constant.VerificationCodeForLogin)
 		} else {
 			account := req.Email
-			id, err = o.verifyCode(ctx, account, req.VerifyCode, mail)
-			if err != nil {
-				return nil, err
+			id, err = o.verifyCode(ctx, account, req.VerifyCode, mail, constant.VerificationCodeForLogin)
+		}
+		if err != nil {
+			if errors.Is(err, eerrs.ErrVerifyCodeNotMatch) {
+				return nil, o.LoginLock.Failed(ctx, credential.UserID, req.Ip, err)
 			}
+			return nil, err
 		}
 
 		if id != "" {
@@ -481,20 +547,45 @@ func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginRes
 		if err != nil {
 			return nil, err
 		}
-		if account.Password != req.Password {
-			return nil, eerrs.ErrPassword.Wrap()
+		if err := o.checkPassword(ctx, account, req.Password); err != nil {
+			if errors.Is(err, eerrs.ErrPassword) {
+				return nil, o.LoginLock.Failed(ctx, credential.UserID, req.Ip, err)
+			}
+			return nil, err
 		}
 	}
-	chatToken, err := o.Admin.CreateToken(ctx, credential.UserID, constant.NormalUser)
+	if check.Blocked {
+		return nil, o.blockedLogin(ctx, credential.UserID)
+	}
+	o.LoginLock.Succeeded(ctx, credential.UserID)
+	// with TOTP the second factor comes first, LoginTOTP checks the login once it is done
+	ticket, err := o.totpChallenge(ctx, credential.UserID, req, verifyCodeID)
+	if err != nil {
+		return nil, err
+	}
+	if ticket != "" {
+		resp.UserID = credential.UserID
+		resp.TotpTicket = ticket
+		return resp, nil
+	}
+	if err := o.checkSuspiciousLogin(ctx, credential.UserID, req.Ip, check.Country, req.DeviceID, req.Platform, req.Password == ""); err != nil {
+		return nil, err
+	}
+	return o.completeLogin(ctx, credential.UserID, req.Platform, req.DeviceID, req.Ip, check.Country, verifyCodeID)
+}
+
+func (o *chatSvr) completeLogin(ctx context.Context, userID string, platform int32, deviceID string, ip string, country string, verifyCodeID *string) (*chat.LoginResp, error) {
+	chatToken, err := o.Admin.CreateToken(ctx, userID, constant.NormalUser, platform, deviceID, ip)
 	if err != nil {
 		return nil, err
 	}
 	record := &chatdb.UserLoginRecord{
-		UserID:    credential.UserID,
+		UserID:    userID,
 		LoginTime: time.Now(),
-		IP:        req.Ip,
-		DeviceID:  req.DeviceID,
-		Platform:  constantpb.PlatformIDToName(int(req.Platform)),
+		IP:        ip,
+		Country:   country,
+		DeviceID:  deviceID,
+		Platform:  constantpb.PlatformIDToName(int(platform)),
 	}
 	if err := o.Database.LoginRecord(ctx, record, verifyCodeID); err != nil {
 		return nil, err
@@ -504,7 +595,9 @@ func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginRes
 			return nil, err
 		}
 	}
-	resp.UserID = credential.UserID
-	resp.ChatToken = chatToken.Token
-	return resp, nil
+	return &chat.LoginResp{
+		UserID:       userID,
+		ChatToken:    chatToken.Token,
+		RefreshToken: chatToken.RefreshToken,
+	}, nil
 }
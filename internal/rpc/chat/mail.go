@@ -0,0 +1,70 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/email"
+)
+
+// newMailProvider builds the named provider; mail is the SMTP server of the mail config.
+func newMailProvider(conf *config.VerifyCode, use string) (email.Mail, error) {
+	mail := &conf.Mail
+	switch strings.ToLower(use) {
+	case constant.VerifyMail:
+		return email.NewMail(mail.SMTPAddr, mail.SMTPPort, mail.SenderMail, mail.SenderName, mail.SenderAuthorizationCode, mail.TLS, mail.CAFile)
+	case constant.VerifySES:
+		c := mail.SES
+		return email.NewSES(c.Region, c.AccessKeyID, c.SecretAccessKey, c.ConfigurationSet, mail.SenderMail, mail.SenderName)
+	case constant.VerifySendGrid:
+		return email.NewSendGrid(mail.SendGrid.APIKey, mail.SenderMail, mail.SenderName)
+	case constant.VerifyMailgun:
+		c := mail.Mailgun
+		return email.NewMailgun(c.Domain, c.APIKey, c.BaseURL, mail.SenderMail, mail.SenderName)
+	default:
+		return nil, errs.New("unknown mail provider", "name", use)
+	}
+}
+
+// newMail builds the provider in use and the failover provider. The failover is the SMTP server of
+// its own block when its use is mail, or when use is empty and the block has an smtpAddr.
+func newMail(conf *config.VerifyCode) (email.Mail, email.Mail, error) {
+	def, err := newMailProvider(conf, conf.Mail.Use)
+	if err != nil {
+		return nil, nil, err
+	}
+	f := conf.Mail.Failover
+	switch use := strings.ToLower(f.Use); {
+	case use == "" && f.SMTPAddr == "":
+		return def, nil, nil
+	case use == "" || use == constant.VerifyMail:
+		failover, err := email.NewMail(f.SMTPAddr, f.SMTPPort, f.SenderMail, conf.Mail.SenderName, f.SenderAuthorizationCode, f.TLS, f.CAFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		return def, failover, nil
+	default:
+		failover, err := newMailProvider(conf, use)
+		if err != nil {
+			return nil, nil, err
+		}
+		return def, failover, nil
+	}
+}
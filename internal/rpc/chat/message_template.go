@@ -0,0 +1,127 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/chat/pkg/sms"
+)
+
+func toPbMessageTemplate(t *chatdb.MessageTemplate) *chat.MessageTemplate {
+	return &chat.MessageTemplate{
+		Channel:    t.Channel,
+		UsedFor:    t.UsedFor,
+		Locale:     t.Locale,
+		Subject:    t.Subject,
+		Html:       t.HTML,
+		Text:       t.Text,
+		UpdateTime: t.UpdateTime.UnixMilli(),
+	}
+}
+
+func (o *chatSvr) SetMessageTemplate(ctx context.Context, req *chat.SetMessageTemplateReq) (*chat.SetMessageTemplateResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermTemplateManage); err != nil {
+		return nil, err
+	}
+	locale, err := canonicalLocale(req.Template.Locale)
+	if err != nil {
+		return nil, err
+	}
+	t := &chatdb.MessageTemplate{
+		Channel:    req.Template.Channel,
+		UsedFor:    req.Template.UsedFor,
+		Locale:     locale,
+		Subject:    req.Template.Subject,
+		HTML:       req.Template.Html,
+		Text:       req.Template.Text,
+		UpdateTime: time.Now(),
+	}
+	if _, err := compileMessageTemplate(t); err != nil {
+		return nil, err
+	}
+	if err := o.Database.SetMessageTemplate(ctx, t); err != nil {
+		return nil, err
+	}
+	o.reloadMessageTemplates(ctx)
+	resp := &chat.SetMessageTemplateResp{}
+	if t.Channel == constant.DeliveryChannelSMS {
+		resp.IgnoredBy = o.smsProvidersWithoutText()
+	}
+	return resp, nil
+}
+
+// smsProvidersWithoutText returns the names of the SMS providers in use sending a template kept
+// by the provider, such as ali, tencent and http, which never use the templates set here.
+func (o *chatSvr) smsProvidersWithoutText() []string {
+	var providers []sms.SMS
+	if o.SMS != nil {
+		providers = append(providers, sms.Providers(o.SMS)...)
+	}
+	if o.SMSFailover != nil {
+		providers = append(providers, sms.Providers(o.SMSFailover)...)
+	}
+	var names []string
+	for _, p := range providers {
+		if _, ok := p.(sms.TextSMS); !ok {
+			names = append(names, p.Name())
+		}
+	}
+	return datautil.Distinct(names)
+}
+
+// DelMessageTemplate deletes a template set through the admin API, the one on disk or built in
+// for the same channel, purpose and locale applies again.
+func (o *chatSvr) DelMessageTemplate(ctx context.Context, req *chat.DelMessageTemplateReq) (*chat.DelMessageTemplateResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermTemplateManage); err != nil {
+		return nil, err
+	}
+	locale, err := canonicalLocale(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelMessageTemplate(ctx, req.Channel, req.UsedFor, locale); err != nil {
+		return nil, err
+	}
+	o.reloadMessageTemplates(ctx)
+	return &chat.DelMessageTemplateResp{}, nil
+}
+
+func (o *chatSvr) SearchMessageTemplate(ctx context.Context, req *chat.SearchMessageTemplateReq) (*chat.SearchMessageTemplateResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermTemplateManage); err != nil {
+		return nil, err
+	}
+	var locale string
+	if req.Locale != "" {
+		var err error
+		if locale, err = canonicalLocale(req.Locale); err != nil {
+			return nil, err
+		}
+	}
+	total, templates, err := o.Database.SearchMessageTemplate(ctx, req.Channel, locale, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.SearchMessageTemplateResp{
+		Total:     uint32(total),
+		Templates: datautil.Slice(templates, toPbMessageTemplate),
+	}, nil
+}
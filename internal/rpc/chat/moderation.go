@@ -0,0 +1,254 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/idutil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+// spacelessLanguages are written without spaces between words, so their words match anywhere.
+var spacelessLanguages = map[string]struct{}{"zh": {}, "ja": {}, "ko": {}, "th": {}, "lo": {}, "km": {}, "my": {}}
+
+// moderationRules is a snapshot of the enabled moderation rules and the allowlist, rebuilt from
+// mongo and swapped in whole so callbacks never wait on a reload.
+type moderationRules struct {
+	rules []*moderationRule
+	allow map[string]struct{}
+}
+
+type moderationRule struct {
+	*chatdb.ModerationRule
+	re        *regexp.Regexp
+	wholeWord bool
+}
+
+// find returns the byte ranges of text the rule matches.
+func (r *moderationRule) find(text string) [][]int {
+	spans := r.re.FindAllStringIndex(text, -1)
+	res := spans[:0]
+	for _, span := range spans {
+		if span[0] == span[1] {
+			continue
+		}
+		if r.wholeWord {
+			before, _ := utf8.DecodeLastRuneInString(text[:span[0]])
+			after, _ := utf8.DecodeRuneInString(text[span[1]:])
+			if isWordRune(before) || isWordRune(after) {
+				continue
+			}
+		}
+		res = append(res, span)
+	}
+	return res
+}
+
+func isWordRune(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsNumber(r) || r == '_')
+}
+
+func baseLanguage(language string) string {
+	language, _, _ = strings.Cut(strings.ToLower(language), "-")
+	return language
+}
+
+func compileModerationRule(rule *chatdb.ModerationRule) (*moderationRule, error) {
+	if rule.Type == constant.ModerationRuleRegex {
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		return &moderationRule{ModerationRule: rule, re: re}, nil
+	}
+	_, spaceless := spacelessLanguages[baseLanguage(rule.Language)]
+	return &moderationRule{
+		ModerationRule: rule,
+		re:             regexp.MustCompile("(?i)" + regexp.QuoteMeta(rule.Pattern)),
+		wholeWord:      !spaceless,
+	}, nil
+}
+
+func (o *chatSvr) loadModerationRules(ctx context.Context) error {
+	rules, err := o.Database.FindAllModerationRule(ctx)
+	if err != nil {
+		return err
+	}
+	users, err := o.Database.FindAllModerationAllowUser(ctx)
+	if err != nil {
+		return err
+	}
+	languages := make(map[string]struct{}, len(o.Moderation.Languages))
+	for _, language := range o.Moderation.Languages {
+		languages[baseLanguage(language)] = struct{}{}
+	}
+	snapshot := &moderationRules{
+		rules: make([]*moderationRule, 0, len(rules)),
+		allow: make(map[string]struct{}, len(users)),
+	}
+	for _, rule := range rules {
+		if !rule.Enabled {
+			continue
+		}
+		if len(languages) > 0 && rule.Language != "" {
+			if _, ok := languages[baseLanguage(rule.Language)]; !ok {
+				continue
+			}
+		}
+		compiled, err := compileModerationRule(rule)
+		if err != nil {
+			log.ZWarn(ctx, "skip invalid moderation rule", err, "ruleID", rule.RuleID, "pattern", rule.Pattern)
+			continue
+		}
+		snapshot.rules = append(snapshot.rules, compiled)
+	}
+	for _, user := range users {
+		snapshot.allow[user.UserID] = struct{}{}
+	}
+	o.moderationRules.Store(snapshot)
+	return nil
+}
+
+// refreshModerationRules reloads the rules every interval, so changes made through other instances take effect.
+func (o *chatSvr) refreshModerationRules(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx := mcontext.SetOperationID(context.Background(), "refresh_moderation_rules_"+time.Now().Format("20060102150405"))
+		if err := o.loadModerationRules(ctx); err != nil {
+			log.ZError(ctx, "refresh moderation rules failed", err)
+		}
+	}
+}
+
+// reloadModerationRules applies a change made through this instance right away.
+func (o *chatSvr) reloadModerationRules(ctx context.Context) {
+	if err := o.loadModerationRules(ctx); err != nil {
+		log.ZError(ctx, "reload moderation rules failed", err)
+	}
+}
+
+// moderationResult is what the rules of a scope found in a text.
+type moderationResult struct {
+	reject *moderationRule   // the first matching reject rule
+	flags  []*moderationRule // matching flag rules
+	masked string            // text with the matches of mask rules replaced
+}
+
+// moderate applies the rules of scope to the text of userID.
+func (o *chatSvr) moderate(userID string, scope int32, text string) *moderationResult {
+	res := &moderationResult{masked: text}
+	snapshot := o.moderationRules.Load()
+	if text == "" || snapshot == nil {
+		return res
+	}
+	if _, ok := snapshot.allow[userID]; ok {
+		return res
+	}
+	var masks [][]int
+	for _, rule := range snapshot.rules {
+		if rule.Scope != constant.ModerationScopeAll && rule.Scope != scope {
+			continue
+		}
+		spans := rule.find(text)
+		if len(spans) == 0 {
+			continue
+		}
+		switch rule.Action {
+		case constant.ModerationActionReject:
+			if res.reject == nil {
+				res.reject = rule
+			}
+		case constant.ModerationActionFlag:
+			res.flags = append(res.flags, rule)
+		case constant.ModerationActionMask:
+			masks = append(masks, spans...)
+		}
+	}
+	if len(masks) > 0 {
+		res.masked = maskSpans(text, masks)
+	}
+	return res
+}
+
+// maskSpans replaces every rune within the byte ranges with *.
+func maskSpans(text string, spans [][]int) string {
+	var sb strings.Builder
+	sb.Grow(len(text))
+	for i, r := range text {
+		masked := false
+		for _, span := range spans {
+			if i >= span[0] && i < span[1] {
+				masked = true
+				break
+			}
+		}
+		if masked {
+			sb.WriteByte('*')
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// moderationExcerpt is the most of the moderated text kept in a report.
+const moderationExcerpt = 500
+
+// reportModerationHits files a report against userID for each rule, so the hits show up in the
+// moderation queue. Repeated hits of a rule update its pending report.
+func (o *chatSvr) reportModerationHits(ctx context.Context, userID string, where string, text string, rules []*moderationRule) {
+	if len(rules) == 0 {
+		return
+	}
+	if runes := []rune(text); len(runes) > moderationExcerpt {
+		text = string(runes[:moderationExcerpt]) + "…"
+	}
+	go func(ctx context.Context) {
+		now := time.Now()
+		for _, rule := range rules {
+			category := rule.Category
+			if category == "" {
+				category = constant.BlockCategoryOther
+			}
+			_, err := o.Database.UpsertReport(ctx, &chatdb.Report{
+				ReportID:       idutil.OperationIDGenerator(),
+				ReporterUserID: constant.ModerationReporter + rule.RuleID,
+				TargetType:     constant.ReportTargetUser,
+				TargetID:       userID,
+				TargetUserID:   userID,
+				Category:       category,
+				Content:        fmt.Sprintf("%s rule %q matched %s: %s", rule.Action, rule.Name, where, text),
+				Status:         constant.ReportStatusPending,
+				CreateTime:     now,
+				UpdateTime:     now,
+			})
+			if err != nil {
+				log.ZError(ctx, "report moderation hit failed", err, "userID", userID, "ruleID", rule.RuleID)
+			}
+		}
+	}(context.WithoutCancel(ctx))
+}
@@ -0,0 +1,199 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/idutil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+func toPbModerationRule(rule *chatdb.ModerationRule) *chat.ModerationRule {
+	return &chat.ModerationRule{
+		RuleID:     rule.RuleID,
+		Name:       rule.Name,
+		Type:       rule.Type,
+		Pattern:    rule.Pattern,
+		Language:   rule.Language,
+		Scope:      rule.Scope,
+		Action:     rule.Action,
+		Category:   rule.Category,
+		Enabled:    rule.Enabled,
+		CreateTime: rule.CreateTime.UnixMilli(),
+		UpdateTime: rule.UpdateTime.UnixMilli(),
+	}
+}
+
+func (o *chatSvr) AddModerationRule(ctx context.Context, req *chat.AddModerationRuleReq) (*chat.AddModerationRuleResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermModerationManage); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	rule := &chatdb.ModerationRule{
+		RuleID:     idutil.OperationIDGenerator(),
+		Name:       req.Rule.Name,
+		Type:       req.Rule.Type,
+		Pattern:    req.Rule.Pattern,
+		Language:   req.Rule.Language,
+		Scope:      req.Rule.Scope,
+		Action:     req.Rule.Action,
+		Category:   req.Rule.Category,
+		Enabled:    req.Rule.Enabled,
+		CreateTime: now,
+		UpdateTime: now,
+	}
+	if err := o.Database.AddModerationRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	o.reloadModerationRules(ctx)
+	return &chat.AddModerationRuleResp{RuleID: rule.RuleID}, nil
+}
+
+func (o *chatSvr) UpdateModerationRule(ctx context.Context, req *chat.UpdateModerationRuleReq) (*chat.UpdateModerationRuleResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermModerationManage); err != nil {
+		return nil, err
+	}
+	rule, err := o.Database.TakeModerationRule(ctx, req.RuleID)
+	if err != nil {
+		return nil, err
+	}
+	merged := toPbModerationRule(rule)
+	update := make(map[string]any)
+	if req.Name != nil {
+		merged.Name = req.Name.Value
+		update["name"] = req.Name.Value
+	}
+	if req.Type != nil {
+		merged.Type = req.Type.Value
+		update["type"] = req.Type.Value
+	}
+	if req.Pattern != nil {
+		merged.Pattern = req.Pattern.Value
+		update["pattern"] = req.Pattern.Value
+	}
+	if req.Language != nil {
+		merged.Language = req.Language.Value
+		update["language"] = req.Language.Value
+	}
+	if req.Scope != nil {
+		merged.Scope = req.Scope.Value
+		update["scope"] = req.Scope.Value
+	}
+	if req.Action != nil {
+		merged.Action = req.Action.Value
+		update["action"] = req.Action.Value
+	}
+	if req.Category != nil {
+		merged.Category = req.Category.Value
+		update["category"] = req.Category.Value
+	}
+	if req.Enabled != nil {
+		update["enabled"] = req.Enabled.Value
+	}
+	// the changed fields have to make a valid rule together with the stored ones
+	if err := (&chat.AddModerationRuleReq{Rule: merged}).Check(); err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateModerationRule(ctx, req.RuleID, update); err != nil {
+		return nil, err
+	}
+	o.reloadModerationRules(ctx)
+	return &chat.UpdateModerationRuleResp{}, nil
+}
+
+func (o *chatSvr) DelModerationRule(ctx context.Context, req *chat.DelModerationRuleReq) (*chat.DelModerationRuleResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermModerationManage); err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelModerationRule(ctx, req.RuleIDs); err != nil {
+		return nil, err
+	}
+	o.reloadModerationRules(ctx)
+	return &chat.DelModerationRuleResp{}, nil
+}
+
+func (o *chatSvr) SearchModerationRule(ctx context.Context, req *chat.SearchModerationRuleReq) (*chat.SearchModerationRuleResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermModerationManage); err != nil {
+		return nil, err
+	}
+	total, rules, err := o.Database.SearchModerationRule(ctx, req.Keyword, req.Language, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.SearchModerationRuleResp{
+		Total: uint32(total),
+		Rules: datautil.Slice(rules, toPbModerationRule),
+	}, nil
+}
+
+func (o *chatSvr) AddModerationAllowUser(ctx context.Context, req *chat.AddModerationAllowUserReq) (*chat.AddModerationAllowUserResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermModerationManage); err != nil {
+		return nil, err
+	}
+	existing, err := o.Database.FindAllModerationAllowUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exist := datautil.SliceSetAny(existing, func(u *chatdb.ModerationAllowUser) string { return u.UserID })
+	now := time.Now()
+	users := make([]*chatdb.ModerationAllowUser, 0, len(req.UserIDs))
+	for _, userID := range req.UserIDs {
+		if _, ok := exist[userID]; ok {
+			continue
+		}
+		users = append(users, &chatdb.ModerationAllowUser{UserID: userID, Note: req.Note, CreateTime: now})
+	}
+	if len(users) == 0 {
+		return &chat.AddModerationAllowUserResp{}, nil
+	}
+	if err := o.Database.AddModerationAllowUser(ctx, users); err != nil {
+		return nil, err
+	}
+	o.reloadModerationRules(ctx)
+	return &chat.AddModerationAllowUserResp{}, nil
+}
+
+func (o *chatSvr) DelModerationAllowUser(ctx context.Context, req *chat.DelModerationAllowUserReq) (*chat.DelModerationAllowUserResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermModerationManage); err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelModerationAllowUser(ctx, req.UserIDs); err != nil {
+		return nil, err
+	}
+	o.reloadModerationRules(ctx)
+	return &chat.DelModerationAllowUserResp{}, nil
+}
+
+func (o *chatSvr) SearchModerationAllowUser(ctx context.Context, req *chat.SearchModerationAllowUserReq) (*chat.SearchModerationAllowUserResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermModerationManage); err != nil {
+		return nil, err
+	}
+	total, users, err := o.Database.SearchModerationAllowUser(ctx, req.Keyword, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.SearchModerationAllowUserResp{
+		Total: uint32(total),
+		Users: datautil.Slice(users, func(u *chatdb.ModerationAllowUser) *chat.ModerationAllowUser {
+			return &chat.ModerationAllowUser{UserID: u.UserID, Note: u.Note, CreateTime: u.CreateTime.UnixMilli()}
+		}),
+	}, nil
+}
@@ -18,9 +18,12 @@ import (
 	"context"
 
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
 
 	"github.com/openimsdk/chat/pkg/common/constant"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
 	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 )
 
@@ -36,9 +39,9 @@ func (o *chatSvr) ResetPassword(ctx context.Context, req *chat.ResetPasswordReq)
 	var verifyCodeID string
 	var err error
 	if req.Email == "" {
-		verifyCodeID, err = o.verifyCode(ctx, o.verifyCodeJoin(req.AreaCode, req.PhoneNumber), req.VerifyCode, phone)
+		verifyCodeID, err = o.verifyCode(ctx, o.verifyCodeJoin(req.AreaCode, req.PhoneNumber), req.VerifyCode, phone, constant.VerificationCodeForResetPassword)
 	} else {
-		verifyCodeID, err = o.verifyCode(ctx, req.Email, req.VerifyCode, mail)
+		verifyCodeID, err = o.verifyCode(ctx, req.Email, req.VerifyCode, mail, constant.VerificationCodeForResetPassword)
 	}
 
 	if err != nil {
@@ -54,7 +57,11 @@ func (o *chatSvr) ResetPassword(ctx context.Context, req *chat.ResetPasswordReq)
 	if err != nil {
 		return nil, err
 	}
-	err = o.Database.UpdatePasswordAndDeleteVerifyCode(ctx, cred.UserID, req.Password, verifyCodeID)
+	password, err := o.Passwd.Hash(req.Password)
+	if err != nil {
+		return nil, err
+	}
+	err = o.Database.UpdatePasswordAndDeleteVerifyCode(ctx, cred.UserID, password, verifyCodeID)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +79,9 @@ func (o *chatSvr) ChangePassword(ctx context.Context, req *chat.ChangePasswordRe
 	if err != nil {
 		return nil, err
 	}
+	if err := mctx.CheckNotImpersonated(ctx); err != nil {
+		return nil, err
+	}
 	switch userType {
 	case constant.NormalUser:
 		if req.UserID == "" {
@@ -84,6 +94,9 @@ func (o *chatSvr) ChangePassword(ctx context.Context, req *chat.ChangePasswordRe
 		if req.UserID == "" {
 			return nil, errs.ErrArgs.WrapMsg("user id must be set")
 		}
+		if err := o.Admin.CheckPermission(ctx, constant.PermUsersWrite); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, errs.ErrInternalServer.WrapMsg("invalid user type")
 	}
@@ -92,14 +105,20 @@ func (o *chatSvr) ChangePassword(ctx context.Context, req *chat.ChangePasswordRe
 		return nil, err
 	}
 	if userType != constant.AdminUser {
-		if user.Password != req.CurrentPassword {
+		match, _, err := o.Passwd.Verify(user.Password, req.CurrentPassword)
+		if err != nil {
+			return nil, err
+		}
+		if !match {
 			return nil, errs.ErrNoPermission.WrapMsg("current password is wrong")
 		}
 	}
-	if user.Password != req.NewPassword {
-		if err := o.Database.UpdatePassword(ctx, req.UserID, req.NewPassword); err != nil {
-			return nil, err
-		}
+	password, err := o.Passwd.Hash(req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdatePassword(ctx, req.UserID, password); err != nil {
+		return nil, err
 	}
 	if err := o.Admin.InvalidateToken(ctx, req.UserID); err != nil {
 		return nil, err
@@ -107,3 +126,33 @@ func (o *chatSvr) ChangePassword(ctx context.Context, req *chat.ChangePasswordRe
 
 	return &chat.ChangePasswordResp{}, nil
 }
+
+func (o *chatSvr) hashPassword(password string) (string, error) {
+	if password == "" {
+		return "", nil
+	}
+	return o.Passwd.Hash(password)
+}
+
+// checkPassword verifies password against the stored account and upgrades
+// legacy or outdated hashes after a successful match.
+func (o *chatSvr) checkPassword(ctx context.Context, account *chatdb.Account, password string) error {
+	match, rehash, err := o.Passwd.Verify(account.Password, password)
+	if err != nil {
+		return err
+	}
+	if !match {
+		return eerrs.ErrPassword.Wrap()
+	}
+	if rehash {
+		hash, err := o.Passwd.Hash(password)
+		if err != nil {
+			log.ZError(ctx, "rehash password failed", err, "userID", account.UserID)
+			return nil
+		}
+		if err := o.Database.UpdatePassword(ctx, account.UserID, hash); err != nil {
+			log.ZError(ctx, "update rehashed password failed", err, "userID", account.UserID)
+		}
+	}
+	return nil
+}
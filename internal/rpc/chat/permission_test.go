@@ -0,0 +1,95 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/protocol/wrapperspb"
+	"github.com/openimsdk/tools/errs"
+	"google.golang.org/grpc"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	chatClient "github.com/openimsdk/chat/pkg/rpclient/chat"
+)
+
+// permClient grants the admins nothing.
+type permClient struct {
+	admin.AdminClient
+	checked []string
+}
+
+func (c *permClient) CheckAdminPermission(ctx context.Context, req *admin.CheckAdminPermissionReq, opts ...grpc.CallOption) (*admin.CheckAdminPermissionResp, error) {
+	c.checked = append(c.checked, req.Permissions...)
+	return nil, errs.ErrNoPermission.WrapMsg("permission denied")
+}
+
+func TestAdminRPCsCheckPermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		permission string
+		call       func(o *chatSvr, ctx context.Context) error
+	}{
+		{name: "ChangePassword", permission: constant.PermUsersWrite, call: func(o *chatSvr, ctx context.Context) error {
+			_, err := o.ChangePassword(ctx, &chat.ChangePasswordReq{UserID: "u1", NewPassword: "new"})
+			return err
+		}},
+		{name: "UpdateUserInfo", permission: constant.PermUsersWrite, call: func(o *chatSvr, ctx context.Context) error {
+			_, err := o.UpdateUserInfo(ctx, &chat.UpdateUserInfoReq{UserID: "u1", Nickname: wrapperspb.String("n")})
+			return err
+		}},
+		{name: "AddUserAccount", permission: constant.PermUsersWrite, call: func(o *chatSvr, ctx context.Context) error {
+			_, err := o.AddUserAccount(ctx, &chat.AddUserAccountReq{})
+			return err
+		}},
+		{name: "SearchUserInfo", permission: constant.PermUsersRead, call: func(o *chatSvr, ctx context.Context) error {
+			_, err := o.SearchUserInfo(ctx, &chat.SearchUserInfoReq{})
+			return err
+		}},
+		{name: "UserLoginCount", permission: constant.PermStatisticRead, call: func(o *chatSvr, ctx context.Context) error {
+			_, err := o.UserLoginCount(ctx, &chat.UserLoginCountReq{})
+			return err
+		}},
+		{name: "NotifyImpersonation", permission: constant.PermUsersImpersonate, call: func(o *chatSvr, ctx context.Context) error {
+			_, err := o.NotifyImpersonation(ctx, &chat.NotifyImpersonationReq{UserID: "u1"})
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &permClient{}
+			o := &chatSvr{Admin: chatClient.NewAdminClient(client)}
+			err := tt.call(o, mctx.WithAdminUser(context.Background(), "a1"))
+			if !errs.ErrNoPermission.Is(err) {
+				t.Fatalf("err = %v, want no permission", err)
+			}
+			if len(client.checked) != 1 || client.checked[0] != tt.permission {
+				t.Fatalf("checked %v, want %s", client.checked, tt.permission)
+			}
+		})
+	}
+}
+
+func TestSearchUserInfoByUser(t *testing.T) {
+	o := &chatSvr{}
+	ctx := mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser)
+	if _, err := o.SearchUserInfo(ctx, &chat.SearchUserInfoReq{}); !errs.ErrNoPermission.Is(err) {
+		t.Fatalf("err = %v, want no permission", err)
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/idutil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/chat/pkg/sms"
+)
+
+// AddReport files the user's report of abuse, reporting a target again updates the pending report.
+func (o *chatSvr) AddReport(ctx context.Context, req *chat.AddReportReq) (*chat.AddReportResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targetUserID := req.TargetUserID
+	if req.TargetType == constant.ReportTargetUser {
+		targetUserID = req.TargetID
+	}
+	if targetUserID == userID {
+		return nil, errs.ErrArgs.WrapMsg("cannot report yourself")
+	}
+	if targetUserID != "" {
+		if _, err := o.Database.TakeAttributeByUserID(ctx, targetUserID); err != nil {
+			if dbutil.IsDBNotFound(err) {
+				return nil, errs.ErrArgs.WrapMsg("reported user not found")
+			}
+			return nil, err
+		}
+	}
+	now := time.Now()
+	report, err := o.Database.UpsertReport(ctx, &chatdb.Report{
+		ReportID:       idutil.OperationIDGenerator(),
+		ReporterUserID: userID,
+		TargetType:     req.TargetType,
+		TargetID:       req.TargetID,
+		TargetUserID:   targetUserID,
+		ConversationID: req.ConversationID,
+		Seq:            req.Seq,
+		Category:       req.Category,
+		Content:        req.Content,
+		Status:         constant.ReportStatusPending,
+		CreateTime:     now,
+		UpdateTime:     now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &chat.AddReportResp{ReportID: report.ReportID}, nil
+}
+
+func (o *chatSvr) SearchReport(ctx context.Context, req *chat.SearchReportReq) (*chat.SearchReportResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermUsersRead); err != nil {
+		return nil, err
+	}
+	total, reports, err := o.Database.SearchReport(ctx, req.TargetType, req.TargetID, req.Status, req.Category, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &chat.SearchReportResp{
+		Total:   uint32(total),
+		Reports: make([]*chat.Report, 0, len(reports)),
+	}
+	for _, report := range reports {
+		resp.Reports = append(resp.Reports, &chat.Report{
+			ReportID:       report.ReportID,
+			ReporterUserID: report.ReporterUserID,
+			TargetType:     report.TargetType,
+			TargetID:       report.TargetID,
+			TargetUserID:   report.TargetUserID,
+			ConversationID: report.ConversationID,
+			Seq:            report.Seq,
+			Category:       report.Category,
+			Content:        report.Content,
+			Status:         report.Status,
+			Action:         report.Action,
+			Note:           report.Note,
+			HandlerUserID:  report.HandlerUserID,
+			CreateTime:     report.CreateTime.UnixMilli(),
+			UpdateTime:     report.UpdateTime.UnixMilli(),
+			HandleTime:     timeMilli(report.HandleTime),
+		})
+	}
+	return resp, nil
+}
+
+// SearchReportTarget is the moderation queue: the reported targets, most reported first.
+func (o *chatSvr) SearchReportTarget(ctx context.Context, req *chat.SearchReportTargetReq) (*chat.SearchReportTargetResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermUsersRead); err != nil {
+		return nil, err
+	}
+	total, targets, err := o.Database.SearchReportTarget(ctx, req.TargetType, req.Status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &chat.SearchReportTargetResp{
+		Total:   uint32(total),
+		Targets: make([]*chat.ReportTarget, 0, len(targets)),
+	}
+	for _, target := range targets {
+		resp.Targets = append(resp.Targets, &chat.ReportTarget{
+			TargetType:     target.TargetType,
+			TargetID:       target.TargetID,
+			TargetUserID:   target.TargetUserID,
+			Count:          target.Count,
+			Categories:     target.Categories,
+			LastReportTime: target.LastReportTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+// reportClaimTimeout is how long a claim keeps other admins from handling the same reports, a claim
+// left by a handler that never finished is taken over after it.
+const reportClaimTimeout = 5 * time.Minute
+
+// HandleReport takes an action on a target and closes the pending reports against it.
+// The reports are claimed first so two admins handling the same target do not both act, and
+// go back to the queue when the action fails. Blocking goes through the admin rpc like a block
+// from the block list, a user blocked already is not an error.
+func (o *chatSvr) HandleReport(ctx context.Context, req *chat.HandleReportReq) (*chat.HandleReportResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermUsersBlock); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	handleID := idutil.OperationIDGenerator()
+	count, err := o.Database.ClaimReport(ctx, req.TargetType, req.TargetID, handleID, now, now.Add(-reportClaimTimeout))
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, errs.ErrArgs.WrapMsg("no pending reports against the target")
+	}
+	resp, err := o.handleReportAction(ctx, req)
+	if err != nil {
+		if err := o.Database.ReleaseReport(ctx, handleID); err != nil {
+			log.ZError(ctx, "release report claim failed", err, "handleID", handleID)
+		}
+		return nil, err
+	}
+	count, err = o.Database.FinishReport(ctx, handleID, map[string]any{
+		"status":          constant.ReportStatusHandled,
+		"action":          req.Action,
+		"note":            req.Note,
+		"handler_user_id": mcontext.GetOpUserID(ctx),
+		"handle_time":     time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Count = count
+	log.ZInfo(ctx, "reports handled", "targetType", req.TargetType, "targetID", req.TargetID, "action", req.Action, "userID", resp.UserID, "warnedBy", resp.WarnedBy, "count", count)
+	return resp, nil
+}
+
+func (o *chatSvr) handleReportAction(ctx context.Context, req *chat.HandleReportReq) (*chat.HandleReportResp, error) {
+	userID := req.UserID
+	if userID == "" && req.TargetType == constant.ReportTargetUser {
+		userID = req.TargetID
+	}
+	resp := &chat.HandleReportResp{}
+	switch req.Action {
+	case constant.ReportActionBlock:
+		// a user already blocked keeps that block, its end time and its OpenIM mutes
+		if err := o.Admin.BlockUser(ctx, userID, req.Note, req.Category, req.BlockExpireTime); err != nil && !eerrs.ErrAlreadyBlocked.Is(err) {
+			return nil, err
+		}
+		resp.UserID = userID
+	case constant.ReportActionWarn:
+		warnedBy, imWarning, err := o.warnUser(ctx, userID, req.Note)
+		if err != nil {
+			return nil, err
+		}
+		resp.UserID, resp.WarnedBy, resp.ImWarning = userID, warnedBy, imWarning
+	}
+	return resp, nil
+}
+
+// warnUser sends the user a warning about reported behaviour by email, else by SMS when the
+// provider sends free text. Without either it returns the warning for the caller to send as an
+// IM message.
+func (o *chatSvr) warnUser(ctx context.Context, userID string, note string) (string, string, error) {
+	attribute, err := o.Database.TakeAttributeByUserID(ctx, userID)
+	if err != nil {
+		return "", "", err
+	}
+	text := "We received reports about your account. Continued violations of the terms of use will get your account blocked."
+	if note != "" {
+		text += "\n\n" + note
+	}
+	if attribute.Email != "" && o.Mail != nil {
+		if err := o.deliverMailNotice(ctx, userID, attribute.Email, "Warning about your account", text); err != nil {
+			return "", "", err
+		}
+		return constant.WarnedByEmail, "", nil
+	}
+	if attribute.PhoneNumber != "" && o.SMS != nil {
+		if _, ok := sms.AsText(o.SMS, attribute.AreaCode); ok {
+			if err := o.deliverSMSTextNotice(ctx, userID, attribute.AreaCode, attribute.PhoneNumber, text); err != nil {
+				return "", "", err
+			}
+			return constant.WarnedBySMS, "", nil
+		}
+	}
+	return constant.WarnedByIM, text, nil
+}
+
+func timeMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
@@ -0,0 +1,186 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/database"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/email"
+	"github.com/openimsdk/chat/pkg/sms"
+)
+
+// reportDB holds the attribute of one user and the deliveries sent to them.
+type reportDB struct {
+	database.ChatDatabaseInterface
+	attribute  *chatdb.Attribute
+	deliveries []*chatdb.Delivery
+}
+
+func (d *reportDB) TakeAttributeByUserID(ctx context.Context, userID string) (*chatdb.Attribute, error) {
+	return d.attribute, nil
+}
+
+func (d *reportDB) AddDelivery(ctx context.Context, delivery *chatdb.Delivery) error {
+	d.deliveries = append(d.deliveries, delivery)
+	return nil
+}
+
+func (d *reportDB) UpdateDelivery(ctx context.Context, deliveryID string, data map[string]any) error {
+	return nil
+}
+
+// sentSMS sends templates only.
+type sentSMS struct {
+	sent []string
+}
+
+func (s *sentSMS) Name() string { return "sent" }
+
+func (s *sentSMS) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) (string, error) {
+	return "", nil
+}
+
+func (s *sentSMS) SendLoginNotice(ctx context.Context, areaCode string, phoneNumber string, params map[string]string) (string, error) {
+	return "", nil
+}
+
+// textSMS sends free text as well.
+type textSMS struct {
+	sentSMS
+}
+
+func (s *textSMS) SendText(ctx context.Context, areaCode string, phoneNumber string, text string) (string, error) {
+	s.sent = append(s.sent, text)
+	return "msg", nil
+}
+
+type sentMail struct {
+	sent []string
+}
+
+func (m *sentMail) Name() string { return "sent" }
+
+func (m *sentMail) SendMail(ctx context.Context, mail string, subject string, html string, text string) (string, error) {
+	return "", nil
+}
+
+func (m *sentMail) SendNotice(ctx context.Context, mail string, subject string, body string) (string, error) {
+	m.sent = append(m.sent, body)
+	return "msg", nil
+}
+
+func TestWarnUser(t *testing.T) {
+	const note = "Stop spamming the group."
+	tests := []struct {
+		name      string
+		attribute *chatdb.Attribute
+		sms       sms.SMS
+		mail      email.Mail
+		warnedBy  string
+	}{
+		{
+			name:      "email",
+			attribute: &chatdb.Attribute{UserID: "u1", Email: "u1@example.com", AreaCode: "+1", PhoneNumber: "5550100"},
+			sms:       &textSMS{},
+			mail:      &sentMail{},
+			warnedBy:  constant.WarnedByEmail,
+		},
+		{
+			name:      "sms without email",
+			attribute: &chatdb.Attribute{UserID: "u1", AreaCode: "+1", PhoneNumber: "5550100"},
+			sms:       &textSMS{},
+			mail:      &sentMail{},
+			warnedBy:  constant.WarnedBySMS,
+		},
+		{
+			name:      "sms without mail provider",
+			attribute: &chatdb.Attribute{UserID: "u1", Email: "u1@example.com", AreaCode: "+1", PhoneNumber: "5550100"},
+			sms:       &textSMS{},
+			warnedBy:  constant.WarnedBySMS,
+		},
+		{
+			name:      "im when sms sends templates only",
+			attribute: &chatdb.Attribute{UserID: "u1", AreaCode: "+1", PhoneNumber: "5550100"},
+			sms:       &sentSMS{},
+			mail:      &sentMail{},
+			warnedBy:  constant.WarnedByIM,
+		},
+		{
+			name:      "im without email and phone",
+			attribute: &chatdb.Attribute{UserID: "u1", Account: "u1"},
+			sms:       &textSMS{},
+			mail:      &sentMail{},
+			warnedBy:  constant.WarnedByIM,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &reportDB{attribute: tt.attribute}
+			o := &chatSvr{
+				Database: db,
+				SMS:      tt.sms,
+				Mail:     tt.mail,
+				Delivery: config.Delivery{Attempts: 1, Backoff: 10, MaxBackoff: 600, NoticeExpire: 86400},
+			}
+			warnedBy, imWarning, err := o.warnUser(context.Background(), "u1", note)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if warnedBy != tt.warnedBy {
+				t.Fatalf("warnedBy = %q, want %q", warnedBy, tt.warnedBy)
+			}
+			var sent []string
+			switch warnedBy {
+			case constant.WarnedByEmail:
+				sent = tt.mail.(*sentMail).sent
+			case constant.WarnedBySMS:
+				sent = tt.sms.(*textSMS).sent
+			case constant.WarnedByIM:
+				sent = []string{imWarning}
+				if len(db.deliveries) != 0 {
+					t.Fatalf("%d deliveries for an IM warning", len(db.deliveries))
+				}
+			}
+			if len(sent) != 1 || !strings.HasSuffix(sent[0], note) {
+				t.Fatalf("sent %q, want one warning with the note", sent)
+			}
+			if warnedBy != constant.WarnedByIM && imWarning != "" {
+				t.Fatalf("imWarning %q set for a warning sent by %s", imWarning, warnedBy)
+			}
+		})
+	}
+}
+
+func TestSendTextNoticeNeedsTextProvider(t *testing.T) {
+	o := &chatSvr{SMS: &sentSMS{}}
+	d := &chatdb.Delivery{
+		Channel:     constant.DeliveryChannelSMS,
+		Kind:        constant.DeliveryKindNotice,
+		AreaCode:    "+1",
+		PhoneNumber: "5550100",
+		Payload:     map[string]string{"text": "warning"},
+		ExpireTime:  time.Now().Add(time.Hour),
+	}
+	if _, _, err := o.send(context.Background(), d); err == nil {
+		t.Fatal("text notice sent through a provider without free text")
+	}
+}
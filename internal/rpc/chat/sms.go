@@ -0,0 +1,77 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/sms"
+)
+
+func newSMSRegistry(conf *config.VerifyCode) *sms.Registry {
+	phone := &conf.Phone
+	registry := sms.NewRegistry()
+	registry.Register(constant.VerifyALi, func() (sms.SMS, error) {
+		c := phone.Ali
+		return sms.NewAli(c.Endpoint, c.AccessKeyID, c.AccessKeySecret, c.SignName, c.VerificationCodeTemplateCode, c.LoginNoticeTemplateCode)
+	})
+	registry.Register(constant.VerifyTwilio, func() (sms.SMS, error) {
+		c := phone.Twilio
+		return sms.NewTwilio(c.AccountSID, c.AuthToken, c.From, c.MessagingServiceSID, c.VerificationCodeTemplate, c.LoginNoticeTemplate)
+	})
+	registry.Register(constant.VerifyTencent, func() (sms.SMS, error) {
+		c := phone.Tencent
+		return sms.NewTencent(c.SecretID, c.SecretKey, c.Region, c.SDKAppID, c.SignName, c.VerificationCodeTemplateID, c.LoginNoticeTemplateID)
+	})
+	registry.Register(constant.VerifySNS, func() (sms.SMS, error) {
+		c := phone.SNS
+		return sms.NewSNS(c.Region, c.AccessKeyID, c.SecretAccessKey, c.SenderID, c.VerificationCodeTemplate, c.LoginNoticeTemplate)
+	})
+	registry.Register(constant.VerifyHTTP, func() (sms.SMS, error) {
+		c := phone.HTTP
+		return sms.NewHTTP(c.URL, c.Method, c.Headers, c.VerificationCodeBody, c.LoginNoticeBody)
+	})
+	registry.Register(constant.VerifyFake, func() (sms.SMS, error) {
+		return sms.NewFake(phone.Fake.File)
+	})
+	return registry
+}
+
+// newSMS builds the provider in use with the providers routed to by area code, and the failover provider.
+func newSMS(conf *config.VerifyCode) (sms.SMS, sms.SMS, error) {
+	registry := newSMSRegistry(conf)
+	def, err := registry.Get(conf.Phone.Use)
+	if err != nil {
+		return nil, nil, err
+	}
+	var failover sms.SMS
+	if conf.Phone.Failover != "" {
+		failover, err = registry.Get(conf.Phone.Failover)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(conf.Phone.Routes) == 0 {
+		return def, failover, nil
+	}
+	routes := make(map[string]sms.SMS, len(conf.Phone.Routes))
+	for areaCode, name := range conf.Phone.Routes {
+		routes[areaCode], err = registry.Get(name)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return sms.NewRouter(def, routes), failover, nil
+}
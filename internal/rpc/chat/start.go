@@ -3,6 +3,7 @@ package chat
 import (
 	"context"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/openimsdk/chat/pkg/common/constant"
@@ -11,14 +12,18 @@ import (
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/mw"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/openimsdk/chat/pkg/captcha"
 	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
 	"github.com/openimsdk/chat/pkg/common/db/database"
+	"github.com/openimsdk/chat/pkg/common/passwd"
 	"github.com/openimsdk/chat/pkg/email"
 	chatClient "github.com/openimsdk/chat/pkg/rpclient/chat"
 	"github.com/openimsdk/chat/pkg/sms"
@@ -44,18 +49,27 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	config.RpcConfig.VerifyCode.Phone.Use = strings.ToLower(config.RpcConfig.VerifyCode.Phone.Use)
 	config.RpcConfig.VerifyCode.Mail.Use = strings.ToLower(config.RpcConfig.VerifyCode.Mail.Use)
 	srv.conf = config.RpcConfig.VerifyCode
-	switch config.RpcConfig.VerifyCode.Phone.Use {
-	case "ali":
-		ali := config.RpcConfig.VerifyCode.Phone.Ali
-		srv.SMS, err = sms.NewAli(ali.Endpoint, ali.AccessKeyID, ali.AccessKeySecret, ali.SignName, ali.VerificationCodeTemplateCode)
+	if use := config.RpcConfig.VerifyCode.Phone.Use; use != "" && use != constant.VerifySuperCode {
+		srv.SMS, srv.SMSFailover, err = newSMS(&config.RpcConfig.VerifyCode)
 		if err != nil {
 			return err
 		}
 	}
-	if mail := config.RpcConfig.VerifyCode.Mail; mail.Use == constant.VerifyMail {
-		srv.Mail = email.NewMail(mail.SMTPAddr, mail.SMTPPort, mail.SenderMail, mail.SenderAuthorizationCode, mail.Title)
+	if use := config.RpcConfig.VerifyCode.Mail.Use; use != "" && use != constant.VerifySuperCode {
+		srv.Mail, srv.MailFailover, err = newMail(&config.RpcConfig.VerifyCode)
+		if err != nil {
+			return err
+		}
+	}
+	srv.Passwd, err = passwd.New(config.RpcConfig.PasswordHash)
+	if err != nil {
+		return err
+	}
+	rdb, err := redisutil.NewRedisClient(ctx, config.RedisConfig.Build())
+	if err != nil {
+		return err
 	}
-	srv.Database, err = database.NewChatDatabase(mgocli)
+	srv.Database, err = database.NewChatDatabase(mgocli, rdb)
 	if err != nil {
 		return err
 	}
@@ -71,24 +85,109 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		SuperCode:  config.RpcConfig.VerifyCode.SuperCode,
 		ValidTime:  time.Duration(config.RpcConfig.VerifyCode.ValidTime) * time.Second,
 		Len:        config.RpcConfig.VerifyCode.Len,
+		HashKey:    []byte(config.RpcConfig.VerifyCode.HashKey),
+	}
+	if len(srv.Code.HashKey) == 0 {
+		srv.Code.HashKey = []byte(config.Share.OpenIM.Secret)
 	}
 	srv.Livekit = rtc.NewLiveKit(config.RpcConfig.LiveKit.Key, config.RpcConfig.LiveKit.Secret, config.RpcConfig.LiveKit.URL)
 	srv.AllowRegister = config.RpcConfig.AllowRegister
+	srv.TOTP = config.RpcConfig.TOTP
+	if srv.TOTP.Issuer == "" {
+		srv.TOTP.Issuer = "OpenIM"
+	}
+	if srv.TOTP.TicketExpire <= 0 {
+		srv.TOTP.TicketExpire = 300
+	}
+	if srv.TOTP.MaxAttempts <= 0 {
+		srv.TOTP.MaxAttempts = 5
+	}
+	srv.LoginLock = cache.NewLoginLocker(srv.Database, cache.NewLoginLockPolicy(config.RpcConfig.LoginLock), "user")
+	srv.SuspiciousLogin = config.RpcConfig.SuspiciousLogin
+	if srv.SuspiciousLogin.History <= 0 {
+		srv.SuspiciousLogin.History = 20
+	}
+	if srv.SuspiciousLogin.HistoryDays <= 0 {
+		srv.SuspiciousLogin.HistoryDays = 90
+	}
+	if srv.SuspiciousLogin.TravelHours <= 0 {
+		srv.SuspiciousLogin.TravelHours = 2
+	}
+	srv.Captcha, err = captcha.New(config.RpcConfig.Captcha, srv.Database)
+	if err != nil {
+		return err
+	}
+	srv.Moderation = config.RpcConfig.Moderation
+	if err := srv.loadModerationRules(ctx); err != nil {
+		return err
+	}
+	refresh := time.Duration(srv.Moderation.Refresh) * time.Second
+	if refresh <= 0 {
+		refresh = 30 * time.Second
+	}
+	go srv.refreshModerationRules(refresh)
+	srv.Delivery = config.RpcConfig.Delivery
+	if srv.Delivery.Attempts <= 0 {
+		srv.Delivery.Attempts = 5
+	}
+	if srv.Delivery.Backoff <= 0 {
+		srv.Delivery.Backoff = 10
+	}
+	if srv.Delivery.MaxBackoff < srv.Delivery.Backoff {
+		srv.Delivery.MaxBackoff = max(srv.Delivery.Backoff, 600)
+	}
+	if srv.Delivery.NoticeExpire <= 0 {
+		srv.Delivery.NoticeExpire = 86400
+	}
+	deliveryCheck := time.Duration(srv.Delivery.Check) * time.Second
+	if deliveryCheck <= 0 {
+		deliveryCheck = 5 * time.Second
+	}
+	go srv.retryDeliveries(deliveryCheck)
+	srv.MessageTemplate = config.RpcConfig.MessageTemplate
+	if srv.MessageTemplate.AppName == "" {
+		srv.MessageTemplate.AppName = "OpenIM"
+	}
+	if srv.MessageTemplate.DefaultLocale != "" {
+		if srv.MessageTemplate.DefaultLocale, err = canonicalLocale(srv.MessageTemplate.DefaultLocale); err != nil {
+			return err
+		}
+	}
+	if err := srv.loadMessageTemplates(ctx); err != nil {
+		return err
+	}
+	templateRefresh := time.Duration(srv.MessageTemplate.Refresh) * time.Second
+	if templateRefresh <= 0 {
+		templateRefresh = 30 * time.Second
+	}
+	go srv.refreshMessageTemplates(templateRefresh)
 	chat.RegisterChatServer(server, &srv)
 	return nil
 }
 
 type chatSvr struct {
 	chat.UnimplementedChatServer
-	conf            config.VerifyCode
-	Database        database.ChatDatabaseInterface
-	Admin           *chatClient.AdminClient
-	SMS             sms.SMS
-	Mail            email.Mail
-	Code            verifyCode
-	Livekit         *rtc.LiveKit
-	ChatAdminUserID string
-	AllowRegister   bool
+	conf             config.VerifyCode
+	Database         database.ChatDatabaseInterface
+	Admin            *chatClient.AdminClient
+	SMS              sms.SMS
+	SMSFailover      sms.SMS // takes every other retry, nil without failover
+	Mail             email.Mail
+	MailFailover     email.Mail
+	Code             verifyCode
+	Livekit          *rtc.LiveKit
+	ChatAdminUserID  string
+	AllowRegister    bool
+	Passwd           *passwd.Hasher
+	TOTP             config.TOTP
+	LoginLock        *cache.LoginLocker
+	Captcha          captcha.Provider
+	SuspiciousLogin  config.SuspiciousLogin
+	Moderation       config.Moderation
+	Delivery         config.Delivery
+	MessageTemplate  config.MessageTemplate
+	moderationRules  atomic.Pointer[moderationRules]
+	messageTemplates atomic.Pointer[messageTemplates]
 }
 
 func (o *chatSvr) WithAdminUser(ctx context.Context) context.Context {
@@ -102,4 +201,5 @@ type verifyCode struct {
 	SuperCode  string
 	ValidTime  time.Duration
 	Len        int
+	HashKey    []byte // of the HMAC codes are stored as
 }
@@ -18,11 +18,15 @@ import (
 	"context"
 	"time"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 	"github.com/openimsdk/tools/errs"
 )
 
 func (o *chatSvr) UserLoginCount(ctx context.Context, req *chat.UserLoginCountReq) (*chat.UserLoginCountResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermStatisticRead); err != nil {
+		return nil, err
+	}
 	resp := &chat.UserLoginCountResp{}
 	if req.Start > req.End {
 		return nil, errs.ErrArgs.WrapMsg("start > end")
@@ -0,0 +1,182 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	constantpb "github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+// detectSuspiciousLogin compares a login with the recent ones of the user and returns the event to record, or nil.
+func (o *chatSvr) detectSuspiciousLogin(ctx context.Context, userID string, ip string, country string, deviceID string, platform int32) (*chatdb.SuspiciousLogin, error) {
+	conf := o.SuspiciousLogin
+	now := time.Now()
+	records, err := o.Database.FindRecentLoginRecord(ctx, userID, now.AddDate(0, 0, -conf.HistoryDays), int64(conf.History))
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		// nothing to compare the first login with
+		return nil, nil
+	}
+	platformName := constantpb.PlatformIDToName(int(platform))
+	var reasons []string
+	if !knownDevice(records, deviceID, platformName, ip) {
+		reasons = append(reasons, constant.SuspiciousLoginNewDevice)
+	}
+	last := records[0]
+	if country != "" && last.Country != "" && country != last.Country && now.Sub(last.LoginTime) < time.Duration(conf.TravelHours)*time.Hour {
+		reasons = append(reasons, constant.SuspiciousLoginImpossibleTravel)
+	}
+	if len(reasons) == 0 {
+		return nil, nil
+	}
+	return &chatdb.SuspiciousLogin{
+		UserID:          userID,
+		Reasons:         reasons,
+		IP:              ip,
+		Country:         country,
+		DeviceID:        deviceID,
+		Platform:        platformName,
+		PreviousIP:      last.IP,
+		PreviousCountry: last.Country,
+		PreviousTime:    last.LoginTime,
+		CreateTime:      now,
+	}, nil
+}
+
+// knownDevice matches by device ID, or by platform and IP when the client sends none.
+func knownDevice(records []*chatdb.UserLoginRecord, deviceID string, platform string, ip string) bool {
+	for _, record := range records {
+		if record.Platform != platform {
+			continue
+		}
+		if deviceID != "" {
+			if record.DeviceID == deviceID {
+				return true
+			}
+		} else if record.IP == ip {
+			return true
+		}
+	}
+	return false
+}
+
+// checkSuspiciousLogin records a flagged login, refuses it when a verification code is required
+// but was not used, and otherwise notifies the user. Detection and lookup errors never block the login.
+func (o *chatSvr) checkSuspiciousLogin(ctx context.Context, userID string, ip string, country string, deviceID string, platform int32, verified bool) error {
+	if !o.SuspiciousLogin.Enable {
+		return nil
+	}
+	event, err := o.detectSuspiciousLogin(ctx, userID, ip, country, deviceID, platform)
+	if err != nil {
+		log.ZError(ctx, "detect suspicious login failed", err, "userID", userID)
+		return nil
+	}
+	if event == nil {
+		return nil
+	}
+	// without the attribute there is no contact to challenge or notify, the login goes on
+	attribute, err := o.Database.TakeAttributeByUserID(ctx, userID)
+	if err != nil {
+		log.ZError(ctx, "take attribute of suspicious login failed", err, "userID", userID)
+		attribute = nil
+	}
+	switch {
+	case verified:
+		event.Result = constant.SuspiciousLoginVerified
+	case o.SuspiciousLogin.RequireVerifyCode && attribute != nil && (attribute.Email != "" || attribute.PhoneNumber != ""):
+		event.Result = constant.SuspiciousLoginChallenged
+	default:
+		event.Result = constant.SuspiciousLoginAllowed
+	}
+	log.ZWarn(ctx, "suspicious login", nil, "userID", userID, "reasons", event.Reasons, "result", event.Result, "ip", ip)
+	if err := o.Database.AddSuspiciousLogin(ctx, event); err != nil {
+		log.ZError(ctx, "add suspicious login failed", err, "userID", userID)
+	}
+	if event.Result == constant.SuspiciousLoginChallenged {
+		return eerrs.ErrVerifyCodeRequired.WrapMsg("login from a new device or location, log in with a verification code")
+	}
+	if o.SuspiciousLogin.Notify && attribute != nil {
+		go o.notifySuspiciousLogin(context.WithoutCancel(ctx), attribute, event)
+	}
+	return nil
+}
+
+func (o *chatSvr) notifySuspiciousLogin(ctx context.Context, attribute *chatdb.Attribute, event *chatdb.SuspiciousLogin) {
+	country := event.Country
+	if country == "" {
+		country = "unknown"
+	}
+	var err error
+	switch {
+	case attribute.Email != "" && o.Mail != nil:
+		body := fmt.Sprintf("We noticed a new login to your account.\n\nTime: %s\nPlatform: %s\nIP: %s\nCountry: %s\n\n"+
+			"If this was not you, change your password and log out your other sessions.",
+			event.CreateTime.UTC().Format(time.RFC1123), event.Platform, event.IP, country)
+		err = o.deliverMailNotice(ctx, attribute.UserID, attribute.Email, "New login to your account", body)
+	case attribute.PhoneNumber != "" && o.SMS != nil:
+		err = o.deliverSMSLoginNotice(ctx, attribute.UserID, attribute.AreaCode, attribute.PhoneNumber, map[string]string{
+			"platform": event.Platform,
+			"ip":       event.IP,
+			"country":  country,
+		})
+	default:
+		return
+	}
+	if err != nil {
+		log.ZError(ctx, "notify suspicious login failed", err, "userID", attribute.UserID)
+	}
+}
+
+func (o *chatSvr) SearchSuspiciousLogin(ctx context.Context, req *chat.SearchSuspiciousLoginReq) (*chat.SearchSuspiciousLoginResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermUsersRead); err != nil {
+		return nil, err
+	}
+	total, events, err := o.Database.SearchSuspiciousLogin(ctx, req.UserIDs, req.Reason, req.Result, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &chat.SearchSuspiciousLoginResp{
+		Total:  uint32(total),
+		Events: make([]*chat.SuspiciousLogin, 0, len(events)),
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, &chat.SuspiciousLogin{
+			Id:              event.ID.Hex(),
+			UserID:          event.UserID,
+			Reasons:         event.Reasons,
+			Result:          event.Result,
+			Ip:              event.IP,
+			Country:         event.Country,
+			DeviceID:        event.DeviceID,
+			Platform:        event.Platform,
+			PreviousIP:      event.PreviousIP,
+			PreviousCountry: event.PreviousCountry,
+			PreviousTime:    event.PreviousTime.UnixMilli(),
+			CreateTime:      event.CreateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,370 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"bytes"
+	"context"
+	htmltemplate "html/template"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"golang.org/x/text/language"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+// templatePurposes names the purposes in template file names and gives them to templates as .Purpose.
+var templatePurposes = map[int32]string{
+	0:                                    "any",
+	constant.VerificationCodeForRegister: "register",
+	constant.VerificationCodeForResetPassword: "reset",
+	constant.VerificationCodeForLogin:         "login",
+}
+
+type templateKey struct {
+	channel string
+	usedFor int32
+	locale  string
+}
+
+// compiledTemplate is a message template parsed for rendering.
+type compiledTemplate struct {
+	subject *texttemplate.Template // nil for sms
+	html    *htmltemplate.Template // nil without html
+	text    *texttemplate.Template
+}
+
+// messageTemplates is the snapshot of all templates, replaced as a whole on reload.
+type messageTemplates struct {
+	templates map[templateKey]*compiledTemplate
+}
+
+// messageTemplateData is what templates can refer to.
+type messageTemplateData struct {
+	AppName       string
+	Code          string
+	ExpireMinutes int
+	Purpose       string
+	Locale        string
+}
+
+// renderedMessage is a rendered template; subject and html are empty for sms.
+type renderedMessage struct {
+	subject string
+	html    string
+	text    string
+}
+
+// canonicalLocale returns the canonical form of a BCP 47 tag, such as "pt-BR" for "pt_br".
+func canonicalLocale(locale string) (string, error) {
+	tag, err := language.Parse(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	if err != nil {
+		return "", errs.ErrArgs.WrapMsg("locale is invalid", "locale", locale)
+	}
+	return tag.String(), nil
+}
+
+// templateCodeProbe stands for the code when checking a template; html escaping leaves it as is.
+const templateCodeProbe = "0codeProbe9"
+
+func compileMessageTemplate(t *chatdb.MessageTemplate) (*compiledTemplate, error) {
+	var (
+		c   compiledTemplate
+		err error
+	)
+	if t.Subject != "" {
+		if c.subject, err = texttemplate.New("subject").Parse(t.Subject); err != nil {
+			return nil, errs.ErrArgs.WrapMsg("subject is not a valid template: " + err.Error())
+		}
+	}
+	if t.HTML != "" {
+		if c.html, err = htmltemplate.New("html").Parse(t.HTML); err != nil {
+			return nil, errs.ErrArgs.WrapMsg("html is not a valid template: " + err.Error())
+		}
+	}
+	if c.text, err = texttemplate.New("text").Parse(t.Text); err != nil {
+		return nil, errs.ErrArgs.WrapMsg("text is not a valid template: " + err.Error())
+	}
+	// catch references to unknown fields now rather than when a code is sent, and
+	// templates the code would be missing from
+	msg, err := c.render(&messageTemplateData{Code: templateCodeProbe})
+	if err != nil {
+		return nil, errs.ErrArgs.WrapMsg("template does not render: " + err.Error())
+	}
+	if !strings.Contains(msg.text, templateCodeProbe) {
+		return nil, errs.ErrArgs.WrapMsg("text does not contain {{.Code}}")
+	}
+	if c.html != nil && !strings.Contains(msg.html, templateCodeProbe) {
+		return nil, errs.ErrArgs.WrapMsg("html does not contain {{.Code}}")
+	}
+	return &c, nil
+}
+
+func (c *compiledTemplate) render(data *messageTemplateData) (*renderedMessage, error) {
+	var (
+		msg renderedMessage
+		buf bytes.Buffer
+	)
+	if c.subject != nil {
+		if err := c.subject.Execute(&buf, data); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		msg.subject = strings.TrimSpace(buf.String())
+		buf.Reset()
+	}
+	if c.html != nil {
+		if err := c.html.Execute(&buf, data); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		msg.html = buf.String()
+		buf.Reset()
+	}
+	if err := c.text.Execute(&buf, data); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	msg.text = buf.String()
+	return &msg, nil
+}
+
+// builtinMailTemplate is a built-in verification mail: the code line holds {{.Code}}, the note
+// line says when it expires.
+type builtinMailTemplate struct {
+	locale  string
+	subject string
+	code    string
+	note    string
+}
+
+var builtinMailTemplates = []builtinMailTemplate{
+	{
+		locale:  "en",
+		subject: "{{.AppName}} verification code",
+		code:    "Your {{.AppName}} verification code is {{.Code}}.",
+		note:    "It expires in {{.ExpireMinutes}} minutes. Do not share it with anyone. If you did not ask for it, ignore this email.",
+	},
+	{
+		locale:  "es",
+		subject: "Código de verificación de {{.AppName}}",
+		code:    "Tu código de verificación de {{.AppName}} es {{.Code}}.",
+		note:    "Caduca en {{.ExpireMinutes}} minutos. No lo compartas con nadie. Si no lo solicitaste, ignora este correo.",
+	},
+	{
+		locale:  "ja",
+		subject: "{{.AppName}} 認証コード",
+		code:    "{{.AppName}} の認証コードは {{.Code}} です。",
+		note:    "有効期限は {{.ExpireMinutes}} 分です。このコードは誰にも教えないでください。お心当たりがない場合は、このメールを破棄してください。",
+	},
+}
+
+// builtinMessageTemplates returns the built-in mails of any purpose; title replaces the English subject when set.
+func builtinMessageTemplates(title string) []*chatdb.MessageTemplate {
+	templates := make([]*chatdb.MessageTemplate, 0, len(builtinMailTemplates))
+	for _, b := range builtinMailTemplates {
+		subject := b.subject
+		if b.locale == "en" && title != "" {
+			// the title is plain text, braces in it are not actions
+			subject = strings.NewReplacer("{{", "{{`{{`}}", "}}", "{{`}}`}}").Replace(title)
+		}
+		templates = append(templates, &chatdb.MessageTemplate{
+			Channel: constant.DeliveryChannelEmail,
+			Locale:  b.locale,
+			Subject: subject,
+			HTML:    "<p>" + strings.ReplaceAll(b.code, "{{.Code}}", "<strong>{{.Code}}</strong>") + "</p>\n<p>" + b.note + "</p>\n",
+			Text:    b.code + "\n\n" + b.note + "\n",
+		})
+	}
+	return templates
+}
+
+// readMessageTemplateDir reads the files <channel>/<purpose>.<locale>.<part> of dir.
+func readMessageTemplateDir(ctx context.Context, dir string) ([]*chatdb.MessageTemplate, error) {
+	purposes := make(map[string]int32, len(templatePurposes))
+	for usedFor, purpose := range templatePurposes {
+		purposes[purpose] = usedFor
+	}
+	found := make(map[templateKey]*chatdb.MessageTemplate)
+	var templates []*chatdb.MessageTemplate
+	for _, channel := range []string{constant.DeliveryChannelSMS, constant.DeliveryChannelEmail} {
+		entries, err := os.ReadDir(filepath.Join(dir, channel))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, errs.WrapMsg(err, "read message template dir failed", "dir", dir)
+		}
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			name := entry.Name()
+			parts := strings.Split(name, ".")
+			if len(parts) != 3 {
+				log.ZWarn(ctx, "skip message template file with unexpected name", nil, "channel", channel, "file", name)
+				continue
+			}
+			usedFor, ok := purposes[parts[0]]
+			if !ok {
+				log.ZWarn(ctx, "skip message template file with unknown purpose", nil, "channel", channel, "file", name)
+				continue
+			}
+			locale, err := canonicalLocale(parts[1])
+			if err != nil {
+				log.ZWarn(ctx, "skip message template file with invalid locale", err, "channel", channel, "file", name)
+				continue
+			}
+			if part := parts[2]; part != "subject" && part != "html" && part != "txt" {
+				log.ZWarn(ctx, "skip message template file with unknown part", nil, "channel", channel, "file", name)
+				continue
+			}
+			data, err := os.ReadFile(filepath.Join(dir, channel, name))
+			if err != nil {
+				return nil, errs.WrapMsg(err, "read message template file failed", "file", name)
+			}
+			key := templateKey{channel: channel, usedFor: usedFor, locale: locale}
+			t, ok := found[key]
+			if !ok {
+				t = &chatdb.MessageTemplate{Channel: channel, UsedFor: usedFor, Locale: locale}
+				found[key] = t
+				templates = append(templates, t)
+			}
+			switch parts[2] {
+			case "subject":
+				t.Subject = string(data)
+			case "html":
+				t.HTML = string(data)
+			case "txt":
+				t.Text = string(data)
+			}
+		}
+	}
+	return templates, nil
+}
+
+// loadMessageTemplates builds the snapshot from the built-in templates, those in the template dir
+// and those in mongo, each replacing the ones before of the same channel, purpose and locale.
+func (o *chatSvr) loadMessageTemplates(ctx context.Context) error {
+	templates := builtinMessageTemplates(o.conf.Mail.Title)
+	if o.MessageTemplate.Dir != "" {
+		dir, err := readMessageTemplateDir(ctx, o.MessageTemplate.Dir)
+		if err != nil {
+			return err
+		}
+		templates = append(templates, dir...)
+	}
+	stored, err := o.Database.FindAllMessageTemplate(ctx)
+	if err != nil {
+		return err
+	}
+	templates = append(templates, stored...)
+	snapshot := &messageTemplates{templates: make(map[templateKey]*compiledTemplate, len(templates))}
+	for _, t := range templates {
+		if t.Text == "" || (t.Channel == constant.DeliveryChannelEmail && t.Subject == "") {
+			log.ZWarn(ctx, "skip incomplete message template", nil, "channel", t.Channel, "usedFor", t.UsedFor, "locale", t.Locale)
+			continue
+		}
+		compiled, err := compileMessageTemplate(t)
+		if err != nil {
+			log.ZWarn(ctx, "skip invalid message template", err, "channel", t.Channel, "usedFor", t.UsedFor, "locale", t.Locale)
+			continue
+		}
+		snapshot.templates[templateKey{channel: t.Channel, usedFor: t.UsedFor, locale: t.Locale}] = compiled
+	}
+	o.messageTemplates.Store(snapshot)
+	return nil
+}
+
+// refreshMessageTemplates reloads the templates every interval, so changes made through other
+// instances or on disk take effect.
+func (o *chatSvr) refreshMessageTemplates(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx := mcontext.SetOperationID(context.Background(), "refresh_message_templates_"+time.Now().Format("20060102150405"))
+		if err := o.loadMessageTemplates(ctx); err != nil {
+			log.ZError(ctx, "refresh message templates failed", err)
+		}
+	}
+}
+
+// reloadMessageTemplates applies a change made through this instance right away.
+func (o *chatSvr) reloadMessageTemplates(ctx context.Context) {
+	if err := o.loadMessageTemplates(ctx); err != nil {
+		log.ZError(ctx, "reload message templates failed", err)
+	}
+}
+
+// templateLocales lists the locales to try for a BCP 47 tag or Accept-Language value, best first:
+// each requested tag, then its language alone, then the default locale and English.
+func (o *chatSvr) templateLocales(lang string) []string {
+	var locales []string
+	seen := make(map[string]struct{})
+	add := func(locale string) {
+		if _, ok := seen[locale]; ok || locale == "" {
+			return
+		}
+		seen[locale] = struct{}{}
+		locales = append(locales, locale)
+	}
+	if lang != "" {
+		tags, _, _ := language.ParseAcceptLanguage(strings.ReplaceAll(lang, "_", "-"))
+		for _, tag := range tags {
+			add(tag.String())
+			if base, confidence := tag.Base(); confidence != language.No {
+				add(base.String())
+			}
+		}
+	}
+	add(o.MessageTemplate.DefaultLocale)
+	add("en")
+	return locales
+}
+
+// renderMessage renders the template of the channel best matching the language, preferring a
+// template of the purpose over one of any purpose in the same locale. ok is false without one.
+func (o *chatSvr) renderMessage(channel string, usedFor int32, lang string, code string) (*renderedMessage, bool, error) {
+	snapshot := o.messageTemplates.Load()
+	if snapshot == nil {
+		return nil, false, nil
+	}
+	for _, locale := range o.templateLocales(lang) {
+		for _, purpose := range []int32{usedFor, 0} {
+			t, ok := snapshot.templates[templateKey{channel: channel, usedFor: purpose, locale: locale}]
+			if !ok {
+				continue
+			}
+			msg, err := t.render(&messageTemplateData{
+				AppName:       o.MessageTemplate.AppName,
+				Code:          code,
+				ExpireMinutes: int(math.Ceil(o.Code.ValidTime.Minutes())),
+				Purpose:       templatePurposes[usedFor],
+				Locale:        locale,
+			})
+			if err != nil {
+				return nil, false, err
+			}
+			return msg, true, nil
+		}
+	}
+	return nil, false, nil
+}
@@ -0,0 +1,199 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/common/constant"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func newTemplateSvr(t *testing.T, defaultLocale string, templates ...*chatdb.MessageTemplate) *chatSvr {
+	t.Helper()
+	o := &chatSvr{
+		Code:            verifyCode{ValidTime: 5 * time.Minute},
+		MessageTemplate: config.MessageTemplate{AppName: "OpenIM", DefaultLocale: defaultLocale},
+	}
+	snapshot := &messageTemplates{templates: make(map[templateKey]*compiledTemplate)}
+	for _, tmpl := range templates {
+		compiled, err := compileMessageTemplate(tmpl)
+		if err != nil {
+			t.Fatalf("compile %s/%d/%s: %v", tmpl.Channel, tmpl.UsedFor, tmpl.Locale, err)
+		}
+		snapshot.templates[templateKey{channel: tmpl.Channel, usedFor: tmpl.UsedFor, locale: tmpl.Locale}] = compiled
+	}
+	o.messageTemplates.Store(snapshot)
+	return o
+}
+
+func smsTemplate(usedFor int32, locale string, text string) *chatdb.MessageTemplate {
+	return &chatdb.MessageTemplate{Channel: constant.DeliveryChannelSMS, UsedFor: usedFor, Locale: locale, Text: text}
+}
+
+func TestCompileMessageTemplate(t *testing.T) {
+	tests := []struct {
+		name string
+		tmpl *chatdb.MessageTemplate
+		ok   bool
+	}{
+		{"code in text", smsTemplate(0, "en", "Code {{.Code}}"), true},
+		{"code through printf", smsTemplate(0, "en", `Code {{printf "%s" .Code}}`), true},
+		{"no code", smsTemplate(0, "en", "Welcome to {{.AppName}}"), false},
+		{"unknown field", smsTemplate(0, "en", "{{.Code}} {{.Unknown}}"), false},
+		{"invalid syntax", smsTemplate(0, "en", "{{.Code"), false},
+		{"html without code", &chatdb.MessageTemplate{
+			Channel: constant.DeliveryChannelEmail, Locale: "en", Subject: "Code",
+			HTML: "<p>Hello</p>", Text: "{{.Code}}",
+		}, false},
+		{"html with code", &chatdb.MessageTemplate{
+			Channel: constant.DeliveryChannelEmail, Locale: "en", Subject: "Code",
+			HTML: "<p><strong>{{.Code}}</strong></p>", Text: "{{.Code}}",
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := compileMessageTemplate(tt.tmpl)
+			if (err == nil) != tt.ok {
+				t.Fatalf("err = %v, want ok %v", err, tt.ok)
+			}
+		})
+	}
+}
+
+func TestBuiltinMessageTemplatesCompile(t *testing.T) {
+	for _, tmpl := range builtinMessageTemplates("Your {{code}}") {
+		if _, err := compileMessageTemplate(tmpl); err != nil {
+			t.Errorf("builtin %s: %v", tmpl.Locale, err)
+		}
+	}
+}
+
+func TestReadMessageTemplateDir(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"sms/register.pt_br.txt": "Codigo {{.Code}}",
+		"sms/any.en.txt":         "Code {{.Code}}",
+		"email/login.ja.subject": "ログイン",
+		"email/login.ja.html":    "<b>{{.Code}}</b>",
+		"email/login.ja.txt":     "{{.Code}}",
+		"email/unknown.en.txt":   "skipped, unknown purpose",
+		"email/reset.xx-!!.txt":  "skipped, invalid locale",
+		"email/reset.en.pdf":     "skipped, unknown part",
+		"email/README":           "skipped, unexpected name",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	templates, err := readMessageTemplateDir(context.Background(), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[templateKey]chatdb.MessageTemplate)
+	for _, tmpl := range templates {
+		got[templateKey{channel: tmpl.Channel, usedFor: tmpl.UsedFor, locale: tmpl.Locale}] = *tmpl
+	}
+	want := map[templateKey]chatdb.MessageTemplate{
+		{channel: constant.DeliveryChannelSMS, usedFor: constant.VerificationCodeForRegister, locale: "pt-BR"}: {
+			Channel: constant.DeliveryChannelSMS, UsedFor: constant.VerificationCodeForRegister, Locale: "pt-BR", Text: "Codigo {{.Code}}",
+		},
+		{channel: constant.DeliveryChannelSMS, usedFor: 0, locale: "en"}: {
+			Channel: constant.DeliveryChannelSMS, Locale: "en", Text: "Code {{.Code}}",
+		},
+		{channel: constant.DeliveryChannelEmail, usedFor: constant.VerificationCodeForLogin, locale: "ja"}: {
+			Channel: constant.DeliveryChannelEmail, UsedFor: constant.VerificationCodeForLogin, Locale: "ja",
+			Subject: "ログイン", HTML: "<b>{{.Code}}</b>", Text: "{{.Code}}",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("templates = %+v, want %+v", got, want)
+	}
+}
+
+func TestReadMessageTemplateDirMissing(t *testing.T) {
+	templates, err := readMessageTemplateDir(context.Background(), filepath.Join(t.TempDir(), "missing"))
+	if err != nil || len(templates) != 0 {
+		t.Fatalf("templates = %v, err = %v, want none", templates, err)
+	}
+}
+
+func TestTemplateLocales(t *testing.T) {
+	tests := []struct {
+		lang          string
+		defaultLocale string
+		want          []string
+	}{
+		{"", "", []string{"en"}},
+		{"", "es", []string{"es", "en"}},
+		{"pt-BR", "", []string{"pt-BR", "pt", "en"}},
+		{"pt_br", "", []string{"pt-BR", "pt", "en"}},
+		{"fr-CH, fr;q=0.9, de;q=0.7", "es", []string{"fr-CH", "fr", "de", "es", "en"}},
+		{"en-US", "en", []string{"en-US", "en"}},
+	}
+	for _, tt := range tests {
+		o := &chatSvr{MessageTemplate: config.MessageTemplate{DefaultLocale: tt.defaultLocale}}
+		if got := o.templateLocales(tt.lang); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("templateLocales(%q) with default %q = %v, want %v", tt.lang, tt.defaultLocale, got, tt.want)
+		}
+	}
+}
+
+func TestRenderMessage(t *testing.T) {
+	o := newTemplateSvr(t, "es",
+		smsTemplate(0, "en", "{{.AppName}} code {{.Code}}"),
+		smsTemplate(constant.VerificationCodeForRegister, "en", "Register with {{.Code}}, valid {{.ExpireMinutes}} minutes"),
+		smsTemplate(0, "es", "Código {{.Code}}"),
+		smsTemplate(0, "pt", "Código pt {{.Code}} {{.Locale}}"),
+	)
+	tests := []struct {
+		name    string
+		usedFor int32
+		lang    string
+		want    string
+	}{
+		{"purpose before any", constant.VerificationCodeForRegister, "en", "Register with 123456, valid 5 minutes"},
+		{"any when the purpose has none", constant.VerificationCodeForLogin, "en", "OpenIM code 123456"},
+		{"base language of a region", constant.VerificationCodeForLogin, "pt-BR", "Código pt 123456 pt"},
+		{"default locale", constant.VerificationCodeForLogin, "de", "Código 123456"},
+		{"locale before purpose", constant.VerificationCodeForRegister, "es", "Código 123456"},
+		{"accept language order", constant.VerificationCodeForLogin, "de, pt;q=0.8, en;q=0.5", "Código pt 123456 pt"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, ok, err := o.renderMessage(constant.DeliveryChannelSMS, tt.usedFor, tt.lang, "123456")
+			if err != nil || !ok {
+				t.Fatalf("ok = %v, err = %v", ok, err)
+			}
+			if msg.text != tt.want {
+				t.Fatalf("text = %q, want %q", msg.text, tt.want)
+			}
+		})
+	}
+	if _, ok, err := o.renderMessage(constant.DeliveryChannelEmail, 0, "en", "123456"); ok || err != nil {
+		t.Fatalf("email without templates: ok = %v, err = %v", ok, err)
+	}
+}
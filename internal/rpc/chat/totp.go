@@ -0,0 +1,298 @@
+package chat
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/common/totp"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+func (o *chatSvr) takeEnabledTOTP(ctx context.Context, userID string) (*chatdb.TOTP, error) {
+	t, err := o.Database.TakeTOTP(ctx, userID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, errs.ErrArgs.WrapMsg("totp is not enabled")
+		}
+		return nil, err
+	}
+	if !t.Enabled {
+		return nil, errs.ErrArgs.WrapMsg("totp is not enabled")
+	}
+	return t, nil
+}
+
+// checkTOTPCode accepts either a TOTP code or an unused recovery code, which is consumed.
+func (o *chatSvr) checkTOTPCode(ctx context.Context, t *chatdb.TOTP, code string) error {
+	if counter, ok := totp.Validate(t.Secret, code, time.Now(), t.LastCounter); ok {
+		ok, err := o.Database.UpdateTOTPCounter(ctx, t.UserID, counter)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return eerrs.ErrTOTPNotMatch.WrapMsg("code already used")
+		}
+		return nil
+	}
+	if totp.MatchRecoveryCode(t.RecoveryCodes, code) >= 0 {
+		ok, err := o.Database.UseTOTPRecoveryCode(ctx, t.UserID, totp.HashRecoveryCode(code))
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+	}
+	return eerrs.ErrTOTPNotMatch.Wrap()
+}
+
+func (o *chatSvr) totpAccountName(ctx context.Context, userID string) string {
+	attribute, err := o.Database.TakeAttributeByUserID(ctx, userID)
+	if err != nil {
+		return userID
+	}
+	switch {
+	case attribute.Account != "":
+		return attribute.Account
+	case attribute.Email != "":
+		return attribute.Email
+	case attribute.PhoneNumber != "":
+		return attribute.AreaCode + attribute.PhoneNumber
+	default:
+		return userID
+	}
+}
+
+// totpChallenge returns a login ticket when userID has TOTP enabled, or an empty string otherwise.
+func (o *chatSvr) totpChallenge(ctx context.Context, userID string, req *chat.LoginReq, verifyCodeID *string) (string, error) {
+	t, err := o.Database.TakeTOTP(ctx, userID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if !t.Enabled {
+		return "", nil
+	}
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errs.Wrap(err)
+	}
+	ticket := hex.EncodeToString(buf)
+	challenge := &cache.LoginChallenge{
+		UserID:   userID,
+		Platform: req.Platform,
+		DeviceID: req.DeviceID,
+		IP:       req.Ip,
+	}
+	if err := o.Database.SetLoginChallenge(ctx, ticket, challenge, time.Duration(o.TOTP.TicketExpire)*time.Second); err != nil {
+		return "", err
+	}
+	// the first factor is spent once the ticket is issued
+	if verifyCodeID != nil {
+		if err := o.Database.DelVerifyCode(ctx, *verifyCodeID); err != nil {
+			return "", err
+		}
+	}
+	return ticket, nil
+}
+
+func (o *chatSvr) LoginTOTP(ctx context.Context, req *chat.LoginTOTPReq) (*chat.LoginResp, error) {
+	challenge, err := o.Database.GetLoginChallenge(ctx, req.Ticket)
+	if err != nil {
+		return nil, err
+	}
+	if challenge == nil {
+		return nil, eerrs.ErrTOTPTicketInvalid.Wrap()
+	}
+	if req.Ip != challenge.IP || req.Platform != challenge.Platform {
+		return nil, eerrs.ErrTOTPTicketInvalid.WrapMsg("ticket was issued to another client")
+	}
+	attempts, err := o.Database.IncrLoginChallengeAttempts(ctx, req.Ticket)
+	if err != nil {
+		return nil, err
+	}
+	if attempts > int64(o.TOTP.MaxAttempts) {
+		if err := o.Database.DelLoginChallenge(ctx, req.Ticket); err != nil {
+			return nil, err
+		}
+		return nil, eerrs.ErrTOTPTicketInvalid.WrapMsg("too many attempts")
+	}
+	if err := o.LoginLock.Check(ctx, challenge.UserID, challenge.IP); err != nil {
+		return nil, err
+	}
+	t, err := o.takeEnabledTOTP(ctx, challenge.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkTOTPCode(ctx, t, req.Code); err != nil {
+		if errors.Is(err, eerrs.ErrTOTPNotMatch) {
+			return nil, o.LoginLock.Failed(ctx, challenge.UserID, challenge.IP, err)
+		}
+		return nil, err
+	}
+	o.LoginLock.Succeeded(ctx, challenge.UserID)
+	if err := o.Database.DelLoginChallenge(ctx, req.Ticket); err != nil {
+		return nil, err
+	}
+	check, err := o.Admin.CheckLogin(ctx, challenge.UserID, challenge.IP)
+	if err != nil {
+		if errors.Is(err, eerrs.ErrAccountBlocked) {
+			return nil, o.blockedLogin(ctx, challenge.UserID)
+		}
+		return nil, err
+	}
+	// the TOTP code verifies a login from a new device or location as a verification code would
+	if err := o.checkSuspiciousLogin(ctx, challenge.UserID, challenge.IP, check.Country, challenge.DeviceID, challenge.Platform, true); err != nil {
+		return nil, err
+	}
+	return o.completeLogin(ctx, challenge.UserID, challenge.Platform, challenge.DeviceID, challenge.IP, check.Country, nil)
+}
+
+func (o *chatSvr) EnrollTOTP(ctx context.Context, req *chat.EnrollTOTPReq) (*chat.EnrollTOTPResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := o.Database.TakeTOTP(ctx, userID)
+	if err == nil {
+		if t.Enabled {
+			return nil, errs.ErrArgs.WrapMsg("totp is already enabled")
+		}
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	err = o.Database.SetTOTP(ctx, &chatdb.TOTP{
+		UserID:        userID,
+		Secret:        secret,
+		Enabled:       false,
+		RecoveryCodes: []string{},
+		CreateTime:    time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &chat.EnrollTOTPResp{
+		Secret: secret,
+		Url:    totp.URL(o.TOTP.Issuer, o.totpAccountName(ctx, userID), secret),
+	}, nil
+}
+
+func (o *chatSvr) ConfirmTOTP(ctx context.Context, req *chat.ConfirmTOTPReq) (*chat.ConfirmTOTPResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := o.Database.TakeTOTP(ctx, userID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, errs.ErrArgs.WrapMsg("totp is not enrolled")
+		}
+		return nil, err
+	}
+	if t.Enabled {
+		return nil, errs.ErrArgs.WrapMsg("totp is already enabled")
+	}
+	counter, ok := totp.Validate(t.Secret, req.Code, time.Now(), t.LastCounter)
+	if !ok {
+		return nil, eerrs.ErrTOTPNotMatch.Wrap()
+	}
+	codes, hashes, err := totp.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	err = o.Database.UpdateTOTP(ctx, userID, map[string]any{
+		"enabled":        true,
+		"recovery_codes": hashes,
+		"last_counter":   counter,
+		"enable_time":    time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &chat.ConfirmTOTPResp{RecoveryCodes: codes}, nil
+}
+
+func (o *chatSvr) DisableTOTP(ctx context.Context, req *chat.DisableTOTPReq) (*chat.DisableTOTPResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := o.takeEnabledTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkTOTPCode(ctx, t, req.Code); err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelTOTP(ctx, []string{userID}); err != nil {
+		return nil, err
+	}
+	return &chat.DisableTOTPResp{}, nil
+}
+
+func (o *chatSvr) RegenerateTOTPRecoveryCodes(ctx context.Context, req *chat.RegenerateTOTPRecoveryCodesReq) (*chat.RegenerateTOTPRecoveryCodesResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := o.takeEnabledTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkTOTPCode(ctx, t, req.Code); err != nil {
+		return nil, err
+	}
+	codes, hashes, err := totp.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateTOTP(ctx, userID, map[string]any{"recovery_codes": hashes}); err != nil {
+		return nil, err
+	}
+	return &chat.RegenerateTOTPRecoveryCodesResp{RecoveryCodes: codes}, nil
+}
+
+func (o *chatSvr) GetTOTPStatus(ctx context.Context, req *chat.GetTOTPStatusReq) (*chat.GetTOTPStatusResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := o.Database.TakeTOTP(ctx, userID)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return &chat.GetTOTPStatusResp{}, nil
+		}
+		return nil, err
+	}
+	return &chat.GetTOTPStatusResp{
+		Enabled:           t.Enabled,
+		RecoveryCodeCount: int32(len(t.RecoveryCodes)),
+	}, nil
+}
+
+func (o *chatSvr) ResetUserTOTP(ctx context.Context, req *chat.ResetUserTOTPReq) (*chat.ResetUserTOTPResp, error) {
+	if err := o.Admin.CheckPermission(ctx, constant.PermUsersWrite); err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelTOTP(ctx, req.UserIDs); err != nil {
+		return nil, err
+	}
+	return &chat.ResetUserTOTPResp{}, nil
+}
@@ -0,0 +1,135 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/common/db/database"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/totp"
+	"github.com/openimsdk/chat/pkg/eerrs"
+)
+
+// totpDB holds the TOTP of one user, updated the way mongo does.
+type totpDB struct {
+	database.ChatDatabaseInterface
+	totp *chatdb.TOTP
+}
+
+func (d *totpDB) TakeTOTP(ctx context.Context, userID string) (*chatdb.TOTP, error) {
+	t := *d.totp
+	t.RecoveryCodes = slices.Clone(d.totp.RecoveryCodes)
+	return &t, nil
+}
+
+func (d *totpDB) UpdateTOTPCounter(ctx context.Context, userID string, counter int64) (bool, error) {
+	if d.totp.LastCounter >= counter {
+		return false, nil
+	}
+	d.totp.LastCounter = counter
+	return true, nil
+}
+
+func (d *totpDB) UseTOTPRecoveryCode(ctx context.Context, userID string, hash string) (bool, error) {
+	i := slices.Index(d.totp.RecoveryCodes, hash)
+	if i < 0 {
+		return false, nil
+	}
+	d.totp.RecoveryCodes = slices.Delete(d.totp.RecoveryCodes, i, i+1)
+	return true, nil
+}
+
+// currentTOTPCode computes the RFC 6238 code of secret for now.
+func currentTOTPCode(t *testing.T, secret string) string {
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(time.Now().Unix()/30))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	return fmt.Sprintf("%06d", (binary.BigEndian.Uint32(sum[offset:offset+4])&0x7fffffff)%1000000)
+}
+
+func newTOTPDB(t *testing.T) (*totpDB, []string) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	codes, hashes, err := totp.GenerateRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &totpDB{totp: &chatdb.TOTP{UserID: "u1", Secret: secret, Enabled: true, RecoveryCodes: hashes}}, codes
+}
+
+func TestCheckTOTPCodeReplay(t *testing.T) {
+	ctx := context.Background()
+	db, _ := newTOTPDB(t)
+	o := &chatSvr{Database: db}
+	code := currentTOTPCode(t, db.totp.Secret)
+	// two logins racing with the same code read the TOTP before either stored its counter
+	first, _ := db.TakeTOTP(ctx, "u1")
+	second, _ := db.TakeTOTP(ctx, "u1")
+	if err := o.checkTOTPCode(ctx, first, code); err != nil {
+		t.Fatalf("first use: %v", err)
+	}
+	if db.totp.LastCounter == 0 {
+		t.Fatal("time step of the code not stored")
+	}
+	if err := o.checkTOTPCode(ctx, second, code); !eerrs.ErrTOTPNotMatch.Is(err) {
+		t.Fatalf("racing reuse: %v, want ErrTOTPNotMatch", err)
+	}
+	third, _ := db.TakeTOTP(ctx, "u1")
+	if err := o.checkTOTPCode(ctx, third, code); !eerrs.ErrTOTPNotMatch.Is(err) {
+		t.Fatalf("later reuse: %v, want ErrTOTPNotMatch", err)
+	}
+}
+
+func TestCheckTOTPRecoveryCodeOnce(t *testing.T) {
+	ctx := context.Background()
+	db, codes := newTOTPDB(t)
+	o := &chatSvr{Database: db}
+	first, _ := db.TakeTOTP(ctx, "u1")
+	second, _ := db.TakeTOTP(ctx, "u1")
+	if err := o.checkTOTPCode(ctx, first, codes[3]); err != nil {
+		t.Fatalf("first use: %v", err)
+	}
+	if len(db.totp.RecoveryCodes) != len(codes)-1 {
+		t.Fatalf("%d recovery codes left, want %d", len(db.totp.RecoveryCodes), len(codes)-1)
+	}
+	if err := o.checkTOTPCode(ctx, second, codes[3]); !eerrs.ErrTOTPNotMatch.Is(err) {
+		t.Fatalf("racing reuse: %v, want ErrTOTPNotMatch", err)
+	}
+	third, _ := db.TakeTOTP(ctx, "u1")
+	if err := o.checkTOTPCode(ctx, third, codes[3]); !eerrs.ErrTOTPNotMatch.Is(err) {
+		t.Fatalf("later reuse: %v, want ErrTOTPNotMatch", err)
+	}
+	if err := o.checkTOTPCode(ctx, third, codes[4]); err != nil {
+		t.Fatalf("another code: %v", err)
+	}
+}
@@ -157,8 +157,11 @@ func (o *chatSvr) UpdateUserInfo(ctx context.Context, req *chat.UpdateUserInfoRe
 		return nil, err
 	}
 
-	if err = o.checkUpdateInfo(ctx, req); err != nil {
-		return nil, err
+	// the account, email and phone take over the account, an admin acting as the user cannot change them
+	if req.Account != nil || req.Email != nil || req.AreaCode != nil || req.PhoneNumber != nil {
+		if err := mctx.CheckNotImpersonated(ctx); err != nil {
+			return nil, err
+		}
 	}
 
 	switch userType {
@@ -171,10 +174,17 @@ func (o *chatSvr) UpdateUserInfo(ctx context.Context, req *chat.UpdateUserInfoRe
 		}
 
 	case constant.AdminUser:
+		if err := o.Admin.CheckPermission(ctx, constant.PermUsersWrite); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, errs.ErrNoPermission.WrapMsg("user type error")
 	}
 
+	if err = o.checkUpdateInfo(ctx, req); err != nil {
+		return nil, err
+	}
+
 	update, err := ToDBAttributeUpdate(req)
 	if err != nil {
 		return nil, err
@@ -205,7 +215,7 @@ func (o *chatSvr) FindUserPublicInfo(ctx context.Context, req *chat.FindUserPubl
 }
 
 func (o *chatSvr) AddUserAccount(ctx context.Context, req *chat.AddUserAccountReq) (*chat.AddUserAccountResp, error) {
-	if _, _, err := mctx.Check(ctx); err != nil {
+	if err := o.Admin.CheckPermission(ctx, constant.PermUsersWrite); err != nil {
 		return nil, err
 	}
 
@@ -278,9 +288,13 @@ func (o *chatSvr) AddUserAccount(ctx context.Context, req *chat.AddUserAccountRe
 		Mode:        constant.UserMode,
 		CreateTime:  time.Now(),
 	}
+	password, err := o.hashPassword(req.User.Password)
+	if err != nil {
+		return nil, err
+	}
 	account := &chatdb.Account{
 		UserID:         req.User.UserID,
-		Password:       req.User.Password,
+		Password:       password,
 		OperatorUserID: mcontext.GetOpUserID(ctx),
 		ChangeTime:     register.CreateTime,
 		CreateTime:     register.CreateTime,
@@ -386,10 +400,25 @@ func (o *chatSvr) FindAccountUser(ctx context.Context, req *chat.FindAccountUser
 	return &chat.FindAccountUserResp{AccountUserMap: accountUserMap}, nil
 }
 
+// SearchUserInfo searches all users for admins holding the read permission, users can only
+// search among the userIDs they pass, such as their friends.
 func (o *chatSvr) SearchUserInfo(ctx context.Context, req *chat.SearchUserInfoReq) (*chat.SearchUserInfoResp, error) {
-	if _, _, err := mctx.Check(ctx); err != nil {
+	_, userType, err := mctx.Check(ctx)
+	if err != nil {
 		return nil, err
 	}
+	switch userType {
+	case constant.AdminUser:
+		if err := o.Admin.CheckPermission(ctx, constant.PermUsersRead); err != nil {
+			return nil, err
+		}
+	case constant.NormalUser:
+		if len(req.UserIDs) == 0 {
+			return nil, errs.ErrNoPermission.WrapMsg("users can only search among given user ids")
+		}
+	default:
+		return nil, errs.ErrNoPermission.WrapMsg("invalid user type")
+	}
 	total, list, err := o.Database.SearchUser(ctx, req.Keyword, req.UserIDs, req.Genders, req.Pagination)
 	if err != nil {
 		return nil, err
@@ -442,6 +471,9 @@ func (o *chatSvr) CheckUserExist(ctx context.Context, req *chat.CheckUserExistRe
 }
 
 func (o *chatSvr) DelUserAccount(ctx context.Context, req *chat.DelUserAccountReq) (resp *chat.DelUserAccountResp, err error) {
+	if err := mctx.CheckNotImpersonated(ctx); err != nil {
+		return nil, err
+	}
 	if err := o.Database.DelUserAccount(ctx, req.UserIDs); err != nil && errs.Unwrap(err) != mongo.ErrNoDocuments {
 		return nil, err
 	}
@@ -0,0 +1,80 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package captcha
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/config"
+)
+
+const (
+	TypePoW   = "pow"
+	TypeImage = "image"
+)
+
+// Challenge is what the client has to solve.
+type Challenge struct {
+	ID   string
+	Type string
+	// Data is the proof-of-work seed, or the image as a data URL.
+	Data string
+	// Difficulty is the number of leading zero bits the proof-of-work hash needs.
+	Difficulty int
+	ExpireTime time.Time
+}
+
+type Provider interface {
+	Name() string
+	New(ctx context.Context) (*Challenge, error)
+	// Verify checks the answer to challenge id. A challenge can be verified only once.
+	Verify(ctx context.Context, id string, answer string) (bool, error)
+}
+
+// Store keeps the expected answers between New and Verify.
+type Store interface {
+	SetCaptcha(ctx context.Context, id string, answer string, expire time.Duration) error
+	// TakeCaptcha returns and deletes the answer of id, or "" if it does not exist.
+	TakeCaptcha(ctx context.Context, id string) (string, error)
+}
+
+func New(conf config.Captcha, store Store) (Provider, error) {
+	expire := time.Duration(conf.Expire) * time.Second
+	if expire <= 0 {
+		expire = 2 * time.Minute
+	}
+	switch strings.ToLower(conf.Use) {
+	case "", TypePoW:
+		return NewPoW(store, expire, conf.PoW.Difficulty), nil
+	case TypeImage:
+		return NewImage(store, expire, conf.Image.Length), nil
+	default:
+		return nil, errs.New("unknown captcha provider", "use", conf.Use)
+	}
+}
+
+func newID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return hex.EncodeToString(buf), nil
+}
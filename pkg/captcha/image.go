@@ -0,0 +1,158 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package captcha
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+	"math/big"
+	mrand "math/rand"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	imageScale   = 4
+	imageGlyphW  = 5
+	imageGlyphH  = 7
+	imagePadding = 8
+	imageHeight  = imageGlyphH*imageScale + 2*imagePadding
+)
+
+// digits is a 5x7 bitmap font, one row per byte with the low five bits set.
+var digits = [10][imageGlyphH]byte{
+	{0x0E, 0x11, 0x13, 0x15, 0x19, 0x11, 0x0E},
+	{0x04, 0x0C, 0x04, 0x04, 0x04, 0x04, 0x0E},
+	{0x0E, 0x11, 0x01, 0x02, 0x04, 0x08, 0x1F},
+	{0x1F, 0x02, 0x04, 0x02, 0x01, 0x11, 0x0E},
+	{0x02, 0x06, 0x0A, 0x12, 0x1F, 0x02, 0x02},
+	{0x1F, 0x10, 0x1E, 0x01, 0x01, 0x11, 0x0E},
+	{0x06, 0x08, 0x10, 0x1E, 0x11, 0x11, 0x0E},
+	{0x1F, 0x01, 0x02, 0x04, 0x08, 0x08, 0x08},
+	{0x0E, 0x11, 0x11, 0x0E, 0x11, 0x11, 0x0E},
+	{0x0E, 0x11, 0x11, 0x0F, 0x01, 0x02, 0x0C},
+}
+
+// NewImage returns a provider that shows length digits in a noisy PNG.
+func NewImage(store Store, expire time.Duration, length int) Provider {
+	if length <= 0 {
+		length = 5
+	}
+	return &imageCaptcha{store: store, expire: expire, length: length}
+}
+
+type imageCaptcha struct {
+	store  Store
+	expire time.Duration
+	length int
+}
+
+func (c *imageCaptcha) Name() string {
+	return TypeImage
+}
+
+func (c *imageCaptcha) New(ctx context.Context) (*Challenge, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	code := make([]byte, c.length)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		code[i] = byte(n.Int64())
+	}
+	data, err := drawDigits(code)
+	if err != nil {
+		return nil, err
+	}
+	answer := make([]byte, len(code))
+	for i, d := range code {
+		answer[i] = '0' + d
+	}
+	if err := c.store.SetCaptcha(ctx, id, string(answer), c.expire); err != nil {
+		return nil, err
+	}
+	return &Challenge{
+		ID:         id,
+		Type:       TypeImage,
+		Data:       "data:image/png;base64," + base64.StdEncoding.EncodeToString(data),
+		ExpireTime: time.Now().Add(c.expire),
+	}, nil
+}
+
+func (c *imageCaptcha) Verify(ctx context.Context, id string, answer string) (bool, error) {
+	val, err := c.store.TakeCaptcha(ctx, id)
+	if err != nil || val == "" {
+		return false, err
+	}
+	return val == strings.TrimSpace(answer), nil
+}
+
+func drawDigits(code []byte) ([]byte, error) {
+	cell := (imageGlyphW + 2) * imageScale
+	width := len(code)*cell + 2*imagePadding
+	img := image.NewRGBA(image.Rect(0, 0, width, imageHeight))
+	for y := 0; y < imageHeight; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: 245, G: 245, B: 240, A: 255})
+		}
+	}
+	for i, d := range code {
+		ink := color.RGBA{R: uint8(mrand.Intn(120)), G: uint8(mrand.Intn(120)), B: uint8(mrand.Intn(120)), A: 255}
+		x0 := imagePadding + i*cell + mrand.Intn(imageScale)
+		y0 := imagePadding + mrand.Intn(imagePadding) - imagePadding/2
+		for row, bits := range digits[d] {
+			// shear each row a little so the glyphs are not pixel-identical
+			shift := (row - imageGlyphH/2) * (mrand.Intn(3) - 1)
+			for col := 0; col < imageGlyphW; col++ {
+				if bits&(1<<(imageGlyphW-1-col)) == 0 {
+					continue
+				}
+				for dy := 0; dy < imageScale; dy++ {
+					for dx := 0; dx < imageScale; dx++ {
+						img.Set(x0+col*imageScale+dx+shift, y0+row*imageScale+dy, ink)
+					}
+				}
+			}
+		}
+	}
+	for i := 0; i < 4; i++ {
+		ink := color.RGBA{R: uint8(mrand.Intn(200)), G: uint8(mrand.Intn(200)), B: uint8(mrand.Intn(200)), A: 255}
+		y, dy := float64(mrand.Intn(imageHeight)), float64(mrand.Intn(5)-2)/10
+		for x := 0; x < width; x++ {
+			img.Set(x, int(y), ink)
+			y += dy
+		}
+	}
+	for i := 0; i < width*imageHeight/12; i++ {
+		v := uint8(mrand.Intn(256))
+		img.Set(mrand.Intn(width), mrand.Intn(imageHeight), color.RGBA{R: v, G: v, B: v, A: 255})
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return buf.Bytes(), nil
+}
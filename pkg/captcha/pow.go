@@ -0,0 +1,97 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package captcha
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// NewPoW returns a proof-of-work provider: the client has to find an answer such that
+// sha256(seed + answer) starts with difficulty zero bits.
+func NewPoW(store Store, expire time.Duration, difficulty int) Provider {
+	if difficulty <= 0 {
+		difficulty = 18
+	}
+	return &pow{store: store, expire: expire, difficulty: difficulty}
+}
+
+type pow struct {
+	store      Store
+	expire     time.Duration
+	difficulty int
+}
+
+func (p *pow) Name() string {
+	return TypePoW
+}
+
+func (p *pow) New(ctx context.Context) (*Challenge, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	seed := hex.EncodeToString(buf)
+	if err := p.store.SetCaptcha(ctx, id, strconv.Itoa(p.difficulty)+":"+seed, p.expire); err != nil {
+		return nil, err
+	}
+	return &Challenge{
+		ID:         id,
+		Type:       TypePoW,
+		Data:       seed,
+		Difficulty: p.difficulty,
+		ExpireTime: time.Now().Add(p.expire),
+	}, nil
+}
+
+func (p *pow) Verify(ctx context.Context, id string, answer string) (bool, error) {
+	val, err := p.store.TakeCaptcha(ctx, id)
+	if err != nil || val == "" {
+		return false, err
+	}
+	difficulty, seed, ok := strings.Cut(val, ":")
+	if !ok {
+		return false, nil
+	}
+	n, err := strconv.Atoi(difficulty)
+	if err != nil {
+		return false, nil
+	}
+	sum := sha256.Sum256([]byte(seed + answer))
+	return leadingZeroBits(sum[:]) >= n, nil
+}
+
+func leadingZeroBits(b []byte) int {
+	var n int
+	for _, v := range b {
+		if v != 0 {
+			return n + bits.LeadingZeros8(v)
+		}
+		n += 8
+	}
+	return n
+}
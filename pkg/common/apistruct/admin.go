@@ -17,14 +17,17 @@ package apistruct
 import "github.com/openimsdk/protocol/sdkws"
 
 type AdminLoginResp struct {
-	AdminAccount string `json:"adminAccount"`
-	AdminToken   string `json:"adminToken"`
-	Nickname     string `json:"nickname"`
-	FaceURL      string `json:"faceURL"`
-	Level        int32  `json:"level"`
-	AdminUserID  string `json:"adminUserID"`
-	ImUserID     string `json:"imUserID"`
-	ImToken      string `json:"imToken"`
+	AdminAccount       string   `json:"adminAccount"`
+	AdminToken         string   `json:"adminToken"`
+	Nickname           string   `json:"nickname"`
+	FaceURL            string   `json:"faceURL"`
+	Level              int32    `json:"level"`
+	AdminUserID        string   `json:"adminUserID"`
+	ImUserID           string   `json:"imUserID"`
+	ImToken            string   `json:"imToken"`
+	TotpTicket         string   `json:"totpTicket"`
+	TotpEnrollRequired bool     `json:"totpEnrollRequired"`
+	RecoveryCodes      []string `json:"recoveryCodes"`
 }
 
 type SearchDefaultGroupResp struct {
@@ -36,3 +39,10 @@ type NewUserCountResp struct {
 	Total     int64            `json:"total"`
 	DateCount map[string]int64 `json:"date_count"`
 }
+
+type ImpersonateUserResp struct {
+	ChatToken    string `json:"chatToken"`
+	ImToken      string `json:"imToken,omitempty"`
+	ExpireTime   int64  `json:"expireTime"`
+	Impersonator string `json:"impersonator"`
+}
@@ -17,15 +17,18 @@ package apistruct
 import "github.com/openimsdk/protocol/sdkws"
 
 type UserRegisterResp struct {
-	ImToken   string `json:"imToken"`
-	ChatToken string `json:"chatToken"`
-	UserID    string `json:"userID"`
+	ImToken      string `json:"imToken"`
+	ChatToken    string `json:"chatToken"`
+	RefreshToken string `json:"refreshToken"`
+	UserID       string `json:"userID"`
 }
 
 type LoginResp struct {
-	ImToken   string `json:"imToken"`
-	ChatToken string `json:"chatToken"`
-	UserID    string `json:"userID"`
+	ImToken      string `json:"imToken"`
+	ChatToken    string `json:"chatToken"`
+	RefreshToken string `json:"refreshToken"`
+	UserID       string `json:"userID"`
+	TotpTicket   string `json:"totpTicket"`
 }
 
 type UpdateUserInfoResp struct{}
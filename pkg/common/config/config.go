@@ -41,6 +41,19 @@ type API struct {
 		ListenIP string `mapstructure:"listenIP"`
 		Ports    []int  `mapstructure:"ports"`
 	} `mapstructure:"api"`
+	RateLimit RateLimit `mapstructure:"rateLimit"`
+}
+
+type RateLimit struct {
+	Enable bool             `mapstructure:"enable"`
+	Routes []RateLimitRoute `mapstructure:"routes"`
+}
+
+type RateLimitRoute struct {
+	Path  string  `mapstructure:"path"`
+	Key   string  `mapstructure:"key"`
+	Rate  float64 `mapstructure:"rate"`
+	Burst int     `mapstructure:"burst"`
 }
 
 type Mongo struct {
@@ -118,7 +131,81 @@ type Chat struct {
 		Key    string `mapstructure:"key"`
 		Secret string `mapstructure:"secret"`
 	} `mapstructure:"liveKit"`
-	AllowRegister bool `mapstructure:"allowRegister"`
+	AllowRegister   bool            `mapstructure:"allowRegister"`
+	PasswordHash    PasswordHash    `mapstructure:"passwordHash"`
+	TOTP            TOTP            `mapstructure:"totp"`
+	LoginLock       LoginLock       `mapstructure:"loginLock"`
+	Captcha         Captcha         `mapstructure:"captcha"`
+	SuspiciousLogin SuspiciousLogin `mapstructure:"suspiciousLogin"`
+	Moderation      Moderation      `mapstructure:"moderation"`
+	Delivery        Delivery        `mapstructure:"delivery"`
+	MessageTemplate MessageTemplate `mapstructure:"messageTemplate"`
+}
+
+type Delivery struct {
+	Attempts     int `mapstructure:"attempts"`
+	Backoff      int `mapstructure:"backoff"`
+	MaxBackoff   int `mapstructure:"maxBackoff"`
+	Check        int `mapstructure:"check"`
+	NoticeExpire int `mapstructure:"noticeExpire"`
+}
+
+type MessageTemplate struct {
+	AppName       string `mapstructure:"appName"`
+	DefaultLocale string `mapstructure:"defaultLocale"`
+	Dir           string `mapstructure:"dir"`
+	Refresh       int    `mapstructure:"refresh"`
+}
+
+type Moderation struct {
+	Refresh   int      `mapstructure:"refresh"`
+	Languages []string `mapstructure:"languages"`
+}
+
+type SuspiciousLogin struct {
+	Enable            bool `mapstructure:"enable"`
+	History           int  `mapstructure:"history"`
+	HistoryDays       int  `mapstructure:"historyDays"`
+	TravelHours       int  `mapstructure:"travelHours"`
+	RequireVerifyCode bool `mapstructure:"requireVerifyCode"`
+	Notify            bool `mapstructure:"notify"`
+}
+
+type Captcha struct {
+	Use    string `mapstructure:"use"`
+	Expire int    `mapstructure:"expire"`
+	PoW    struct {
+		Difficulty int `mapstructure:"difficulty"`
+	} `mapstructure:"pow"`
+	Image struct {
+		Length int `mapstructure:"length"`
+	} `mapstructure:"image"`
+}
+
+type TOTP struct {
+	Issuer       string `mapstructure:"issuer"`
+	TicketExpire int    `mapstructure:"ticketExpire"`
+	MaxAttempts  int    `mapstructure:"maxAttempts"`
+}
+
+type LoginLock struct {
+	MaxFailures   int `mapstructure:"maxFailures"`
+	IPMaxFailures int `mapstructure:"ipMaxFailures"`
+	Window        int `mapstructure:"window"`
+	LockTime      int `mapstructure:"lockTime"`
+	MaxLockTime   int `mapstructure:"maxLockTime"`
+}
+
+type PasswordHash struct {
+	Use      string `mapstructure:"use"`
+	Argon2id struct {
+		Memory      uint32 `mapstructure:"memory"`
+		Iterations  uint32 `mapstructure:"iterations"`
+		Parallelism uint8  `mapstructure:"parallelism"`
+	} `mapstructure:"argon2id"`
+	Bcrypt struct {
+		Cost int `mapstructure:"cost"`
+	} `mapstructure:"bcrypt"`
 }
 
 type VerifyCode struct {
@@ -128,23 +215,88 @@ type VerifyCode struct {
 	MaxCount   int    `mapstructure:"maxCount"`
 	SuperCode  string `mapstructure:"superCode"`
 	Len        int    `mapstructure:"len"`
+	HashKey    string `mapstructure:"hashKey"`
 	Phone      struct {
-		Use string `mapstructure:"use"`
-		Ali struct {
+		Use      string            `mapstructure:"use"`
+		Routes   map[string]string `mapstructure:"routes"`
+		Failover string            `mapstructure:"failover"`
+		Ali      struct {
 			Endpoint                     string `mapstructure:"endpoint"`
 			AccessKeyID                  string `mapstructure:"accessKeyId"`
 			AccessKeySecret              string `mapstructure:"accessKeySecret"`
 			SignName                     string `mapstructure:"signName"`
 			VerificationCodeTemplateCode string `mapstructure:"verificationCodeTemplateCode"`
+			LoginNoticeTemplateCode      string `mapstructure:"loginNoticeTemplateCode"`
 		} `mapstructure:"ali"`
+		Twilio struct {
+			AccountSID               string `mapstructure:"accountSid"`
+			AuthToken                string `mapstructure:"authToken"`
+			From                     string `mapstructure:"from"`
+			MessagingServiceSID      string `mapstructure:"messagingServiceSid"`
+			VerificationCodeTemplate string `mapstructure:"verificationCodeTemplate"`
+			LoginNoticeTemplate      string `mapstructure:"loginNoticeTemplate"`
+		} `mapstructure:"twilio"`
+		Tencent struct {
+			SecretID                   string `mapstructure:"secretId"`
+			SecretKey                  string `mapstructure:"secretKey"`
+			Region                     string `mapstructure:"region"`
+			SDKAppID                   string `mapstructure:"sdkAppId"`
+			SignName                   string `mapstructure:"signName"`
+			VerificationCodeTemplateID string `mapstructure:"verificationCodeTemplateId"`
+			LoginNoticeTemplateID      string `mapstructure:"loginNoticeTemplateId"`
+		} `mapstructure:"tencent"`
+		SNS struct {
+			Region                   string `mapstructure:"region"`
+			AccessKeyID              string `mapstructure:"accessKeyId"`
+			SecretAccessKey          string `mapstructure:"secretAccessKey"`
+			SenderID                 string `mapstructure:"senderId"`
+			VerificationCodeTemplate string `mapstructure:"verificationCodeTemplate"`
+			LoginNoticeTemplate      string `mapstructure:"loginNoticeTemplate"`
+		} `mapstructure:"sns"`
+		HTTP struct {
+			URL                  string            `mapstructure:"url"`
+			Method               string            `mapstructure:"method"`
+			Headers              map[string]string `mapstructure:"headers"`
+			VerificationCodeBody string            `mapstructure:"verificationCodeBody"`
+			LoginNoticeBody      string            `mapstructure:"loginNoticeBody"`
+		} `mapstructure:"http"`
+		Fake struct {
+			File string `mapstructure:"file"`
+		} `mapstructure:"fake"`
 	} `mapstructure:"phone"`
 	Mail struct {
 		Use                     string `mapstructure:"use"`
 		Title                   string `mapstructure:"title"`
 		SenderMail              string `mapstructure:"senderMail"`
+		SenderName              string `mapstructure:"senderName"`
 		SenderAuthorizationCode string `mapstructure:"senderAuthorizationCode"`
 		SMTPAddr                string `mapstructure:"smtpAddr"`
 		SMTPPort                int    `mapstructure:"smtpPort"`
+		TLS                     string `mapstructure:"tls"`
+		CAFile                  string `mapstructure:"caFile"`
+		SES                     struct {
+			Region           string `mapstructure:"region"`
+			AccessKeyID      string `mapstructure:"accessKeyId"`
+			SecretAccessKey  string `mapstructure:"secretAccessKey"`
+			ConfigurationSet string `mapstructure:"configurationSet"`
+		} `mapstructure:"ses"`
+		SendGrid struct {
+			APIKey string `mapstructure:"apiKey"`
+		} `mapstructure:"sendgrid"`
+		Mailgun struct {
+			Domain  string `mapstructure:"domain"`
+			APIKey  string `mapstructure:"apiKey"`
+			BaseURL string `mapstructure:"baseURL"`
+		} `mapstructure:"mailgun"`
+		Failover struct {
+			Use                     string `mapstructure:"use"`
+			SenderMail              string `mapstructure:"senderMail"`
+			SenderAuthorizationCode string `mapstructure:"senderAuthorizationCode"`
+			SMTPAddr                string `mapstructure:"smtpAddr"`
+			SMTPPort                int    `mapstructure:"smtpPort"`
+			TLS                     string `mapstructure:"tls"`
+			CAFile                  string `mapstructure:"caFile"`
+		} `mapstructure:"failover"`
 	} `mapstructure:"mail"`
 }
 
@@ -155,9 +307,51 @@ type Admin struct {
 		Ports      []int  `mapstructure:"ports"`
 	} `mapstructure:"rpc"`
 	TokenPolicy struct {
-		Expire int `mapstructure:"expire"`
+		Expire       int `mapstructure:"expire"`
+		AccessExpire int `mapstructure:"accessExpire"`
 	} `mapstructure:"tokenPolicy"`
-	Secret string `mapstructure:"secret"`
+	Secret       string       `mapstructure:"secret"`
+	TokenSigning TokenSigning `mapstructure:"tokenSigning"`
+	PasswordHash PasswordHash `mapstructure:"passwordHash"`
+	TOTP         AdminTOTP    `mapstructure:"totp"`
+	LoginLock    LoginLock    `mapstructure:"loginLock"`
+	IPRule       struct {
+		Refresh int `mapstructure:"refresh"`
+	} `mapstructure:"ipRule"`
+	GeoIP struct {
+		File string `mapstructure:"file"`
+	} `mapstructure:"geoIP"`
+	Impersonation struct {
+		Expire int  `mapstructure:"expire"`
+		Notify bool `mapstructure:"notify"`
+	} `mapstructure:"impersonation"`
+	Block struct {
+		ExpireCheck int  `mapstructure:"expireCheck"`
+		MuteGroups  bool `mapstructure:"muteGroups"`
+	} `mapstructure:"block"`
+	Appeal struct {
+		TicketExpire int `mapstructure:"ticketExpire"`
+	} `mapstructure:"appeal"`
+	AuditLog struct {
+		HashKey string `mapstructure:"hashKey"`
+	} `mapstructure:"auditLog"`
+}
+
+type TokenSigning struct {
+	Keys []SigningKey `mapstructure:"keys"`
+}
+
+type SigningKey struct {
+	Kid            string `mapstructure:"kid"`
+	PrivateKeyFile string `mapstructure:"privateKeyFile"`
+	PublicKeyFile  string `mapstructure:"publicKeyFile"`
+	SignFrom       string `mapstructure:"signFrom"`
+	VerifyUntil    string `mapstructure:"verifyUntil"`
+}
+
+type AdminTOTP struct {
+	TOTP    `mapstructure:",squash"`
+	Require string `mapstructure:"require"`
 }
 
 type Log struct {
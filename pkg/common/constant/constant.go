@@ -71,6 +71,116 @@ const (
 	LimitLoginRegisterIP = 6 // Restrict both login and registration
 )
 
+// Reasons and results of suspicious logins.
+const (
+	SuspiciousLoginNewDevice        = "new_device"
+	SuspiciousLoginImpossibleTravel = "impossible_travel"
+
+	SuspiciousLoginAllowed    = "allowed"    // the login went on, the user was notified
+	SuspiciousLoginChallenged = "challenged" // the password login was refused until repeated with a verification code
+	SuspiciousLoginVerified   = "verified"   // the login used a verification code
+)
+
+// Geo rule actions for registrations or logins from a country or region.
+const (
+	GeoActionNone   = 0
+	GeoActionBlock  = 1
+	GeoActionVerify = 2 // require a captcha
+)
+
+// Reason categories of account blocks.
+const (
+	BlockCategorySpam    = "spam"
+	BlockCategoryAbuse   = "abuse"
+	BlockCategoryFraud   = "fraud"
+	BlockCategoryIllegal = "illegal"
+	BlockCategoryOther   = "other"
+)
+
+var BlockCategories = []string{BlockCategorySpam, BlockCategoryAbuse, BlockCategoryFraud, BlockCategoryIllegal, BlockCategoryOther}
+
+// Actions recorded in the block history.
+const (
+	BlockActionBlock   = "block"
+	BlockActionUnblock = "unblock"
+	BlockActionExpire  = "expire" // lifted by the expiry job once the end time passed
+)
+
+const (
+	BlockStateAll     = 0 // All
+	BlockStateActive  = 1 // Not yet ended
+	BlockStateExpired = 2 // Ended, waiting for the expiry job
+)
+
+// Status of an appeal against an account block.
+const (
+	AppealStatusAll      = 0 // All
+	AppealStatusPending  = 1 // Waiting for an admin
+	AppealStatusAccepted = 2 // Accepted, the block was lifted
+	AppealStatusRejected = 3 // Rejected, the block stays
+)
+
+// Targets of abuse reports. Reports use the block categories.
+const (
+	ReportTargetUser    = 1
+	ReportTargetGroup   = 2
+	ReportTargetMessage = 3
+)
+
+const (
+	ReportStatusAll      = 0 // All
+	ReportStatusPending  = 1 // In the moderation queue
+	ReportStatusHandled  = 2 // An action was taken
+	ReportStatusHandling = 3 // Claimed by an admin, the action is running
+)
+
+// Actions taken on the reports against a target.
+const (
+	ReportActionBlock   = "block"   // block the user through the admin rpc
+	ReportActionWarn    = "warn"    // send the user a warning by email, SMS or IM
+	ReportActionDismiss = "dismiss" // nothing to do
+)
+
+// Channels a report warning went out through.
+const (
+	WarnedByEmail = "email"
+	WarnedBySMS   = "sms"
+	WarnedByIM    = "im" // sent by the admin API as a message from the IM admin
+)
+
+// Content moderation rules, applied to messages and user info through the OpenIM callbacks.
+const (
+	ModerationRuleWord  = "word"  // whole words, or substrings for languages written without spaces
+	ModerationRuleRegex = "regex" // RE2 syntax
+
+	ModerationActionReject = "reject"
+	ModerationActionMask   = "mask" // replace the match with *
+	ModerationActionFlag   = "flag" // let the content through and report the sender
+
+	ModerationScopeAll      = 0
+	ModerationScopeMessage  = 1
+	ModerationScopeUserInfo = 2
+)
+
+// ModerationReporter prefixes the rule ID in the reporter of reports filed for moderation hits.
+const ModerationReporter = "moderation:"
+
+// Outbound SMS and email deliveries.
+const (
+	DeliveryChannelSMS   = "sms"
+	DeliveryChannelEmail = "email"
+
+	DeliveryKindCode   = "code"   // a verification code
+	DeliveryKindNotice = "notice" // an email subject and body, or the params of an SMS login notice
+)
+
+const (
+	DeliveryStatusAll    = 0 // All
+	DeliveryStatusQueued = 1 // Waiting for an attempt
+	DeliveryStatusSent   = 2 // Accepted by a provider
+	DeliveryStatusFailed = 3 // Out of attempts or expired
+)
+
 const (
 	InvitationCodeAll    = 0 // All
 	InvitationCodeUsed   = 1 // Used
@@ -80,12 +190,21 @@ const (
 const (
 	RpcOpUserID   = constant.OpUserID
 	RpcOpUserType = "opUserType"
+	// RpcImpersonator carries the admin user ID of a session an admin opened as the user.
+	RpcImpersonator = "opImpersonator"
 )
 
 const RpcCustomHeader = constant.RpcCustomHeader
 
 const NeedInvitationCodeRegisterConfigKey = "needInvitationCodeRegister"
 
+// Client config keys that turn on the captcha per route.
+const (
+	CaptchaCodeSendConfigKey      = "captchaCodeSend"
+	CaptchaRegisterConfigKey      = "captchaRegister"
+	CaptchaLoginFailuresConfigKey = "captchaLoginFailures" // failed logins of the account or IP before login needs a captcha
+)
+
 const (
 	DefaultAllowVibration = 1
 	DefaultAllowBeep      = 1
@@ -122,5 +241,20 @@ const (
 const (
 	VerifySuperCode = "supercode"
 	VerifyALi       = "ali"
-	VerifyMail      = "mail"
+	VerifyTwilio    = "twilio"
+	VerifyTencent   = "tencent"
+	VerifySNS       = "sns"
+	VerifyHTTP      = "http"
+	VerifyFake      = "fake"
+	VerifyMail      = "mail" // SMTP
+	VerifySES       = "ses"
+	VerifySendGrid  = "sendgrid"
+	VerifyMailgun   = "mailgun"
+)
+
+// admin TOTP policy
+const (
+	AdminTOTPRequireNone  = "none"
+	AdminTOTPRequireSuper = "super"
+	AdminTOTPRequireAll   = "all"
 )
@@ -0,0 +1,64 @@
+package constant
+
+// Admin permissions, granted to admins through roles. Super admins hold all of them.
+const (
+	PermUsersRead         = "users.read"
+	PermUsersWrite        = "users.write"
+	PermUsersBlock        = "users.block"
+	PermUsersImpersonate  = "users.impersonate"
+	PermInvitationManage  = "invitation.manage"
+	PermForbiddenManage   = "forbidden.manage"
+	PermDefaultManage     = "default.manage"
+	PermAppletManage      = "applet.manage"
+	PermApplicationManage = "application.manage"
+	PermClientConfigWrite = "client_config.write"
+	PermConfigRead        = "config.read"
+	PermConfigWrite       = "config.write"
+	PermSystemRestart     = "system.restart"
+	PermStatisticRead     = "statistic.read"
+	PermAuditRead         = "audit.read"
+	PermAdminsManage      = "admins.manage"
+	PermRolesManage       = "roles.manage"
+	PermModerationManage  = "moderation.manage"
+	PermTemplateManage    = "template.manage"
+)
+
+var AllPermissions = []string{
+	PermUsersRead,
+	PermUsersWrite,
+	PermUsersBlock,
+	PermUsersImpersonate,
+	PermInvitationManage,
+	PermForbiddenManage,
+	PermDefaultManage,
+	PermAppletManage,
+	PermApplicationManage,
+	PermClientConfigWrite,
+	PermConfigRead,
+	PermConfigWrite,
+	PermSystemRestart,
+	PermStatisticRead,
+	PermAuditRead,
+	PermAdminsManage,
+	PermRolesManage,
+	PermModerationManage,
+	PermTemplateManage,
+}
+
+// Built-in roles, created at startup and not deletable.
+const (
+	RoleAdmin   = "admin"   // everything except managing admins and roles, given to admins without roles
+	RoleSupport = "support" // user care, cannot touch configuration or restart services
+	RoleViewer  = "viewer"  // read only, without the configuration, which holds the signing and provider secrets
+)
+
+var BuiltinRoles = map[string][]string{
+	RoleAdmin: {
+		PermUsersRead, PermUsersWrite, PermUsersBlock, PermUsersImpersonate, PermInvitationManage, PermForbiddenManage,
+		PermDefaultManage, PermAppletManage, PermApplicationManage, PermClientConfigWrite,
+		PermConfigRead, PermConfigWrite, PermSystemRestart, PermStatisticRead, PermAuditRead, PermModerationManage,
+		PermTemplateManage,
+	},
+	RoleSupport: {PermUsersRead, PermUsersBlock, PermUsersImpersonate, PermStatisticRead},
+	RoleViewer:  {PermUsersRead, PermStatisticRead},
+}
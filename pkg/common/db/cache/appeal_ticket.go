@@ -0,0 +1,75 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const appealTicket = "CHAT_APPEAL_TICKET:"
+
+// AppealTicket lets a blocked user, who proved to own the account by logging in, appeal the block.
+type AppealTicket struct {
+	UserID    string `json:"userID"`
+	BlockTime int64  `json:"blockTime"` // create time of the block in milliseconds
+}
+
+type AppealTicketInterface interface {
+	SetAppealTicket(ctx context.Context, ticket string, t *AppealTicket, expire time.Duration) error
+	// GetAppealTicket returns nil if the ticket does not exist or has expired.
+	GetAppealTicket(ctx context.Context, ticket string) (*AppealTicket, error)
+	DelAppealTicket(ctx context.Context, ticket string) error
+}
+
+type AppealTicketRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewAppealTicketInterface(rdb redis.UniversalClient) *AppealTicketRedis {
+	return &AppealTicketRedis{rdb: rdb}
+}
+
+func (a *AppealTicketRedis) SetAppealTicket(ctx context.Context, ticket string, t *AppealTicket, expire time.Duration) error {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(a.rdb.Set(ctx, appealTicket+ticket, data, expire).Err())
+}
+
+func (a *AppealTicketRedis) GetAppealTicket(ctx context.Context, ticket string) (*AppealTicket, error) {
+	data, err := a.rdb.Get(ctx, appealTicket+ticket).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, errs.Wrap(err)
+	}
+	var t AppealTicket
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &t, nil
+}
+
+func (a *AppealTicketRedis) DelAppealTicket(ctx context.Context, ticket string) error {
+	return errs.Wrap(a.rdb.Del(ctx, appealTicket+ticket).Err())
+}
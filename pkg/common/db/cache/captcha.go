@@ -0,0 +1,57 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	chatCaptcha = "CHAT_CAPTCHA:"
+)
+
+type CaptchaInterface interface {
+	SetCaptcha(ctx context.Context, id string, answer string, expire time.Duration) error
+	// TakeCaptcha returns and deletes the answer of id, or "" if it does not exist.
+	TakeCaptcha(ctx context.Context, id string) (string, error)
+}
+
+type CaptchaRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewCaptchaInterface(rdb redis.UniversalClient) *CaptchaRedis {
+	return &CaptchaRedis{rdb: rdb}
+}
+
+func (c *CaptchaRedis) SetCaptcha(ctx context.Context, id string, answer string, expire time.Duration) error {
+	return errs.Wrap(c.rdb.Set(ctx, chatCaptcha+id, answer, expire).Err())
+}
+
+func (c *CaptchaRedis) TakeCaptcha(ctx context.Context, id string) (string, error) {
+	key := chatCaptcha + id
+	pipe := c.rdb.TxPipeline()
+	get := pipe.Get(ctx, key)
+	pipe.Del(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return "", errs.Wrap(err)
+	}
+	return get.Val(), nil
+}
@@ -0,0 +1,91 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const impersonationIMExpire = "CHAT_IMPERSONATION_IM_EXPIRE"
+
+// ImpersonationIMToken is an IM token issued to an admin acting as the user, the user goes offline
+// on the platform once the impersonation ends.
+type ImpersonationIMToken struct {
+	UserID   string
+	Platform int32
+}
+
+type ImpersonationInterface interface {
+	AddImpersonationIMToken(ctx context.Context, t *ImpersonationIMToken, expireTime time.Time) error
+	// TakeExpiredImpersonationIMToken removes and returns up to limit tokens whose impersonation
+	// ended; each is returned to one caller only.
+	TakeExpiredImpersonationIMToken(ctx context.Context, now time.Time, limit int64) ([]*ImpersonationIMToken, error)
+}
+
+type ImpersonationRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewImpersonationInterface(rdb redis.UniversalClient) *ImpersonationRedis {
+	return &ImpersonationRedis{rdb: rdb}
+}
+
+// the platform comes first, user IDs may contain the separator.
+func impersonationMember(t *ImpersonationIMToken) string {
+	return strconv.Itoa(int(t.Platform)) + ":" + t.UserID
+}
+
+func (i *ImpersonationRedis) AddImpersonationIMToken(ctx context.Context, t *ImpersonationIMToken, expireTime time.Time) error {
+	z := redis.Z{Score: float64(expireTime.UnixMilli()), Member: impersonationMember(t)}
+	// a later impersonation of the same user and platform moves the expiry
+	return errs.Wrap(i.rdb.ZAddArgs(ctx, impersonationIMExpire, redis.ZAddArgs{GT: true, Members: []redis.Z{z}}).Err())
+}
+
+func (i *ImpersonationRedis) TakeExpiredImpersonationIMToken(ctx context.Context, now time.Time, limit int64) ([]*ImpersonationIMToken, error) {
+	members, err := i.rdb.ZRangeByScore(ctx, impersonationIMExpire, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(now.UnixMilli(), 10),
+		Count: limit,
+	}).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	tokens := make([]*ImpersonationIMToken, 0, len(members))
+	for _, member := range members {
+		removed, err := i.rdb.ZRem(ctx, impersonationIMExpire, member).Result()
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		if removed == 0 {
+			continue // taken by another instance
+		}
+		platform, userID, ok := strings.Cut(member, ":")
+		if !ok {
+			continue
+		}
+		platformID, err := strconv.Atoi(platform)
+		if err != nil {
+			continue
+		}
+		tokens = append(tokens, &ImpersonationIMToken{UserID: userID, Platform: int32(platformID)})
+	}
+	return tokens, nil
+}
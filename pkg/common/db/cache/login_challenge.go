@@ -0,0 +1,100 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	loginChallenge      = "CHAT_LOGIN_CHALLENGE:"
+	adminLoginChallenge = "CHAT_ADMIN_LOGIN_CHALLENGE:"
+
+	loginChallengeData     = "data"
+	loginChallengeAttempts = "attempts"
+)
+
+// LoginChallenge is a login that passed the first factor and waits for the second.
+type LoginChallenge struct {
+	UserID   string `json:"userID"`
+	Platform int32  `json:"platform"`
+	DeviceID string `json:"deviceID"`
+	IP       string `json:"ip"`
+}
+
+type LoginChallengeInterface interface {
+	SetLoginChallenge(ctx context.Context, ticket string, challenge *LoginChallenge, expire time.Duration) error
+	// GetLoginChallenge returns nil if the ticket does not exist or has expired.
+	GetLoginChallenge(ctx context.Context, ticket string) (*LoginChallenge, error)
+	IncrLoginChallengeAttempts(ctx context.Context, ticket string) (int64, error)
+	DelLoginChallenge(ctx context.Context, ticket string) error
+}
+
+type LoginChallengeRedis struct {
+	rdb    redis.UniversalClient
+	prefix string
+}
+
+func NewLoginChallengeInterface(rdb redis.UniversalClient) *LoginChallengeRedis {
+	return &LoginChallengeRedis{rdb: rdb, prefix: loginChallenge}
+}
+
+// NewAdminLoginChallengeInterface keeps admin tickets apart so they cannot be redeemed as user tickets.
+func NewAdminLoginChallengeInterface(rdb redis.UniversalClient) *LoginChallengeRedis {
+	return &LoginChallengeRedis{rdb: rdb, prefix: adminLoginChallenge}
+}
+
+func (l *LoginChallengeRedis) SetLoginChallenge(ctx context.Context, ticket string, challenge *LoginChallenge, expire time.Duration) error {
+	data, err := json.Marshal(challenge)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	key := l.prefix + ticket
+	pipe := l.rdb.TxPipeline()
+	pipe.HSet(ctx, key, loginChallengeData, data, loginChallengeAttempts, 0)
+	pipe.Expire(ctx, key, expire)
+	_, err = pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (l *LoginChallengeRedis) GetLoginChallenge(ctx context.Context, ticket string) (*LoginChallenge, error) {
+	data, err := l.rdb.HGet(ctx, l.prefix+ticket, loginChallengeData).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, errs.Wrap(err)
+	}
+	var challenge LoginChallenge
+	if err := json.Unmarshal(data, &challenge); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &challenge, nil
+}
+
+func (l *LoginChallengeRedis) IncrLoginChallengeAttempts(ctx context.Context, ticket string) (int64, error) {
+	n, err := l.rdb.HIncrBy(ctx, l.prefix+ticket, loginChallengeAttempts, 1).Result()
+	return n, errs.Wrap(err)
+}
+
+func (l *LoginChallengeRedis) DelLoginChallenge(ctx context.Context, ticket string) error {
+	return errs.Wrap(l.rdb.Del(ctx, l.prefix+ticket).Err())
+}
@@ -0,0 +1,323 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/eerrs"
+)
+
+const (
+	loginLock      = "CHAT_LOGIN_LOCK:"
+	adminLoginLock = "CHAT_ADMIN_LOGIN_LOCK:"
+
+	loginLockIndex = "index"
+)
+
+// addLoginFailure counts a failure and, once the limit is reached, locks the target for
+// base * 2^(level-1) capped at max, where level is the number of recent locks. recent counts
+// the failures of the window too but is not reset by a lock, so it can gate a captcha.
+// KEYS: count, lock, level, recent. ARGV: limit, window, base, max (milliseconds).
+var addLoginFailure = redis.NewScript(`
+if redis.call("INCR", KEYS[4]) == 1 then
+	redis.call("PEXPIRE", KEYS[4], ARGV[2])
+end
+local n = redis.call("INCR", KEYS[1])
+if n == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+if n < tonumber(ARGV[1]) then
+	return 0
+end
+redis.call("DEL", KEYS[1])
+local level = redis.call("INCR", KEYS[3])
+local lock = math.floor(tonumber(ARGV[3]) * 2 ^ (level - 1))
+if lock > tonumber(ARGV[4]) then
+	lock = tonumber(ARGV[4])
+end
+redis.call("SET", KEYS[2], level, "PX", lock)
+redis.call("PEXPIRE", KEYS[3], lock + tonumber(ARGV[4]))
+return lock
+`)
+
+type LockPolicy struct {
+	MaxFailures int
+	Window      time.Duration
+	LockTime    time.Duration
+	MaxLockTime time.Duration
+}
+
+// LoginLockPolicy holds the limits for failures counted per account and per IP.
+type LoginLockPolicy struct {
+	Account LockPolicy
+	IP      LockPolicy
+}
+
+func NewLoginLockPolicy(conf config.LoginLock) LoginLockPolicy {
+	if conf.MaxFailures <= 0 {
+		conf.MaxFailures = 5
+	}
+	if conf.IPMaxFailures <= 0 {
+		conf.IPMaxFailures = 20
+	}
+	if conf.Window <= 0 {
+		conf.Window = 900
+	}
+	if conf.LockTime <= 0 {
+		conf.LockTime = 60
+	}
+	if conf.MaxLockTime < conf.LockTime {
+		conf.MaxLockTime = max(conf.LockTime, 86400)
+	}
+	policy := LockPolicy{
+		Window:      time.Duration(conf.Window) * time.Second,
+		LockTime:    time.Duration(conf.LockTime) * time.Second,
+		MaxLockTime: time.Duration(conf.MaxLockTime) * time.Second,
+	}
+	account, ip := policy, policy
+	account.MaxFailures = conf.MaxFailures
+	ip.MaxFailures = conf.IPMaxFailures
+	return LoginLockPolicy{Account: account, IP: ip}
+}
+
+// LoginLockCounter is the part of a login lock store LoginLocker needs.
+type LoginLockCounter interface {
+	GetLoginLockRemaining(ctx context.Context, target string) (time.Duration, error)
+	AddLoginFailure(ctx context.Context, target string, policy LockPolicy) (time.Duration, error)
+	ResetLoginFailures(ctx context.Context, target string) error
+}
+
+// LoginLocker locks an account or an IP after too many failed logins. Accounts are counted
+// under the target kind:account, IPs under ip:address.
+type LoginLocker struct {
+	counter LoginLockCounter
+	policy  LoginLockPolicy
+	kind    string
+}
+
+func NewLoginLocker(counter LoginLockCounter, policy LoginLockPolicy, kind string) *LoginLocker {
+	return &LoginLocker{counter: counter, policy: policy, kind: kind}
+}
+
+// Targets returns the lock targets of the account and the IP, either may be empty.
+func (l *LoginLocker) Targets(account string, ip string) []string {
+	targets := make([]string, 0, 2)
+	if account != "" {
+		targets = append(targets, l.kind+":"+account)
+	}
+	if ip != "" {
+		targets = append(targets, ipLockTarget(ip))
+	}
+	return targets
+}
+
+func ipLockTarget(ip string) string {
+	return "ip:" + ip
+}
+
+// Check fails while the account or the IP is locked.
+func (l *LoginLocker) Check(ctx context.Context, account string, ip string) error {
+	var remaining time.Duration
+	for _, target := range l.Targets(account, ip) {
+		d, err := l.counter.GetLoginLockRemaining(ctx, target)
+		if err != nil {
+			return err
+		}
+		remaining = max(remaining, d)
+	}
+	if remaining > 0 {
+		return eerrs.LoginLocked(remaining)
+	}
+	return nil
+}
+
+// Failed counts a failed login and returns err, or the lock error if this failure caused a lock.
+func (l *LoginLocker) Failed(ctx context.Context, account string, ip string, err error) error {
+	var locked time.Duration
+	for _, target := range l.Targets(account, ip) {
+		policy := l.policy.Account
+		if target == ipLockTarget(ip) {
+			policy = l.policy.IP
+		}
+		d, lockErr := l.counter.AddLoginFailure(ctx, target, policy)
+		if lockErr != nil {
+			log.ZError(ctx, "add login failure failed", lockErr, "target", target)
+			continue
+		}
+		if d > 0 {
+			log.ZWarn(ctx, "login locked", nil, "target", target, "lockTime", d)
+		}
+		locked = max(locked, d)
+	}
+	if locked > 0 {
+		return eerrs.LoginLocked(locked)
+	}
+	return err
+}
+
+// Succeeded resets the failures of the account, those of the IP keep counting.
+func (l *LoginLocker) Succeeded(ctx context.Context, account string) {
+	for _, target := range l.Targets(account, "") {
+		if err := l.counter.ResetLoginFailures(ctx, target); err != nil {
+			log.ZError(ctx, "reset login failures failed", err, "target", target)
+		}
+	}
+}
+
+// LoginLock is the failure state of a lock target such as an account or an IP.
+type LoginLock struct {
+	Target    string
+	Failures  int64 // toward the next lock
+	Recent    int64 // within the window, locks do not reset it
+	Level     int64
+	LockUntil time.Time
+}
+
+type LoginLockInterface interface {
+	// GetLoginLockRemaining returns how long target stays locked, zero if it is not locked.
+	GetLoginLockRemaining(ctx context.Context, target string) (time.Duration, error)
+	// AddLoginFailure counts a failed login and returns the lock time if it locked target.
+	AddLoginFailure(ctx context.Context, target string, policy LockPolicy) (time.Duration, error)
+	ResetLoginFailures(ctx context.Context, target string) error
+	GetLoginLock(ctx context.Context, target string) (*LoginLock, error)
+	// SearchLoginLocks pages the targets currently locked, the latest to unlock first.
+	SearchLoginLocks(ctx context.Context, offset int64, count int64) (int64, []*LoginLock, error)
+	ClearLoginLocks(ctx context.Context, targets ...string) error
+}
+
+type LoginLockRedis struct {
+	rdb    redis.UniversalClient
+	prefix string
+}
+
+func NewLoginLockInterface(rdb redis.UniversalClient) *LoginLockRedis {
+	return &LoginLockRedis{rdb: rdb, prefix: loginLock}
+}
+
+func NewAdminLoginLockInterface(rdb redis.UniversalClient) *LoginLockRedis {
+	return &LoginLockRedis{rdb: rdb, prefix: adminLoginLock}
+}
+
+// keys share a hash tag, so the script runs on one slot in cluster mode.
+func (l *LoginLockRedis) keys(target string) (count string, lock string, level string, recent string) {
+	base := l.prefix + "{" + target + "}:"
+	return base + "count", base + "lock", base + "level", base + "recent"
+}
+
+func (l *LoginLockRedis) GetLoginLockRemaining(ctx context.Context, target string) (time.Duration, error) {
+	_, lock, _, _ := l.keys(target)
+	ttl, err := l.rdb.PTTL(ctx, lock).Result()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (l *LoginLockRedis) AddLoginFailure(ctx context.Context, target string, policy LockPolicy) (time.Duration, error) {
+	count, lock, level, recent := l.keys(target)
+	ms, err := addLoginFailure.Run(ctx, l.rdb, []string{count, lock, level, recent},
+		policy.MaxFailures, policy.Window.Milliseconds(), policy.LockTime.Milliseconds(), policy.MaxLockTime.Milliseconds()).Int64()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if ms <= 0 {
+		return 0, nil
+	}
+	lockTime := time.Duration(ms) * time.Millisecond
+	index := redis.Z{Score: float64(time.Now().Add(lockTime).UnixMilli()), Member: target}
+	if err := l.rdb.ZAdd(ctx, l.prefix+loginLockIndex, index).Err(); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return lockTime, nil
+}
+
+func (l *LoginLockRedis) ResetLoginFailures(ctx context.Context, target string) error {
+	count, _, _, recent := l.keys(target)
+	return errs.Wrap(l.rdb.Del(ctx, count, recent).Err())
+}
+
+func (l *LoginLockRedis) GetLoginLock(ctx context.Context, target string) (*LoginLock, error) {
+	count, lock, level, recent := l.keys(target)
+	pipe := l.rdb.Pipeline()
+	countCmd := pipe.Get(ctx, count)
+	recentCmd := pipe.Get(ctx, recent)
+	levelCmd := pipe.Get(ctx, level)
+	ttlCmd := pipe.PTTL(ctx, lock)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errs.Wrap(err)
+	}
+	res := &LoginLock{Target: target}
+	res.Failures, _ = strconv.ParseInt(countCmd.Val(), 10, 64)
+	res.Recent, _ = strconv.ParseInt(recentCmd.Val(), 10, 64)
+	res.Level, _ = strconv.ParseInt(levelCmd.Val(), 10, 64)
+	if ttl := ttlCmd.Val(); ttl > 0 {
+		res.LockUntil = time.Now().Add(ttl)
+	}
+	return res, nil
+}
+
+func (l *LoginLockRedis) SearchLoginLocks(ctx context.Context, offset int64, count int64) (int64, []*LoginLock, error) {
+	key := l.prefix + loginLockIndex
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	if err := l.rdb.ZRemRangeByScore(ctx, key, "-inf", now).Err(); err != nil {
+		return 0, nil, errs.Wrap(err)
+	}
+	total, err := l.rdb.ZCard(ctx, key).Result()
+	if err != nil {
+		return 0, nil, errs.Wrap(err)
+	}
+	targets, err := l.rdb.ZRevRange(ctx, key, offset, offset+count-1).Result()
+	if err != nil {
+		return 0, nil, errs.Wrap(err)
+	}
+	locks := make([]*LoginLock, 0, len(targets))
+	for _, target := range targets {
+		lock, err := l.GetLoginLock(ctx, target)
+		if err != nil {
+			return 0, nil, err
+		}
+		locks = append(locks, lock)
+	}
+	return total, locks, nil
+}
+
+func (l *LoginLockRedis) ClearLoginLocks(ctx context.Context, targets ...string) error {
+	if len(targets) == 0 {
+		return nil
+	}
+	for _, target := range targets {
+		count, lock, level, recent := l.keys(target)
+		if err := l.rdb.Del(ctx, count, lock, level, recent).Err(); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	members := make([]any, 0, len(targets))
+	for _, target := range targets {
+		members = append(members, target)
+	}
+	return errs.Wrap(l.rdb.ZRem(ctx, l.prefix+loginLockIndex, members...).Err())
+}
@@ -0,0 +1,86 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/eerrs"
+)
+
+// memCounter locks a target for a minute once its failures reach the policy limit.
+type memCounter struct {
+	failures map[string]int
+	locked   map[string]bool
+}
+
+func (c *memCounter) GetLoginLockRemaining(ctx context.Context, target string) (time.Duration, error) {
+	if c.locked[target] {
+		return time.Minute, nil
+	}
+	return 0, nil
+}
+
+func (c *memCounter) AddLoginFailure(ctx context.Context, target string, policy LockPolicy) (time.Duration, error) {
+	c.failures[target]++
+	if c.failures[target] < policy.MaxFailures {
+		return 0, nil
+	}
+	c.failures[target] = 0
+	c.locked[target] = true
+	return time.Minute, nil
+}
+
+func (c *memCounter) ResetLoginFailures(ctx context.Context, target string) error {
+	delete(c.failures, target)
+	return nil
+}
+
+func TestLoginLocker(t *testing.T) {
+	ctx := context.Background()
+	counter := &memCounter{failures: make(map[string]int), locked: make(map[string]bool)}
+	l := NewLoginLocker(counter, LoginLockPolicy{Account: LockPolicy{MaxFailures: 2}, IP: LockPolicy{MaxFailures: 3}}, "user")
+	if got := l.Targets("u1", "1.2.3.4"); len(got) != 2 || got[0] != "user:u1" || got[1] != "ip:1.2.3.4" {
+		t.Fatalf("targets = %v", got)
+	}
+	errPassword := errors.New("password")
+
+	if err := l.Failed(ctx, "u1", "1.2.3.4", errPassword); err != errPassword {
+		t.Fatalf("first failure: %v", err)
+	}
+	l.Succeeded(ctx, "u1")
+	if counter.failures["user:u1"] != 0 || counter.failures["ip:1.2.3.4"] != 1 {
+		t.Fatalf("success reset %v, want the account only", counter.failures)
+	}
+	if err := l.Failed(ctx, "u1", "1.2.3.4", errPassword); err != errPassword {
+		t.Fatalf("second failure: %v", err)
+	}
+	// the account reaches its limit of 2, the IP its limit of 3
+	if err := l.Failed(ctx, "u1", "1.2.3.4", errPassword); !eerrs.ErrLoginLocked.Is(err) {
+		t.Fatalf("third failure: %v, want locked", err)
+	}
+	if !counter.locked["user:u1"] || !counter.locked["ip:1.2.3.4"] {
+		t.Fatalf("locked = %v", counter.locked)
+	}
+	if err := l.Check(ctx, "u2", "1.2.3.4"); !eerrs.ErrLoginLocked.Is(err) {
+		t.Fatalf("locked IP: %v", err)
+	}
+	if err := l.Check(ctx, "u2", "5.6.7.8"); err != nil {
+		t.Fatalf("other IP: %v", err)
+	}
+}
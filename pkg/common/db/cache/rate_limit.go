@@ -0,0 +1,93 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	rateLimit = "CHAT_RATE_LIMIT:"
+)
+
+// takeToken refills the bucket by the time passed and takes one token.
+// It returns 0 if a token was taken, otherwise the milliseconds until the next one.
+// KEYS: bucket. ARGV: rate (tokens per millisecond), burst, now (milliseconds).
+var takeToken = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(bucket[1]) or burst
+local ts = tonumber(bucket[2]) or now
+if now > ts then
+	tokens = math.min(burst, tokens + (now - ts) * rate)
+	ts = now
+end
+local wait = 0
+if tokens < 1 then
+	wait = math.ceil((1 - tokens) / rate)
+else
+	tokens = tokens - 1
+end
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", ts)
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst / rate))
+return wait
+`)
+
+// refundToken puts back a token taken from a bucket that still exists, up to burst.
+// KEYS: bucket. ARGV: burst.
+var refundToken = redis.NewScript(`
+local tokens = tonumber(redis.call("HGET", KEYS[1], "tokens"))
+if tokens then
+	redis.call("HSET", KEYS[1], "tokens", tostring(math.min(tonumber(ARGV[1]), tokens + 1)))
+end
+return 0
+`)
+
+type RateLimitInterface interface {
+	// Take takes a token from the bucket of key, returning how long to wait if it is empty.
+	Take(ctx context.Context, key string, rate float64, burst int) (time.Duration, error)
+	// Refund puts back a token Take took, for a request another bucket refused.
+	Refund(ctx context.Context, key string, burst int) error
+}
+
+type RateLimitRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewRateLimitInterface(rdb redis.UniversalClient) *RateLimitRedis {
+	return &RateLimitRedis{rdb: rdb}
+}
+
+func (r *RateLimitRedis) Take(ctx context.Context, key string, rate float64, burst int) (time.Duration, error) {
+	perMs := rate / float64(time.Second/time.Millisecond)
+	wait, err := takeToken.Run(ctx, r.rdb, []string{rateLimit + key}, perMs, burst, time.Now().UnixMilli()).Int64()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return time.Duration(wait) * time.Millisecond, nil
+}
+
+func (r *RateLimitRedis) Refund(ctx context.Context, key string, burst int) error {
+	if err := refundToken.Run(ctx, r.rdb, []string{rateLimit + key}, burst).Err(); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newRateLimit(t *testing.T) (*RateLimitRedis, *miniredis.Miniredis) {
+	t.Helper()
+	s := miniredis.RunT(t)
+	return NewRateLimitInterface(redis.NewClient(&redis.Options{Addr: s.Addr()})), s
+}
+
+func TestRateLimitTake(t *testing.T) {
+	ctx := context.Background()
+	r, s := newRateLimit(t)
+	for i := 0; i < 3; i++ {
+		if wait, err := r.Take(ctx, "k", 1, 3); err != nil || wait != 0 {
+			t.Fatalf("take %d: wait %s, err %v", i, wait, err)
+		}
+	}
+	wait, err := r.Take(ctx, "k", 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wait <= 0 || wait > time.Second {
+		t.Fatalf("empty bucket: wait %s, want up to a second", wait)
+	}
+	if ttl := s.TTL(rateLimit + "k"); ttl <= 0 || ttl > 3*time.Second {
+		t.Fatalf("bucket ttl %s, want the time to refill it", ttl)
+	}
+	if wait, err := r.Take(ctx, "other", 1, 3); err != nil || wait != 0 {
+		t.Fatalf("other bucket: wait %s, err %v", wait, err)
+	}
+}
+
+func TestRateLimitRefill(t *testing.T) {
+	ctx := context.Background()
+	r, _ := newRateLimit(t)
+	// a token every millisecond
+	if wait, err := r.Take(ctx, "k", 1000, 1); err != nil || wait != 0 {
+		t.Fatalf("first take: wait %s, err %v", wait, err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if wait, err := r.Take(ctx, "k", 1000, 1); err != nil || wait != 0 {
+		t.Fatalf("take after refill: wait %s, err %v", wait, err)
+	}
+}
+
+func TestRateLimitRefund(t *testing.T) {
+	ctx := context.Background()
+	r, s := newRateLimit(t)
+	if err := r.Refund(ctx, "missing", 2); err != nil {
+		t.Fatal(err)
+	}
+	if s.Exists(rateLimit + "missing") {
+		t.Fatal("refund created a bucket")
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := r.Take(ctx, "k", 0.001, 2); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := r.Refund(ctx, "k", 2); err != nil {
+		t.Fatal(err)
+	}
+	if wait, err := r.Take(ctx, "k", 0.001, 2); err != nil || wait != 0 {
+		t.Fatalf("take of the refunded token: wait %s, err %v", wait, err)
+	}
+	if wait, _ := r.Take(ctx, "k", 0.001, 2); wait == 0 {
+		t.Fatal("bucket not empty again")
+	}
+	for i := 0; i < 3; i++ {
+		if err := r.Refund(ctx, "k", 2); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := s.HGet(rateLimit+"k", "tokens"); got != "2" {
+		t.Fatalf("refunds filled the bucket to %s, want the burst 2", got)
+	}
+}
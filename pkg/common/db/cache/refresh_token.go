@@ -0,0 +1,103 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	refreshToken = "CHAT_REFRESH_TOKEN:"
+
+	refreshTokenData = "data"
+	refreshTokenUsed = "used"
+)
+
+// useRefreshToken returns the data and the new use count of a refresh token, or nil if it does not exist.
+var useRefreshToken = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return false
+end
+local used = redis.call("HINCRBY", KEYS[1], ARGV[1], 1)
+return {redis.call("HGET", KEYS[1], ARGV[2]), used}
+`)
+
+// RefreshToken is the family a refresh token belongs to; the family is the session it was issued for.
+type RefreshToken struct {
+	UserID    string `json:"userID"`
+	SessionID string `json:"sessionID"`
+}
+
+type RefreshTokenInterface interface {
+	SetRefreshToken(ctx context.Context, token string, rt *RefreshToken, expire time.Duration) error
+	// UseRefreshToken marks token as used and reports whether it had been used before.
+	// It returns nil if the token does not exist or has expired.
+	UseRefreshToken(ctx context.Context, token string) (*RefreshToken, bool, error)
+}
+
+type RefreshTokenRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewRefreshTokenInterface(rdb redis.UniversalClient) *RefreshTokenRedis {
+	return &RefreshTokenRedis{rdb: rdb}
+}
+
+// refreshTokenKey stores only a digest, so the tokens cannot be read back from redis.
+func refreshTokenKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return refreshToken + hex.EncodeToString(sum[:])
+}
+
+func (r *RefreshTokenRedis) SetRefreshToken(ctx context.Context, token string, rt *RefreshToken, expire time.Duration) error {
+	data, err := json.Marshal(rt)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	key := refreshTokenKey(token)
+	pipe := r.rdb.TxPipeline()
+	pipe.HSet(ctx, key, refreshTokenData, data, refreshTokenUsed, 0)
+	pipe.Expire(ctx, key, expire)
+	_, err = pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (r *RefreshTokenRedis) UseRefreshToken(ctx context.Context, token string) (*RefreshToken, bool, error) {
+	res, err := useRefreshToken.Run(ctx, r.rdb, []string{refreshTokenKey(token)}, refreshTokenUsed, refreshTokenData).Slice()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, errs.Wrap(err)
+	}
+	if len(res) != 2 {
+		return nil, false, errs.New("invalid refresh token script result").Wrap()
+	}
+	data, _ := res[0].(string)
+	used, _ := res[1].(int64)
+	var rt RefreshToken
+	if err := json.Unmarshal([]byte(data), &rt); err != nil {
+		return nil, false, errs.Wrap(err)
+	}
+	return &rt, used > 1, nil
+}
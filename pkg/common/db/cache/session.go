@@ -0,0 +1,137 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	userSession = "CHAT_USER_SESSION:"
+)
+
+// Session is one signed-in device of a user, stored per user as sessionID -> Session.
+type Session struct {
+	SessionID    string    `json:"sessionID"`
+	Token        string    `json:"token"`
+	Platform     int32     `json:"platform"`
+	DeviceID     string    `json:"deviceID"`
+	IP           string    `json:"ip"`
+	CreateTime   time.Time `json:"createTime"`
+	LastSeenTime time.Time `json:"lastSeenTime"`
+	ExpireTime   time.Time `json:"expireTime"`
+	Impersonator string    `json:"impersonator,omitempty"` // admin user ID when an admin logged in as the user
+}
+
+type SessionInterface interface {
+	SetSession(ctx context.Context, userID string, session *Session) error
+	// GetSession returns nil if the session does not exist.
+	GetSession(ctx context.Context, userID string, sessionID string) (*Session, error)
+	// GetSessions returns the unexpired sessions, newest first.
+	GetSessions(ctx context.Context, userID string) ([]*Session, error)
+	DelSessions(ctx context.Context, userID string, sessionIDs ...string) error
+	DeleteSessionsByUid(ctx context.Context, userID string) error
+}
+
+type SessionCacheRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewSessionInterface(rdb redis.UniversalClient) *SessionCacheRedis {
+	return &SessionCacheRedis{rdb: rdb}
+}
+
+func (s *SessionCacheRedis) SetSession(ctx context.Context, userID string, session *Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	key := userSession + userID
+	if err := s.rdb.HSet(ctx, key, session.SessionID, data).Err(); err != nil {
+		return errs.Wrap(err)
+	}
+	// the hash lives as long as its longest session
+	ttl, err := s.rdb.TTL(ctx, key).Result()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if expire := time.Until(session.ExpireTime); ttl < expire {
+		if err := s.rdb.Expire(ctx, key, expire).Err(); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func (s *SessionCacheRedis) GetSession(ctx context.Context, userID string, sessionID string) (*Session, error) {
+	data, err := s.rdb.HGet(ctx, userSession+userID, sessionID).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, errs.Wrap(err)
+	}
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &session, nil
+}
+
+func (s *SessionCacheRedis) GetSessions(ctx context.Context, userID string) ([]*Session, error) {
+	key := userSession + userID
+	m, err := s.rdb.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	now := time.Now()
+	sessions := make([]*Session, 0, len(m))
+	var expired []string
+	for sessionID, data := range m {
+		var session Session
+		if err := json.Unmarshal([]byte(data), &session); err != nil || session.ExpireTime.Before(now) {
+			expired = append(expired, sessionID)
+			continue
+		}
+		sessions = append(sessions, &session)
+	}
+	if len(expired) > 0 {
+		if err := s.rdb.HDel(ctx, key, expired...).Err(); err != nil {
+			return nil, errs.Wrap(err)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreateTime.After(sessions[j].CreateTime)
+	})
+	return sessions, nil
+}
+
+func (s *SessionCacheRedis) DelSessions(ctx context.Context, userID string, sessionIDs ...string) error {
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+	return errs.Wrap(s.rdb.HDel(ctx, userSession+userID, sessionIDs...).Err())
+}
+
+func (s *SessionCacheRedis) DeleteSessionsByUid(ctx context.Context, userID string) error {
+	return errs.Wrap(s.rdb.Del(ctx, userSession+userID).Err())
+}
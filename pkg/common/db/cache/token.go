@@ -33,6 +33,7 @@ type TokenInterface interface {
 	AddTokenFlagNXEx(ctx context.Context, userID string, token string, flag int, expire time.Duration) (bool, error)
 	GetTokensWithoutError(ctx context.Context, userID string) (map[string]int32, error)
 	DeleteTokenByUid(ctx context.Context, userID string) error
+	DeleteTokens(ctx context.Context, userID string, tokens ...string) error
 }
 
 type TokenCacheRedis struct {
@@ -82,3 +83,11 @@ func (t *TokenCacheRedis) DeleteTokenByUid(ctx context.Context, userID string) e
 	key := chatToken + userID
 	return errs.Wrap(t.rdb.Del(ctx, key).Err())
 }
+
+func (t *TokenCacheRedis) DeleteTokens(ctx context.Context, userID string, tokens ...string) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	key := chatToken + userID
+	return errs.Wrap(t.rdb.HDel(ctx, key, tokens...).Err())
+}
@@ -19,12 +19,16 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"time"
 
+	chatconstant "github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/pagination"
 	"github.com/openimsdk/tools/db/tx"
+	"github.com/openimsdk/tools/errs"
 	"github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/openimsdk/chat/pkg/common/db/model/admin"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
@@ -56,6 +60,7 @@ type AdminDatabaseInterface interface {
 	SearchIPForbidden(ctx context.Context, keyword string, state int32, pagination pagination.Pagination) (int64, []*admindb.IPForbidden, error)
 	AddIPForbidden(ctx context.Context, ms []*admindb.IPForbidden) error
 	FindIPForbidden(ctx context.Context, ms []string) ([]*admindb.IPForbidden, error)
+	FindAllIPForbidden(ctx context.Context) ([]*admindb.IPForbidden, error)
 	DelIPForbidden(ctx context.Context, ips []string) error
 	FindDefaultFriend(ctx context.Context, userIDs []string) ([]string, error)
 	AddDefaultFriend(ctx context.Context, ms []*admindb.RegisterAddFriend) error
@@ -67,23 +72,82 @@ type AdminDatabaseInterface interface {
 	SearchDefaultGroup(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.RegisterAddGroup, error)
 	FindBlockInfo(ctx context.Context, userIDs []string) ([]*admindb.ForbiddenAccount, error)
 	GetBlockInfo(ctx context.Context, userID string) (*admindb.ForbiddenAccount, error)
+	// BlockUser and DelBlockUser also append the change to the block history.
 	BlockUser(ctx context.Context, f []*admindb.ForbiddenAccount) error
-	DelBlockUser(ctx context.Context, userID []string) error
-	SearchBlockUser(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.ForbiddenAccount, error)
+	DelBlockUser(ctx context.Context, f []*admindb.ForbiddenAccount, operatorUserID string) error
+	// LiftExpiredBlock removes a block whose end time passed, it reports false if it was already lifted.
+	LiftExpiredBlock(ctx context.Context, f *admindb.ForbiddenAccount) (bool, error)
+	FindExpiredBlock(ctx context.Context, now time.Time, limit int64) ([]*admindb.ForbiddenAccount, error)
+	SearchBlockUser(ctx context.Context, keyword string, category string, state int32, pagination pagination.Pagination) (int64, []*admindb.ForbiddenAccount, error)
 	FindBlockUser(ctx context.Context, userIDs []string) ([]*admindb.ForbiddenAccount, error)
+	SearchBlockHistory(ctx context.Context, userIDs []string, action string, pagination pagination.Pagination) (int64, []*admindb.BlockHistory, error)
+	AddImpersonationIMToken(ctx context.Context, t *cache.ImpersonationIMToken, expireTime time.Time) error
+	TakeExpiredImpersonationIMToken(ctx context.Context, now time.Time, limit int64) ([]*cache.ImpersonationIMToken, error)
+	SetAppealTicket(ctx context.Context, ticket string, t *cache.AppealTicket, expire time.Duration) error
+	GetAppealTicket(ctx context.Context, ticket string) (*cache.AppealTicket, error)
+	// SubmitAppeal stores the appeal and spends the ticket.
+	SubmitAppeal(ctx context.Context, ticket string, appeal *admindb.Appeal) error
+	TakeAppeal(ctx context.Context, appealID string) (*admindb.Appeal, error)
+	TakeAppealByBlock(ctx context.Context, userID string, blockTime time.Time) (*admindb.Appeal, error)
+	SearchAppeal(ctx context.Context, keyword string, status int32, assigneeUserID string, pagination pagination.Pagination) (int64, []*admindb.Appeal, error)
+	AssignAppeal(ctx context.Context, appealIDs []string, assigneeUserID string) error
+	// HandleAppeal closes a pending appeal and, if unblock is not nil, lifts that block on behalf of
+	// operatorUserID. It reports false if the appeal was handled before.
+	HandleAppeal(ctx context.Context, appealID string, update map[string]any, unblock *admindb.ForbiddenAccount, operatorUserID string) (bool, error)
 	SearchUserLimitLogin(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.LimitUserLoginIP, error)
 	AddUserLimitLogin(ctx context.Context, ms []*admindb.LimitUserLoginIP) error
 	DelUserLimitLogin(ctx context.Context, ms []*admindb.LimitUserLoginIP) error
 	CountLimitUserLoginIP(ctx context.Context, userID string) (uint32, error)
 	GetLimitUserLoginIP(ctx context.Context, userID string, ip string) (*admindb.LimitUserLoginIP, error)
+	FindAllUserLimitLogin(ctx context.Context) ([]*admindb.LimitUserLoginIP, error)
+	AddGeoRule(ctx context.Context, ms []*admindb.GeoRule) error
+	DelGeoRule(ctx context.Context, ms []*admindb.GeoRule) error
+	FindAllGeoRule(ctx context.Context) ([]*admindb.GeoRule, error)
+	SearchGeoRule(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.GeoRule, error)
+	AddRole(ctx context.Context, roles []*admindb.Role) error
+	UpdateRole(ctx context.Context, roleID string, update map[string]any) error
+	DelRole(ctx context.Context, roleIDs []string) error
+	FindRole(ctx context.Context, roleIDs []string) ([]*admindb.Role, error)
+	SearchRole(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.Role, error)
+	ExistAdminRole(ctx context.Context, roleIDs []string) (bool, error)
+	AppendAuditLog(ctx context.Context, log *admindb.AuditLog, key []byte) error
+	FindAuditLogAfter(ctx context.Context, seq int64, limit int64) ([]*admindb.AuditLog, error)
+	FindAuditLogByOperationID(ctx context.Context, operationID string) ([]*admindb.AuditLog, error)
+	SearchAuditLog(ctx context.Context, operatorUserIDs []string, action string, target string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*admindb.AuditLog, error)
+	ScanAuditLog(ctx context.Context, operatorUserIDs []string, action string, target string, start time.Time, end time.Time, cursor primitive.ObjectID, limit int64) ([]*admindb.AuditLog, error)
+	TakeAuditLogHead(ctx context.Context) (*admindb.AuditLogHead, error)
 	CacheToken(ctx context.Context, userID string, token string, expire time.Duration) error
 	GetTokens(ctx context.Context, userID string) (map[string]int32, error)
+	SetSession(ctx context.Context, userID string, session *cache.Session) error
+	GetSession(ctx context.Context, userID string, sessionID string) (*cache.Session, error)
+	GetSessions(ctx context.Context, userID string) ([]*cache.Session, error)
+	RevokeSession(ctx context.Context, userID string, session *cache.Session) error
+	DeleteTokens(ctx context.Context, userID string, tokens ...string) error
+	SetRefreshToken(ctx context.Context, token string, rt *cache.RefreshToken, expire time.Duration) error
+	UseRefreshToken(ctx context.Context, token string) (*cache.RefreshToken, bool, error)
 	DeleteToken(ctx context.Context, userID string) error
 	LatestVersion(ctx context.Context, platform string) (*admindb.Application, error)
 	AddVersion(ctx context.Context, val *admindb.Application) error
 	UpdateVersion(ctx context.Context, id primitive.ObjectID, update map[string]any) error
 	DeleteVersion(ctx context.Context, id []primitive.ObjectID) error
 	PageVersion(ctx context.Context, platforms []string, page pagination.Pagination) (int64, []*admindb.Application, error)
+	TakeAdminTOTP(ctx context.Context, userID string) (*admindb.AdminTOTP, error)
+	SetAdminTOTP(ctx context.Context, totp *admindb.AdminTOTP) error
+	UpdateAdminTOTP(ctx context.Context, userID string, data map[string]any) error
+	UpdateAdminTOTPCounter(ctx context.Context, userID string, counter int64) (bool, error)
+	UseAdminTOTPRecoveryCode(ctx context.Context, userID string, hash string) (bool, error)
+	DelAdminTOTP(ctx context.Context, userIDs []string) error
+	SetLoginChallenge(ctx context.Context, ticket string, challenge *cache.LoginChallenge, expire time.Duration) error
+	GetLoginChallenge(ctx context.Context, ticket string) (*cache.LoginChallenge, error)
+	GetLoginLockRemaining(ctx context.Context, target string) (time.Duration, error)
+	AddLoginFailure(ctx context.Context, target string, policy cache.LockPolicy) (time.Duration, error)
+	ResetLoginFailures(ctx context.Context, target string) error
+	// SearchLoginLocks, GetLoginLocks and ClearLoginLocks work on admin logins if admin is set, otherwise on user logins.
+	SearchLoginLocks(ctx context.Context, admin bool, pagination pagination.Pagination) (int64, []*cache.LoginLock, error)
+	GetLoginLocks(ctx context.Context, admin bool, targets []string) ([]*cache.LoginLock, error)
+	ClearLoginLocks(ctx context.Context, admin bool, targets []string) error
+	IncrLoginChallengeAttempts(ctx context.Context, ticket string) (int64, error)
+	DelLoginChallenge(ctx context.Context, ticket string) error
 }
 
 func NewAdminDatabase(cli *mongoutil.Client, rdb redis.UniversalClient) (AdminDatabaseInterface, error) {
@@ -99,10 +163,30 @@ func NewAdminDatabase(cli *mongoutil.Client, rdb redis.UniversalClient) (AdminDa
 	if err != nil {
 		return nil, err
 	}
+	blockHistory, err := admin.NewBlockHistory(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	appeal, err := admin.NewAppeal(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
 	limitUserLoginIP, err := admin.NewLimitUserLoginIP(cli.GetDB())
 	if err != nil {
 		return nil, err
 	}
+	geoRule, err := admin.NewGeoRule(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	role, err := admin.NewRole(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	auditLog, err := admin.NewAuditLog(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
 	invitationRegister, err := admin.NewInvitationRegister(cli.GetDB())
 	if err != nil {
 		return nil, err
@@ -127,19 +211,36 @@ func NewAdminDatabase(cli *mongoutil.Client, rdb redis.UniversalClient) (AdminDa
 	if err != nil {
 		return nil, err
 	}
+	adminTOTP, err := admin.NewAdminTOTP(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
 	return &AdminDatabase{
 		tx:                 cli.GetTx(),
 		admin:              a,
 		ipForbidden:        forbidden,
 		forbiddenAccount:   forbiddenAccount,
+		blockHistory:       blockHistory,
+		appeal:             appeal,
 		limitUserLoginIP:   limitUserLoginIP,
+		geoRule:            geoRule,
+		role:               role,
+		auditLog:           auditLog,
 		invitationRegister: invitationRegister,
 		registerAddFriend:  registerAddFriend,
 		registerAddGroup:   registerAddGroup,
 		applet:             applet,
 		clientConfig:       clientConfig,
 		application:        application,
+		adminTOTP:          adminTOTP,
 		cache:              cache.NewTokenInterface(rdb),
+		session:            cache.NewSessionInterface(rdb),
+		refreshToken:       cache.NewRefreshTokenInterface(rdb),
+		loginChallenge:     cache.NewAdminLoginChallengeInterface(rdb),
+		appealTicket:       cache.NewAppealTicketInterface(rdb),
+		impersonation:      cache.NewImpersonationInterface(rdb),
+		loginLock:          cache.NewAdminLoginLockInterface(rdb),
+		userLoginLock:      cache.NewLoginLockInterface(rdb),
 	}, nil
 }
 
@@ -148,14 +249,27 @@ type AdminDatabase struct {
 	admin              admindb.AdminInterface
 	ipForbidden        admindb.IPForbiddenInterface
 	forbiddenAccount   admindb.ForbiddenAccountInterface
+	blockHistory       admindb.BlockHistoryInterface
+	appeal             admindb.AppealInterface
 	limitUserLoginIP   admindb.LimitUserLoginIPInterface
+	geoRule            admindb.GeoRuleInterface
+	role               admindb.RoleInterface
+	auditLog           admindb.AuditLogInterface
 	invitationRegister admindb.InvitationRegisterInterface
 	registerAddFriend  admindb.RegisterAddFriendInterface
 	registerAddGroup   admindb.RegisterAddGroupInterface
 	applet             admindb.AppletInterface
 	clientConfig       admindb.ClientConfigInterface
 	application        admindb.ApplicationInterface
+	adminTOTP          admindb.AdminTOTPInterface
 	cache              cache.TokenInterface
+	session            cache.SessionInterface
+	refreshToken       cache.RefreshTokenInterface
+	loginChallenge     cache.LoginChallengeInterface
+	appealTicket       cache.AppealTicketInterface
+	impersonation      cache.ImpersonationInterface
+	loginLock          cache.LoginLockInterface
+	userLoginLock      cache.LoginLockInterface
 }
 
 func (o *AdminDatabase) GetAdmin(ctx context.Context, account string) (*admindb.Admin, error) {
@@ -179,7 +293,12 @@ func (o *AdminDatabase) AddAdminAccount(ctx context.Context, admins []*admindb.A
 }
 
 func (o *AdminDatabase) DelAdminAccount(ctx context.Context, userIDs []string) error {
-	return o.admin.Delete(ctx, userIDs)
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := o.admin.Delete(ctx, userIDs); err != nil {
+			return err
+		}
+		return o.adminTOTP.Delete(ctx, userIDs)
+	})
 }
 
 func (o *AdminDatabase) SearchAdminAccount(ctx context.Context, pagination pagination.Pagination) (int64, []*admindb.Admin, error) {
@@ -258,6 +377,10 @@ func (o *AdminDatabase) FindIPForbidden(ctx context.Context, ms []string) ([]*ad
 	return o.ipForbidden.Find(ctx, ms)
 }
 
+func (o *AdminDatabase) FindAllIPForbidden(ctx context.Context) ([]*admindb.IPForbidden, error) {
+	return o.ipForbidden.FindAll(ctx)
+}
+
 func (o *AdminDatabase) DelIPForbidden(ctx context.Context, ips []string) error {
 	return o.ipForbidden.Delete(ctx, ips)
 }
@@ -302,16 +425,125 @@ func (o *AdminDatabase) GetBlockInfo(ctx context.Context, userID string) (*admin
 	return o.forbiddenAccount.Take(ctx, userID)
 }
 
+func newBlockHistory(f *admindb.ForbiddenAccount, action string, operatorUserID string, now time.Time) *admindb.BlockHistory {
+	return &admindb.BlockHistory{
+		UserID:         f.UserID,
+		Action:         action,
+		Reason:         f.Reason,
+		Category:       f.Category,
+		OperatorUserID: operatorUserID,
+		ExpireTime:     f.ExpireTime,
+		CreateTime:     now,
+	}
+}
+
 func (o *AdminDatabase) BlockUser(ctx context.Context, f []*admindb.ForbiddenAccount) error {
-	return o.forbiddenAccount.Create(ctx, f)
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := o.forbiddenAccount.Create(ctx, f); err != nil {
+			return err
+		}
+		histories := make([]*admindb.BlockHistory, 0, len(f))
+		for _, block := range f {
+			histories = append(histories, newBlockHistory(block, chatconstant.BlockActionBlock, block.OperatorUserID, block.CreateTime))
+		}
+		return o.blockHistory.Create(ctx, histories...)
+	})
+}
+
+func (o *AdminDatabase) DelBlockUser(ctx context.Context, f []*admindb.ForbiddenAccount, operatorUserID string) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		userIDs := make([]string, 0, len(f))
+		histories := make([]*admindb.BlockHistory, 0, len(f))
+		now := time.Now()
+		for _, block := range f {
+			userIDs = append(userIDs, block.UserID)
+			histories = append(histories, newBlockHistory(block, chatconstant.BlockActionUnblock, operatorUserID, now))
+		}
+		if err := o.forbiddenAccount.Delete(ctx, userIDs); err != nil {
+			return err
+		}
+		return o.blockHistory.Create(ctx, histories...)
+	})
+}
+
+func (o *AdminDatabase) LiftExpiredBlock(ctx context.Context, f *admindb.ForbiddenAccount) (bool, error) {
+	var lifted bool
+	err := o.tx.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		lifted, err = o.forbiddenAccount.DeleteExpired(ctx, f.UserID, f.ExpireTime)
+		if err != nil || !lifted {
+			return err
+		}
+		return o.blockHistory.Create(ctx, newBlockHistory(f, chatconstant.BlockActionExpire, "", time.Now()))
+	})
+	return lifted, err
+}
+
+func (o *AdminDatabase) FindExpiredBlock(ctx context.Context, now time.Time, limit int64) ([]*admindb.ForbiddenAccount, error) {
+	return o.forbiddenAccount.FindExpired(ctx, now, limit)
+}
+
+func (o *AdminDatabase) SearchBlockUser(ctx context.Context, keyword string, category string, state int32, pagination pagination.Pagination) (int64, []*admindb.ForbiddenAccount, error) {
+	return o.forbiddenAccount.Search(ctx, keyword, category, state, pagination)
+}
+
+func (o *AdminDatabase) SearchBlockHistory(ctx context.Context, userIDs []string, action string, pagination pagination.Pagination) (int64, []*admindb.BlockHistory, error) {
+	return o.blockHistory.Search(ctx, userIDs, action, pagination)
+}
+
+func (o *AdminDatabase) SetAppealTicket(ctx context.Context, ticket string, t *cache.AppealTicket, expire time.Duration) error {
+	return o.appealTicket.SetAppealTicket(ctx, ticket, t, expire)
+}
+
+func (o *AdminDatabase) GetAppealTicket(ctx context.Context, ticket string) (*cache.AppealTicket, error) {
+	return o.appealTicket.GetAppealTicket(ctx, ticket)
+}
+
+func (o *AdminDatabase) AddImpersonationIMToken(ctx context.Context, t *cache.ImpersonationIMToken, expireTime time.Time) error {
+	return o.impersonation.AddImpersonationIMToken(ctx, t, expireTime)
+}
+
+func (o *AdminDatabase) TakeExpiredImpersonationIMToken(ctx context.Context, now time.Time, limit int64) ([]*cache.ImpersonationIMToken, error) {
+	return o.impersonation.TakeExpiredImpersonationIMToken(ctx, now, limit)
+}
+
+func (o *AdminDatabase) SubmitAppeal(ctx context.Context, ticket string, appeal *admindb.Appeal) error {
+	if err := o.appeal.Create(ctx, appeal); err != nil {
+		return err
+	}
+	return o.appealTicket.DelAppealTicket(ctx, ticket)
+}
+
+func (o *AdminDatabase) TakeAppeal(ctx context.Context, appealID string) (*admindb.Appeal, error) {
+	return o.appeal.Take(ctx, appealID)
 }
 
-func (o *AdminDatabase) DelBlockUser(ctx context.Context, userID []string) error {
-	return o.forbiddenAccount.Delete(ctx, userID)
+func (o *AdminDatabase) TakeAppealByBlock(ctx context.Context, userID string, blockTime time.Time) (*admindb.Appeal, error) {
+	return o.appeal.TakeByBlock(ctx, userID, blockTime)
 }
 
-func (o *AdminDatabase) SearchBlockUser(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.ForbiddenAccount, error) {
-	return o.forbiddenAccount.Search(ctx, keyword, pagination)
+func (o *AdminDatabase) SearchAppeal(ctx context.Context, keyword string, status int32, assigneeUserID string, pagination pagination.Pagination) (int64, []*admindb.Appeal, error) {
+	return o.appeal.Search(ctx, keyword, status, assigneeUserID, pagination)
+}
+
+func (o *AdminDatabase) AssignAppeal(ctx context.Context, appealIDs []string, assigneeUserID string) error {
+	return o.appeal.Assign(ctx, appealIDs, assigneeUserID)
+}
+
+func (o *AdminDatabase) HandleAppeal(ctx context.Context, appealID string, update map[string]any, unblock *admindb.ForbiddenAccount, operatorUserID string) (bool, error) {
+	var handled bool
+	err := o.tx.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		handled, err = o.appeal.UpdatePending(ctx, appealID, update)
+		if err != nil || !handled || unblock == nil {
+			return err
+		}
+		if err := o.forbiddenAccount.Delete(ctx, []string{unblock.UserID}); err != nil {
+			return err
+		}
+		return o.blockHistory.Create(ctx, newBlockHistory(unblock, chatconstant.BlockActionUnblock, operatorUserID, time.Now()))
+	})
+	return handled, err
 }
 
 func (o *AdminDatabase) FindBlockUser(ctx context.Context, userIDs []string) ([]*admindb.ForbiddenAccount, error) {
@@ -338,6 +570,103 @@ func (o *AdminDatabase) GetLimitUserLoginIP(ctx context.Context, userID string,
 	return o.limitUserLoginIP.Take(ctx, userID, ip)
 }
 
+func (o *AdminDatabase) FindAllUserLimitLogin(ctx context.Context) ([]*admindb.LimitUserLoginIP, error) {
+	return o.limitUserLoginIP.FindAll(ctx)
+}
+
+func (o *AdminDatabase) AddGeoRule(ctx context.Context, ms []*admindb.GeoRule) error {
+	return o.geoRule.Create(ctx, ms)
+}
+
+func (o *AdminDatabase) DelGeoRule(ctx context.Context, ms []*admindb.GeoRule) error {
+	return o.geoRule.Delete(ctx, ms)
+}
+
+func (o *AdminDatabase) FindAllGeoRule(ctx context.Context) ([]*admindb.GeoRule, error) {
+	return o.geoRule.FindAll(ctx)
+}
+
+func (o *AdminDatabase) SearchGeoRule(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.GeoRule, error) {
+	return o.geoRule.Search(ctx, keyword, pagination)
+}
+
+func (o *AdminDatabase) AddRole(ctx context.Context, roles []*admindb.Role) error {
+	return o.role.Create(ctx, roles)
+}
+
+func (o *AdminDatabase) UpdateRole(ctx context.Context, roleID string, update map[string]any) error {
+	return o.role.Update(ctx, roleID, update)
+}
+
+func (o *AdminDatabase) DelRole(ctx context.Context, roleIDs []string) error {
+	return o.role.Delete(ctx, roleIDs)
+}
+
+func (o *AdminDatabase) FindRole(ctx context.Context, roleIDs []string) ([]*admindb.Role, error) {
+	return o.role.Find(ctx, roleIDs)
+}
+
+func (o *AdminDatabase) SearchRole(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.Role, error) {
+	return o.role.Search(ctx, keyword, pagination)
+}
+
+func (o *AdminDatabase) ExistAdminRole(ctx context.Context, roleIDs []string) (bool, error) {
+	return o.admin.ExistRole(ctx, roleIDs)
+}
+
+// AppendAuditLog chains log to the last entry with the HMAC keyed with key, then moves the chain
+// head to it. Concurrent appends collide on the unique seq index, the loser retries on top of the
+// new last entry.
+func (o *AdminDatabase) AppendAuditLog(ctx context.Context, log *admindb.AuditLog, key []byte) error {
+	log.CreateTime = log.CreateTime.Truncate(time.Millisecond)
+	if log.ID.IsZero() {
+		log.ID = primitive.NewObjectID()
+	}
+	for i := 0; ; i++ {
+		last, err := o.auditLog.Last(ctx)
+		if err == nil {
+			log.Seq = last.Seq + 1
+			log.PrevHash = last.Hash
+		} else if dbutil.IsDBNotFound(err) {
+			log.Seq = 1
+			log.PrevHash = ""
+		} else {
+			return err
+		}
+		log.Hash = log.ComputeHash(key)
+		err = o.auditLog.Create(ctx, log)
+		if err == nil {
+			break
+		}
+		if !mongo.IsDuplicateKeyError(errs.Unwrap(err)) || i >= 10 {
+			return err
+		}
+	}
+	head := &admindb.AuditLogHead{Seq: log.Seq, Hash: log.Hash}
+	head.MAC = head.ComputeMAC(key)
+	return o.auditLog.AdvanceHead(ctx, head)
+}
+
+func (o *AdminDatabase) FindAuditLogAfter(ctx context.Context, seq int64, limit int64) ([]*admindb.AuditLog, error) {
+	return o.auditLog.FindAfter(ctx, seq, limit)
+}
+
+func (o *AdminDatabase) FindAuditLogByOperationID(ctx context.Context, operationID string) ([]*admindb.AuditLog, error) {
+	return o.auditLog.FindByOperationID(ctx, operationID)
+}
+
+func (o *AdminDatabase) ScanAuditLog(ctx context.Context, operatorUserIDs []string, action string, target string, start time.Time, end time.Time, cursor primitive.ObjectID, limit int64) ([]*admindb.AuditLog, error) {
+	return o.auditLog.Scan(ctx, operatorUserIDs, action, target, start, end, cursor, limit)
+}
+
+func (o *AdminDatabase) TakeAuditLogHead(ctx context.Context) (*admindb.AuditLogHead, error) {
+	return o.auditLog.TakeHead(ctx)
+}
+
+func (o *AdminDatabase) SearchAuditLog(ctx context.Context, operatorUserIDs []string, action string, target string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*admindb.AuditLog, error) {
+	return o.auditLog.Search(ctx, operatorUserIDs, action, target, start, end, pagination)
+}
+
 func (o *AdminDatabase) CacheToken(ctx context.Context, userID string, token string, expire time.Duration) error {
 	isSet, err := o.cache.AddTokenFlagNXEx(ctx, userID, token, constant.NormalToken, expire)
 	if err != nil {
@@ -357,7 +686,41 @@ func (o *AdminDatabase) GetTokens(ctx context.Context, userID string) (map[strin
 }
 
 func (o *AdminDatabase) DeleteToken(ctx context.Context, userID string) error {
-	return o.cache.DeleteTokenByUid(ctx, userID)
+	if err := o.cache.DeleteTokenByUid(ctx, userID); err != nil {
+		return err
+	}
+	return o.session.DeleteSessionsByUid(ctx, userID)
+}
+
+func (o *AdminDatabase) SetSession(ctx context.Context, userID string, session *cache.Session) error {
+	return o.session.SetSession(ctx, userID, session)
+}
+
+func (o *AdminDatabase) GetSession(ctx context.Context, userID string, sessionID string) (*cache.Session, error) {
+	return o.session.GetSession(ctx, userID, sessionID)
+}
+
+func (o *AdminDatabase) GetSessions(ctx context.Context, userID string) ([]*cache.Session, error) {
+	return o.session.GetSessions(ctx, userID)
+}
+
+func (o *AdminDatabase) RevokeSession(ctx context.Context, userID string, session *cache.Session) error {
+	if err := o.cache.DeleteTokens(ctx, userID, session.Token); err != nil {
+		return err
+	}
+	return o.session.DelSessions(ctx, userID, session.SessionID)
+}
+
+func (o *AdminDatabase) DeleteTokens(ctx context.Context, userID string, tokens ...string) error {
+	return o.cache.DeleteTokens(ctx, userID, tokens...)
+}
+
+func (o *AdminDatabase) SetRefreshToken(ctx context.Context, token string, rt *cache.RefreshToken, expire time.Duration) error {
+	return o.refreshToken.SetRefreshToken(ctx, token, rt, expire)
+}
+
+func (o *AdminDatabase) UseRefreshToken(ctx context.Context, token string) (*cache.RefreshToken, bool, error) {
+	return o.refreshToken.UseRefreshToken(ctx, token)
 }
 
 func (o *AdminDatabase) LatestVersion(ctx context.Context, platform string) (*admindb.Application, error) {
@@ -379,3 +742,83 @@ func (o *AdminDatabase) DeleteVersion(ctx context.Context, id []primitive.Object
 func (o *AdminDatabase) PageVersion(ctx context.Context, platforms []string, page pagination.Pagination) (int64, []*admindb.Application, error) {
 	return o.application.PageVersion(ctx, platforms, page)
 }
+
+func (o *AdminDatabase) TakeAdminTOTP(ctx context.Context, userID string) (*admindb.AdminTOTP, error) {
+	return o.adminTOTP.Take(ctx, userID)
+}
+
+func (o *AdminDatabase) SetAdminTOTP(ctx context.Context, totp *admindb.AdminTOTP) error {
+	return o.adminTOTP.Set(ctx, totp)
+}
+
+func (o *AdminDatabase) UpdateAdminTOTP(ctx context.Context, userID string, data map[string]any) error {
+	return o.adminTOTP.Update(ctx, userID, data)
+}
+
+func (o *AdminDatabase) UpdateAdminTOTPCounter(ctx context.Context, userID string, counter int64) (bool, error) {
+	return o.adminTOTP.UpdateCounter(ctx, userID, counter)
+}
+
+func (o *AdminDatabase) UseAdminTOTPRecoveryCode(ctx context.Context, userID string, hash string) (bool, error) {
+	return o.adminTOTP.UseRecoveryCode(ctx, userID, hash)
+}
+
+func (o *AdminDatabase) DelAdminTOTP(ctx context.Context, userIDs []string) error {
+	return o.adminTOTP.Delete(ctx, userIDs)
+}
+
+func (o *AdminDatabase) SetLoginChallenge(ctx context.Context, ticket string, challenge *cache.LoginChallenge, expire time.Duration) error {
+	return o.loginChallenge.SetLoginChallenge(ctx, ticket, challenge, expire)
+}
+
+func (o *AdminDatabase) GetLoginChallenge(ctx context.Context, ticket string) (*cache.LoginChallenge, error) {
+	return o.loginChallenge.GetLoginChallenge(ctx, ticket)
+}
+
+func (o *AdminDatabase) IncrLoginChallengeAttempts(ctx context.Context, ticket string) (int64, error) {
+	return o.loginChallenge.IncrLoginChallengeAttempts(ctx, ticket)
+}
+
+func (o *AdminDatabase) DelLoginChallenge(ctx context.Context, ticket string) error {
+	return o.loginChallenge.DelLoginChallenge(ctx, ticket)
+}
+
+func (o *AdminDatabase) GetLoginLockRemaining(ctx context.Context, target string) (time.Duration, error) {
+	return o.loginLock.GetLoginLockRemaining(ctx, target)
+}
+
+func (o *AdminDatabase) AddLoginFailure(ctx context.Context, target string, policy cache.LockPolicy) (time.Duration, error) {
+	return o.loginLock.AddLoginFailure(ctx, target, policy)
+}
+
+func (o *AdminDatabase) ResetLoginFailures(ctx context.Context, target string) error {
+	return o.loginLock.ResetLoginFailures(ctx, target)
+}
+
+func (o *AdminDatabase) loginLockOf(admin bool) cache.LoginLockInterface {
+	if admin {
+		return o.loginLock
+	}
+	return o.userLoginLock
+}
+
+func (o *AdminDatabase) SearchLoginLocks(ctx context.Context, admin bool, pagination pagination.Pagination) (int64, []*cache.LoginLock, error) {
+	offset := int64(pagination.GetPageNumber()-1) * int64(pagination.GetShowNumber())
+	return o.loginLockOf(admin).SearchLoginLocks(ctx, max(offset, 0), int64(pagination.GetShowNumber()))
+}
+
+func (o *AdminDatabase) GetLoginLocks(ctx context.Context, admin bool, targets []string) ([]*cache.LoginLock, error) {
+	locks := make([]*cache.LoginLock, 0, len(targets))
+	for _, target := range targets {
+		lock, err := o.loginLockOf(admin).GetLoginLock(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+		locks = append(locks, lock)
+	}
+	return locks, nil
+}
+
+func (o *AdminDatabase) ClearLoginLocks(ctx context.Context, admin bool, targets []string) error {
+	return o.loginLockOf(admin).ClearLoginLocks(ctx, targets...)
+}
@@ -21,8 +21,10 @@ import (
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/pagination"
 	"github.com/openimsdk/tools/db/tx"
+	"github.com/redis/go-redis/v9"
 
 	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
 	admindb "github.com/openimsdk/chat/pkg/common/db/model/admin"
 	"github.com/openimsdk/chat/pkg/common/db/model/chat"
 	"github.com/openimsdk/chat/pkg/common/db/table/admin"
@@ -41,7 +43,7 @@ type ChatDatabaseInterface interface {
 	TakeAccount(ctx context.Context, userID string) (*chatdb.Account, error)
 	TakeCredentialByAccount(ctx context.Context, account string) (*chatdb.Credential, error)
 	TakeCredentialsByUserID(ctx context.Context, userID string) ([]*chatdb.Credential, error)
-	TakeLastVerifyCode(ctx context.Context, account string) (*chatdb.VerifyCode, error)
+	TakeLastVerifyCode(ctx context.Context, account string, usedFor int32) (*chatdb.VerifyCode, error)
 	Search(ctx context.Context, normalUser int32, keyword string, gender int32, pagination pagination.Pagination) (int64, []*chatdb.Attribute, error)
 	SearchUser(ctx context.Context, keyword string, userIDs []string, genders []int32, pagination pagination.Pagination) (int64, []*chatdb.Attribute, error)
 	CountVerifyCodeRange(ctx context.Context, account string, start time.Time, end time.Time) (int64, error)
@@ -50,15 +52,59 @@ type ChatDatabaseInterface interface {
 	DelVerifyCode(ctx context.Context, id string) error
 	RegisterUser(ctx context.Context, register *chatdb.Register, account *chatdb.Account, attribute *chatdb.Attribute, credentials []*chatdb.Credential) error
 	LoginRecord(ctx context.Context, record *chatdb.UserLoginRecord, verifyCodeID *string) error
+	FindRecentLoginRecord(ctx context.Context, userID string, after time.Time, limit int64) ([]*chatdb.UserLoginRecord, error)
+	AddSuspiciousLogin(ctx context.Context, event *chatdb.SuspiciousLogin) error
+	SearchSuspiciousLogin(ctx context.Context, userIDs []string, reason string, result string, pagination pagination.Pagination) (int64, []*chatdb.SuspiciousLogin, error)
+	UpsertReport(ctx context.Context, report *chatdb.Report) (*chatdb.Report, error)
+	SearchReport(ctx context.Context, targetType int32, targetID string, status int32, category string, pagination pagination.Pagination) (int64, []*chatdb.Report, error)
+	SearchReportTarget(ctx context.Context, targetType int32, status int32, pagination pagination.Pagination) (int64, []*chatdb.ReportTarget, error)
+	ClaimReport(ctx context.Context, targetType int32, targetID string, handleID string, now time.Time, staleBefore time.Time) (int64, error)
+	FinishReport(ctx context.Context, handleID string, update map[string]any) (int64, error)
+	ReleaseReport(ctx context.Context, handleID string) error
+	AddModerationRule(ctx context.Context, rule *chatdb.ModerationRule) error
+	TakeModerationRule(ctx context.Context, ruleID string) (*chatdb.ModerationRule, error)
+	UpdateModerationRule(ctx context.Context, ruleID string, data map[string]any) error
+	DelModerationRule(ctx context.Context, ruleIDs []string) error
+	FindAllModerationRule(ctx context.Context) ([]*chatdb.ModerationRule, error)
+	SearchModerationRule(ctx context.Context, keyword string, language string, pagination pagination.Pagination) (int64, []*chatdb.ModerationRule, error)
+	AddModerationAllowUser(ctx context.Context, users []*chatdb.ModerationAllowUser) error
+	DelModerationAllowUser(ctx context.Context, userIDs []string) error
+	FindAllModerationAllowUser(ctx context.Context) ([]*chatdb.ModerationAllowUser, error)
+	SearchModerationAllowUser(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*chatdb.ModerationAllowUser, error)
+	AddDelivery(ctx context.Context, delivery *chatdb.Delivery) error
+	UpdateDelivery(ctx context.Context, deliveryID string, data map[string]any) error
+	ClaimDelivery(ctx context.Context, now time.Time, lease time.Time) (*chatdb.Delivery, error)
+	SearchDelivery(ctx context.Context, account string, userID string, channel string, status int32, pagination pagination.Pagination) (int64, []*chatdb.Delivery, error)
+	SetMessageTemplate(ctx context.Context, template *chatdb.MessageTemplate) error
+	DelMessageTemplate(ctx context.Context, channel string, usedFor int32, locale string) error
+	FindAllMessageTemplate(ctx context.Context) ([]*chatdb.MessageTemplate, error)
+	SearchMessageTemplate(ctx context.Context, channel string, locale string, pagination pagination.Pagination) (int64, []*chatdb.MessageTemplate, error)
 	UpdatePassword(ctx context.Context, userID string, password string) error
 	UpdatePasswordAndDeleteVerifyCode(ctx context.Context, userID string, password string, codeID string) error
 	NewUserCountTotal(ctx context.Context, before *time.Time) (int64, error)
 	UserLoginCountTotal(ctx context.Context, before *time.Time) (int64, error)
 	UserLoginCountRangeEverydayTotal(ctx context.Context, start *time.Time, end *time.Time) (map[string]int64, int64, error)
 	DelUserAccount(ctx context.Context, userIDs []string) error
+	TakeTOTP(ctx context.Context, userID string) (*chatdb.TOTP, error)
+	SetTOTP(ctx context.Context, totp *chatdb.TOTP) error
+	UpdateTOTP(ctx context.Context, userID string, data map[string]any) error
+	UpdateTOTPCounter(ctx context.Context, userID string, counter int64) (bool, error)
+	UseTOTPRecoveryCode(ctx context.Context, userID string, hash string) (bool, error)
+	DelTOTP(ctx context.Context, userIDs []string) error
+	SetLoginChallenge(ctx context.Context, ticket string, challenge *cache.LoginChallenge, expire time.Duration) error
+	GetLoginChallenge(ctx context.Context, ticket string) (*cache.LoginChallenge, error)
+	IncrLoginChallengeAttempts(ctx context.Context, ticket string) (int64, error)
+	DelLoginChallenge(ctx context.Context, ticket string) error
+	GetLoginLockRemaining(ctx context.Context, target string) (time.Duration, error)
+	AddLoginFailure(ctx context.Context, target string, policy cache.LockPolicy) (time.Duration, error)
+	ResetLoginFailures(ctx context.Context, target string) error
+	// GetLoginFailures returns the failures of target within the lock window, including those a lock reset.
+	GetLoginFailures(ctx context.Context, target string) (int64, error)
+	SetCaptcha(ctx context.Context, id string, answer string, expire time.Duration) error
+	TakeCaptcha(ctx context.Context, id string) (string, error)
 }
 
-func NewChatDatabase(cli *mongoutil.Client) (ChatDatabaseInterface, error) {
+func NewChatDatabase(cli *mongoutil.Client, rdb redis.UniversalClient) (ChatDatabaseInterface, error) {
 	register, err := chat.NewRegister(cli.GetDB())
 	if err != nil {
 		return nil, err
@@ -79,6 +125,30 @@ func NewChatDatabase(cli *mongoutil.Client) (ChatDatabaseInterface, error) {
 	if err != nil {
 		return nil, err
 	}
+	suspiciousLogin, err := chat.NewSuspiciousLogin(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	report, err := chat.NewReport(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	moderationRule, err := chat.NewModerationRule(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	moderationAllowUser, err := chat.NewModerationAllowUser(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	delivery, err := chat.NewDelivery(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	messageTemplate, err := chat.NewMessageTemplate(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
 	verifyCode, err := chat.NewVerifyCode(cli.GetDB())
 	if err != nil {
 		return nil, err
@@ -87,27 +157,51 @@ func NewChatDatabase(cli *mongoutil.Client) (ChatDatabaseInterface, error) {
 	if err != nil {
 		return nil, err
 	}
+	totp, err := chat.NewTOTP(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
 	return &ChatDatabase{
-		tx:               cli.GetTx(),
-		register:         register,
-		account:          account,
-		attribute:        attribute,
-		credential:       credential,
-		userLoginRecord:  userLoginRecord,
-		verifyCode:       verifyCode,
-		forbiddenAccount: forbiddenAccount,
+		tx:                  cli.GetTx(),
+		register:            register,
+		account:             account,
+		attribute:           attribute,
+		credential:          credential,
+		userLoginRecord:     userLoginRecord,
+		suspiciousLogin:     suspiciousLogin,
+		report:              report,
+		moderationRule:      moderationRule,
+		moderationAllowUser: moderationAllowUser,
+		delivery:            delivery,
+		messageTemplate:     messageTemplate,
+		verifyCode:          verifyCode,
+		forbiddenAccount:    forbiddenAccount,
+		totp:                totp,
+		loginChallenge:      cache.NewLoginChallengeInterface(rdb),
+		loginLock:           cache.NewLoginLockInterface(rdb),
+		captcha:             cache.NewCaptchaInterface(rdb),
 	}, nil
 }
 
 type ChatDatabase struct {
-	tx               tx.Tx
-	register         chatdb.RegisterInterface
-	account          chatdb.AccountInterface
-	attribute        chatdb.AttributeInterface
-	credential       chatdb.CredentialInterface
-	userLoginRecord  chatdb.UserLoginRecordInterface
-	verifyCode       chatdb.VerifyCodeInterface
-	forbiddenAccount admin.ForbiddenAccountInterface
+	tx                  tx.Tx
+	register            chatdb.RegisterInterface
+	account             chatdb.AccountInterface
+	attribute           chatdb.AttributeInterface
+	credential          chatdb.CredentialInterface
+	userLoginRecord     chatdb.UserLoginRecordInterface
+	suspiciousLogin     chatdb.SuspiciousLoginInterface
+	report              chatdb.ReportInterface
+	moderationRule      chatdb.ModerationRuleInterface
+	moderationAllowUser chatdb.ModerationAllowUserInterface
+	delivery            chatdb.DeliveryInterface
+	messageTemplate     chatdb.MessageTemplateInterface
+	verifyCode          chatdb.VerifyCodeInterface
+	forbiddenAccount    admin.ForbiddenAccountInterface
+	totp                chatdb.TOTPInterface
+	loginChallenge      cache.LoginChallengeInterface
+	loginLock           cache.LoginLockInterface
+	captcha             cache.CaptchaInterface
 }
 
 func (o *ChatDatabase) GetUser(ctx context.Context, userID string) (account *chatdb.Account, err error) {
@@ -155,8 +249,8 @@ func (o *ChatDatabase) TakeAttributeByUserID(ctx context.Context, userID string)
 	return o.attribute.Take(ctx, userID)
 }
 
-func (o *ChatDatabase) TakeLastVerifyCode(ctx context.Context, account string) (*chatdb.VerifyCode, error) {
-	return o.verifyCode.TakeLast(ctx, account)
+func (o *ChatDatabase) TakeLastVerifyCode(ctx context.Context, account string, usedFor int32) (*chatdb.VerifyCode, error) {
+	return o.verifyCode.TakeLast(ctx, account, usedFor)
 }
 
 func (o *ChatDatabase) TakeAccount(ctx context.Context, userID string) (*chatdb.Account, error) {
@@ -288,6 +382,185 @@ func (o *ChatDatabase) DelUserAccount(ctx context.Context, userIDs []string) err
 		if err := o.attribute.Delete(ctx, userIDs); err != nil {
 			return err
 		}
+		if err := o.totp.Delete(ctx, userIDs); err != nil {
+			return err
+		}
 		return nil
 	})
 }
+
+func (o *ChatDatabase) TakeTOTP(ctx context.Context, userID string) (*chatdb.TOTP, error) {
+	return o.totp.Take(ctx, userID)
+}
+
+func (o *ChatDatabase) SetTOTP(ctx context.Context, totp *chatdb.TOTP) error {
+	return o.totp.Set(ctx, totp)
+}
+
+func (o *ChatDatabase) UpdateTOTP(ctx context.Context, userID string, data map[string]any) error {
+	return o.totp.Update(ctx, userID, data)
+}
+
+func (o *ChatDatabase) UpdateTOTPCounter(ctx context.Context, userID string, counter int64) (bool, error) {
+	return o.totp.UpdateCounter(ctx, userID, counter)
+}
+
+func (o *ChatDatabase) UseTOTPRecoveryCode(ctx context.Context, userID string, hash string) (bool, error) {
+	return o.totp.UseRecoveryCode(ctx, userID, hash)
+}
+
+func (o *ChatDatabase) DelTOTP(ctx context.Context, userIDs []string) error {
+	return o.totp.Delete(ctx, userIDs)
+}
+
+func (o *ChatDatabase) SetLoginChallenge(ctx context.Context, ticket string, challenge *cache.LoginChallenge, expire time.Duration) error {
+	return o.loginChallenge.SetLoginChallenge(ctx, ticket, challenge, expire)
+}
+
+func (o *ChatDatabase) GetLoginChallenge(ctx context.Context, ticket string) (*cache.LoginChallenge, error) {
+	return o.loginChallenge.GetLoginChallenge(ctx, ticket)
+}
+
+func (o *ChatDatabase) IncrLoginChallengeAttempts(ctx context.Context, ticket string) (int64, error) {
+	return o.loginChallenge.IncrLoginChallengeAttempts(ctx, ticket)
+}
+
+func (o *ChatDatabase) DelLoginChallenge(ctx context.Context, ticket string) error {
+	return o.loginChallenge.DelLoginChallenge(ctx, ticket)
+}
+
+func (o *ChatDatabase) GetLoginLockRemaining(ctx context.Context, target string) (time.Duration, error) {
+	return o.loginLock.GetLoginLockRemaining(ctx, target)
+}
+
+func (o *ChatDatabase) AddLoginFailure(ctx context.Context, target string, policy cache.LockPolicy) (time.Duration, error) {
+	return o.loginLock.AddLoginFailure(ctx, target, policy)
+}
+
+func (o *ChatDatabase) ResetLoginFailures(ctx context.Context, target string) error {
+	return o.loginLock.ResetLoginFailures(ctx, target)
+}
+
+func (o *ChatDatabase) GetLoginFailures(ctx context.Context, target string) (int64, error) {
+	lock, err := o.loginLock.GetLoginLock(ctx, target)
+	if err != nil {
+		return 0, err
+	}
+	return lock.Recent, nil
+}
+
+func (o *ChatDatabase) SetCaptcha(ctx context.Context, id string, answer string, expire time.Duration) error {
+	return o.captcha.SetCaptcha(ctx, id, answer, expire)
+}
+
+func (o *ChatDatabase) TakeCaptcha(ctx context.Context, id string) (string, error) {
+	return o.captcha.TakeCaptcha(ctx, id)
+}
+
+func (o *ChatDatabase) FindRecentLoginRecord(ctx context.Context, userID string, after time.Time, limit int64) ([]*chatdb.UserLoginRecord, error) {
+	return o.userLoginRecord.FindRecent(ctx, userID, after, limit)
+}
+
+func (o *ChatDatabase) AddSuspiciousLogin(ctx context.Context, event *chatdb.SuspiciousLogin) error {
+	return o.suspiciousLogin.Create(ctx, event)
+}
+
+func (o *ChatDatabase) SearchSuspiciousLogin(ctx context.Context, userIDs []string, reason string, result string, pagination pagination.Pagination) (int64, []*chatdb.SuspiciousLogin, error) {
+	return o.suspiciousLogin.Search(ctx, userIDs, reason, result, pagination)
+}
+
+func (o *ChatDatabase) UpsertReport(ctx context.Context, report *chatdb.Report) (*chatdb.Report, error) {
+	return o.report.Upsert(ctx, report)
+}
+
+func (o *ChatDatabase) SearchReport(ctx context.Context, targetType int32, targetID string, status int32, category string, pagination pagination.Pagination) (int64, []*chatdb.Report, error) {
+	return o.report.Search(ctx, targetType, targetID, status, category, pagination)
+}
+
+func (o *ChatDatabase) SearchReportTarget(ctx context.Context, targetType int32, status int32, pagination pagination.Pagination) (int64, []*chatdb.ReportTarget, error) {
+	return o.report.SearchTarget(ctx, targetType, status, pagination)
+}
+
+func (o *ChatDatabase) ClaimReport(ctx context.Context, targetType int32, targetID string, handleID string, now time.Time, staleBefore time.Time) (int64, error) {
+	return o.report.Claim(ctx, targetType, targetID, handleID, now, staleBefore)
+}
+
+func (o *ChatDatabase) FinishReport(ctx context.Context, handleID string, update map[string]any) (int64, error) {
+	return o.report.Finish(ctx, handleID, update)
+}
+
+func (o *ChatDatabase) ReleaseReport(ctx context.Context, handleID string) error {
+	return o.report.Release(ctx, handleID)
+}
+
+func (o *ChatDatabase) AddModerationRule(ctx context.Context, rule *chatdb.ModerationRule) error {
+	return o.moderationRule.Create(ctx, rule)
+}
+
+func (o *ChatDatabase) TakeModerationRule(ctx context.Context, ruleID string) (*chatdb.ModerationRule, error) {
+	return o.moderationRule.Take(ctx, ruleID)
+}
+
+func (o *ChatDatabase) UpdateModerationRule(ctx context.Context, ruleID string, data map[string]any) error {
+	return o.moderationRule.Update(ctx, ruleID, data)
+}
+
+func (o *ChatDatabase) DelModerationRule(ctx context.Context, ruleIDs []string) error {
+	return o.moderationRule.Delete(ctx, ruleIDs)
+}
+
+func (o *ChatDatabase) FindAllModerationRule(ctx context.Context) ([]*chatdb.ModerationRule, error) {
+	return o.moderationRule.FindAll(ctx)
+}
+
+func (o *ChatDatabase) SearchModerationRule(ctx context.Context, keyword string, language string, pagination pagination.Pagination) (int64, []*chatdb.ModerationRule, error) {
+	return o.moderationRule.Search(ctx, keyword, language, pagination)
+}
+
+func (o *ChatDatabase) AddModerationAllowUser(ctx context.Context, users []*chatdb.ModerationAllowUser) error {
+	return o.moderationAllowUser.Create(ctx, users)
+}
+
+func (o *ChatDatabase) DelModerationAllowUser(ctx context.Context, userIDs []string) error {
+	return o.moderationAllowUser.Delete(ctx, userIDs)
+}
+
+func (o *ChatDatabase) FindAllModerationAllowUser(ctx context.Context) ([]*chatdb.ModerationAllowUser, error) {
+	return o.moderationAllowUser.FindAll(ctx)
+}
+
+func (o *ChatDatabase) SearchModerationAllowUser(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*chatdb.ModerationAllowUser, error) {
+	return o.moderationAllowUser.Search(ctx, keyword, pagination)
+}
+
+func (o *ChatDatabase) AddDelivery(ctx context.Context, delivery *chatdb.Delivery) error {
+	return o.delivery.Create(ctx, delivery)
+}
+
+func (o *ChatDatabase) UpdateDelivery(ctx context.Context, deliveryID string, data map[string]any) error {
+	return o.delivery.Update(ctx, deliveryID, data)
+}
+
+func (o *ChatDatabase) ClaimDelivery(ctx context.Context, now time.Time, lease time.Time) (*chatdb.Delivery, error) {
+	return o.delivery.Claim(ctx, now, lease)
+}
+
+func (o *ChatDatabase) SearchDelivery(ctx context.Context, account string, userID string, channel string, status int32, pagination pagination.Pagination) (int64, []*chatdb.Delivery, error) {
+	return o.delivery.Search(ctx, account, userID, channel, status, pagination)
+}
+
+func (o *ChatDatabase) SetMessageTemplate(ctx context.Context, template *chatdb.MessageTemplate) error {
+	return o.messageTemplate.Set(ctx, template)
+}
+
+func (o *ChatDatabase) DelMessageTemplate(ctx context.Context, channel string, usedFor int32, locale string) error {
+	return o.messageTemplate.Delete(ctx, channel, usedFor, locale)
+}
+
+func (o *ChatDatabase) FindAllMessageTemplate(ctx context.Context) ([]*chatdb.MessageTemplate, error) {
+	return o.messageTemplate.FindAll(ctx)
+}
+
+func (o *ChatDatabase) SearchMessageTemplate(ctx context.Context, channel string, locale string, pagination pagination.Pagination) (int64, []*chatdb.MessageTemplate, error) {
+	return o.messageTemplate.Search(ctx, channel, locale, pagination)
+}
@@ -82,3 +82,7 @@ func (o *Admin) Search(ctx context.Context, pagination pagination.Pagination) (i
 	filter := bson.M{"level": constant.NormalAdmin}
 	return mongoutil.FindPage[*admindb.Admin](ctx, o.coll, filter, pagination, opt)
 }
+
+func (o *Admin) ExistRole(ctx context.Context, roleIDs []string) (bool, error) {
+	return mongoutil.Exist(ctx, o.coll, bson.M{"role_ids": bson.M{"$in": roleIDs}})
+}
@@ -0,0 +1,110 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/table/admin"
+)
+
+func NewAppeal(db *mongo.Database) (admin.AppealInterface, error) {
+	coll := db.Collection("appeal")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "appeal_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "block_time", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "create_time", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &Appeal{coll: coll}, nil
+}
+
+type Appeal struct {
+	coll *mongo.Collection
+}
+
+func (o *Appeal) Create(ctx context.Context, appeal *admin.Appeal) error {
+	return mongoutil.InsertMany(ctx, o.coll, []*admin.Appeal{appeal})
+}
+
+func (o *Appeal) Take(ctx context.Context, appealID string) (*admin.Appeal, error) {
+	return mongoutil.FindOne[*admin.Appeal](ctx, o.coll, bson.M{"appeal_id": appealID})
+}
+
+func (o *Appeal) TakeByBlock(ctx context.Context, userID string, blockTime time.Time) (*admin.Appeal, error) {
+	return mongoutil.FindOne[*admin.Appeal](ctx, o.coll, bson.M{"user_id": userID, "block_time": blockTime})
+}
+
+func (o *Appeal) Search(ctx context.Context, keyword string, status int32, assigneeUserID string, pagination pagination.Pagination) (int64, []*admin.Appeal, error) {
+	filter := bson.M{}
+	if keyword != "" {
+		filter["$or"] = []bson.M{
+			{"user_id": bson.M{"$regex": keyword, "$options": "i"}},
+			{"content": bson.M{"$regex": keyword, "$options": "i"}},
+		}
+	}
+	if status != constant.AppealStatusAll {
+		filter["status"] = status
+	}
+	if assigneeUserID != "" {
+		filter["assignee_user_id"] = assigneeUserID
+	}
+	// oldest first, so pending appeals are handled in order
+	opt := options.Find().SetSort(bson.D{{Key: "create_time", Value: 1}})
+	return mongoutil.FindPage[*admin.Appeal](ctx, o.coll, filter, pagination, opt)
+}
+
+func (o *Appeal) Assign(ctx context.Context, appealIDs []string, assigneeUserID string) error {
+	if len(appealIDs) == 0 {
+		return nil
+	}
+	_, err := mongoutil.UpdateMany(ctx, o.coll, bson.M{"appeal_id": bson.M{"$in": appealIDs}}, bson.M{"$set": bson.M{"assignee_user_id": assigneeUserID}})
+	return err
+}
+
+func (o *Appeal) UpdatePending(ctx context.Context, appealID string, update map[string]any) (bool, error) {
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, bson.M{"appeal_id": appealID, "status": constant.AppealStatusPending}, bson.M{"$set": update})
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount > 0, nil
+}
@@ -0,0 +1,140 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/tools/errs"
+)
+
+func NewAuditLog(db *mongo.Database) (admin.AuditLogInterface, error) {
+	coll := db.Collection("audit_log")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "seq", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "operator_user_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{{Key: "create_time", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "operation_id", Value: 1}},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &AuditLog{
+		coll:     coll,
+		headColl: db.Collection("audit_log_head"),
+	}, nil
+}
+
+// auditLogHeadID is the _id of the only document of the head collection.
+const auditLogHeadID = "audit_log"
+
+type AuditLog struct {
+	coll     *mongo.Collection
+	headColl *mongo.Collection // apart from coll, a deleted entry has to be removed from both
+}
+
+func (o *AuditLog) Create(ctx context.Context, log *admin.AuditLog) error {
+	return mongoutil.InsertMany(ctx, o.coll, []*admin.AuditLog{log})
+}
+
+func (o *AuditLog) Last(ctx context.Context) (*admin.AuditLog, error) {
+	return mongoutil.FindOne[*admin.AuditLog](ctx, o.coll, bson.M{}, options.FindOne().SetSort(bson.D{{Key: "seq", Value: -1}}))
+}
+
+func (o *AuditLog) FindAfter(ctx context.Context, seq int64, limit int64) ([]*admin.AuditLog, error) {
+	opt := options.Find().SetSort(bson.D{{Key: "seq", Value: 1}}).SetLimit(limit)
+	return mongoutil.Find[*admin.AuditLog](ctx, o.coll, bson.M{"seq": bson.M{"$gt": seq}}, opt)
+}
+
+func (o *AuditLog) FindByOperationID(ctx context.Context, operationID string) ([]*admin.AuditLog, error) {
+	opt := options.Find().SetSort(bson.D{{Key: "seq", Value: 1}})
+	return mongoutil.Find[*admin.AuditLog](ctx, o.coll, bson.M{"operation_id": operationID}, opt)
+}
+
+func (o *AuditLog) searchFilter(operatorUserIDs []string, action string, target string, start time.Time, end time.Time) bson.M {
+	filter := bson.M{}
+	if len(operatorUserIDs) > 0 {
+		filter["operator_user_id"] = bson.M{"$in": operatorUserIDs}
+	}
+	if action != "" {
+		filter["action"] = bson.M{"$regex": regexp.QuoteMeta(action), "$options": "i"}
+	}
+	if target != "" {
+		filter["target"] = bson.M{"$regex": regexp.QuoteMeta(target), "$options": "i"}
+	}
+	createTime := bson.M{}
+	if !start.IsZero() {
+		createTime["$gte"] = start
+	}
+	if !end.IsZero() {
+		createTime["$lt"] = end
+	}
+	if len(createTime) > 0 {
+		filter["create_time"] = createTime
+	}
+	return filter
+}
+
+func (o *AuditLog) Search(ctx context.Context, operatorUserIDs []string, action string, target string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*admin.AuditLog, error) {
+	filter := o.searchFilter(operatorUserIDs, action, target, start, end)
+	opt := options.Find().SetSort(bson.D{{Key: "seq", Value: -1}})
+	return mongoutil.FindPage[*admin.AuditLog](ctx, o.coll, filter, pagination, opt)
+}
+
+func (o *AuditLog) Scan(ctx context.Context, operatorUserIDs []string, action string, target string, start time.Time, end time.Time, cursor primitive.ObjectID, limit int64) ([]*admin.AuditLog, error) {
+	filter := o.searchFilter(operatorUserIDs, action, target, start, end)
+	if !cursor.IsZero() {
+		filter["_id"] = bson.M{"$lt": cursor}
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "_id", Value: -1}}).SetLimit(limit)
+	return mongoutil.Find[*admin.AuditLog](ctx, o.coll, filter, opt)
+}
+
+func (o *AuditLog) TakeHead(ctx context.Context) (*admin.AuditLogHead, error) {
+	return mongoutil.FindOne[*admin.AuditLogHead](ctx, o.headColl, bson.M{"_id": auditLogHeadID})
+}
+
+func (o *AuditLog) AdvanceHead(ctx context.Context, head *admin.AuditLogHead) error {
+	filter := bson.M{"_id": auditLogHeadID, "seq": bson.M{"$lt": head.Seq}}
+	update := bson.M{"$set": bson.M{"seq": head.Seq, "hash": head.Hash, "mac": head.MAC}}
+	err := mongoutil.UpdateOne(ctx, o.headColl, filter, update, false, options.Update().SetUpsert(true))
+	if err != nil && mongo.IsDuplicateKeyError(errs.Unwrap(err)) {
+		// the stored head is at a later entry already
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,75 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/admin"
+)
+
+func NewBlockHistory(db *mongo.Database) (admin.BlockHistoryInterface, error) {
+	coll := db.Collection("block_history")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "create_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &BlockHistory{coll: coll}, nil
+}
+
+type BlockHistory struct {
+	coll *mongo.Collection
+}
+
+func (o *BlockHistory) Create(ctx context.Context, ms ...*admin.BlockHistory) error {
+	for _, m := range ms {
+		if m.ID.IsZero() {
+			m.ID = primitive.NewObjectID()
+		}
+	}
+	return mongoutil.InsertMany(ctx, o.coll, ms)
+}
+
+func (o *BlockHistory) Search(ctx context.Context, userIDs []string, action string, pagination pagination.Pagination) (int64, []*admin.BlockHistory, error) {
+	filter := bson.M{}
+	if len(userIDs) > 0 {
+		filter["user_id"] = bson.M{"$in": userIDs}
+	}
+	if action != "" {
+		filter["action"] = action
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*admin.BlockHistory](ctx, o.coll, filter, pagination, opt)
+}
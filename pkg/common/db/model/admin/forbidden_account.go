@@ -16,6 +16,7 @@ package admin
 
 import (
 	"context"
+	"time"
 
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/pagination"
@@ -23,17 +24,25 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/db/table/admin"
 	"github.com/openimsdk/tools/errs"
 )
 
 func NewForbiddenAccount(db *mongo.Database) (admin.ForbiddenAccountInterface, error) {
 	coll := db.Collection("forbidden_account")
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "user_id", Value: 1},
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "expire_time", Value: 1},
+			},
 		},
-		Options: options.Index().SetUnique(true),
 	})
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -62,25 +71,59 @@ func (o *ForbiddenAccount) Delete(ctx context.Context, userIDs []string) error {
 	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
 }
 
+func (o *ForbiddenAccount) DeleteExpired(ctx context.Context, userID string, expireTime time.Time) (bool, error) {
+	res, err := mongoutil.DeleteOneResult(ctx, o.coll, bson.M{"user_id": userID, "expire_time": expireTime})
+	if err != nil {
+		return false, err
+	}
+	return res.DeletedCount > 0, nil
+}
+
 func (o *ForbiddenAccount) Find(ctx context.Context, userIDs []string) ([]*admin.ForbiddenAccount, error) {
 	return mongoutil.Find[*admin.ForbiddenAccount](ctx, o.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
 }
 
-func (o *ForbiddenAccount) Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admin.ForbiddenAccount, error) {
-	filter := bson.M{}
+func (o *ForbiddenAccount) FindExpired(ctx context.Context, now time.Time, limit int64) ([]*admin.ForbiddenAccount, error) {
+	filter := bson.M{"expire_time": bson.M{"$gt": time.Time{}, "$lte": now}}
+	return mongoutil.Find[*admin.ForbiddenAccount](ctx, o.coll, filter, options.Find().SetSort(bson.M{"expire_time": 1}).SetLimit(limit))
+}
+
+// activeFilter matches blocks without an end time, records created before it existed have no field at all.
+func activeFilter(now time.Time) bson.M {
+	return bson.M{"$or": []bson.M{
+		{"expire_time": bson.M{"$exists": false}},
+		{"expire_time": time.Time{}},
+		{"expire_time": bson.M{"$gt": now}},
+	}}
+}
 
+func (o *ForbiddenAccount) Search(ctx context.Context, keyword string, category string, state int32, pagination pagination.Pagination) (int64, []*admin.ForbiddenAccount, error) {
+	var and []bson.M
+	switch state {
+	case constant.BlockStateActive:
+		and = append(and, activeFilter(time.Now()))
+	case constant.BlockStateExpired:
+		and = append(and, bson.M{"expire_time": bson.M{"$gt": time.Time{}, "$lte": time.Now()}})
+	}
+	if category != "" {
+		and = append(and, bson.M{"category": category})
+	}
 	if keyword != "" {
-		filter = bson.M{
+		and = append(and, bson.M{
 			"$or": []bson.M{
 				{"user_id": bson.M{"$regex": keyword, "$options": "i"}},
 				{"reason": bson.M{"$regex": keyword, "$options": "i"}},
 				{"operator_user_id": bson.M{"$regex": keyword, "$options": "i"}},
 			},
-		}
+		})
+	}
+	filter := bson.M{}
+	if len(and) > 0 {
+		filter["$and"] = and
 	}
 	return mongoutil.FindPage[*admin.ForbiddenAccount](ctx, o.coll, filter, pagination)
 }
 
 func (o *ForbiddenAccount) FindAllIDs(ctx context.Context) ([]string, error) {
-	return mongoutil.Find[string](ctx, o.coll, bson.M{}, options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1}))
+	return mongoutil.Find[string](ctx, o.coll, activeFilter(time.Now()), options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1}))
 }
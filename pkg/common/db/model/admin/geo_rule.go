@@ -0,0 +1,83 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/tools/errs"
+)
+
+func NewGeoRule(db *mongo.Database) (admin.GeoRuleInterface, error) {
+	coll := db.Collection("geo_rule")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "country", Value: 1},
+			{Key: "region", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &GeoRule{
+		coll: coll,
+	}, nil
+}
+
+type GeoRule struct {
+	coll *mongo.Collection
+}
+
+func (o *GeoRule) Create(ctx context.Context, ms []*admin.GeoRule) error {
+	return mongoutil.InsertMany(ctx, o.coll, ms)
+}
+
+func (o *GeoRule) Delete(ctx context.Context, ms []*admin.GeoRule) error {
+	if len(ms) == 0 {
+		return nil
+	}
+	or := make(bson.A, 0, len(ms))
+	for _, m := range ms {
+		or = append(or, bson.M{
+			"country": m.Country,
+			"region":  m.Region,
+		})
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"$or": or})
+}
+
+func (o *GeoRule) FindAll(ctx context.Context) ([]*admin.GeoRule, error) {
+	return mongoutil.Find[*admin.GeoRule](ctx, o.coll, bson.M{})
+}
+
+func (o *GeoRule) Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admin.GeoRule, error) {
+	filter := bson.M{}
+	if keyword != "" {
+		filter["$or"] = []bson.M{
+			{"country": bson.M{"$regex": keyword, "$options": "i"}},
+			{"region": bson.M{"$regex": keyword, "$options": "i"}},
+			{"note": bson.M{"$regex": keyword, "$options": "i"}},
+		}
+	}
+	return mongoutil.FindPage[*admin.GeoRule](ctx, o.coll, filter, pagination)
+}
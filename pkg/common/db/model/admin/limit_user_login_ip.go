@@ -68,6 +68,10 @@ func (o *LimitUserLoginIP) Take(ctx context.Context, userID string, ip string) (
 	return mongoutil.FindOne[*admin.LimitUserLoginIP](ctx, o.coll, bson.M{"user_id": userID, "ip": ip})
 }
 
+func (o *LimitUserLoginIP) FindAll(ctx context.Context) ([]*admin.LimitUserLoginIP, error) {
+	return mongoutil.Find[*admin.LimitUserLoginIP](ctx, o.coll, bson.M{})
+}
+
 func (o *LimitUserLoginIP) Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admin.LimitUserLoginIP, error) {
 	filter := bson.M{
 		"$or": []bson.M{
@@ -0,0 +1,108 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func NewDelivery(db *mongo.Database) (chat.DeliveryInterface, error) {
+	coll := db.Collection("delivery")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "delivery_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "next_time", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "account", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &Delivery{coll: coll}, nil
+}
+
+type Delivery struct {
+	coll *mongo.Collection
+}
+
+func (o *Delivery) Create(ctx context.Context, delivery *chat.Delivery) error {
+	return mongoutil.InsertMany(ctx, o.coll, []*chat.Delivery{delivery})
+}
+
+func (o *Delivery) Update(ctx context.Context, deliveryID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	data["update_time"] = time.Now()
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"delivery_id": deliveryID}, bson.M{"$set": data}, false)
+}
+
+func (o *Delivery) Claim(ctx context.Context, now time.Time, lease time.Time) (*chat.Delivery, error) {
+	filter := bson.M{
+		"status":    constant.DeliveryStatusQueued,
+		"next_time": bson.M{"$lte": now},
+	}
+	update := bson.M{"$set": bson.M{"next_time": lease, "update_time": now}}
+	opt := options.FindOneAndUpdate().SetSort(bson.D{{Key: "next_time", Value: 1}}).SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*chat.Delivery](ctx, o.coll, filter, update, opt)
+}
+
+func (o *Delivery) Search(ctx context.Context, account string, userID string, channel string, status int32, pagination pagination.Pagination) (int64, []*chat.Delivery, error) {
+	filter := bson.M{}
+	if account != "" {
+		filter["account"] = account
+	}
+	if userID != "" {
+		filter["user_id"] = userID
+	}
+	if channel != "" {
+		filter["channel"] = channel
+	}
+	if status != constant.DeliveryStatusAll {
+		filter["status"] = status
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*chat.Delivery](ctx, o.coll, filter, pagination, opt)
+}
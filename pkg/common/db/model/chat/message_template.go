@@ -0,0 +1,73 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func NewMessageTemplate(db *mongo.Database) (chat.MessageTemplateInterface, error) {
+	coll := db.Collection("message_template")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "channel", Value: 1},
+			{Key: "used_for", Value: 1},
+			{Key: "locale", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &MessageTemplate{coll: coll}, nil
+}
+
+type MessageTemplate struct {
+	coll *mongo.Collection
+}
+
+func (o *MessageTemplate) Set(ctx context.Context, template *chat.MessageTemplate) error {
+	filter := bson.M{"channel": template.Channel, "used_for": template.UsedFor, "locale": template.Locale}
+	return mongoutil.UpdateOne(ctx, o.coll, filter, bson.M{"$set": template}, false, options.Update().SetUpsert(true))
+}
+
+func (o *MessageTemplate) Delete(ctx context.Context, channel string, usedFor int32, locale string) error {
+	return mongoutil.DeleteOne(ctx, o.coll, bson.M{"channel": channel, "used_for": usedFor, "locale": locale})
+}
+
+func (o *MessageTemplate) FindAll(ctx context.Context) ([]*chat.MessageTemplate, error) {
+	return mongoutil.Find[*chat.MessageTemplate](ctx, o.coll, bson.M{})
+}
+
+func (o *MessageTemplate) Search(ctx context.Context, channel string, locale string, pagination pagination.Pagination) (int64, []*chat.MessageTemplate, error) {
+	filter := bson.M{}
+	if channel != "" {
+		filter["channel"] = channel
+	}
+	if locale != "" {
+		filter["locale"] = locale
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "channel", Value: 1}, {Key: "locale", Value: 1}, {Key: "used_for", Value: 1}})
+	return mongoutil.FindPage[*chat.MessageTemplate](ctx, o.coll, filter, pagination, opt)
+}
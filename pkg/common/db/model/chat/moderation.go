@@ -0,0 +1,134 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func NewModerationRule(db *mongo.Database) (chat.ModerationRuleInterface, error) {
+	coll := db.Collection("moderation_rule")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "rule_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ModerationRule{coll: coll}, nil
+}
+
+type ModerationRule struct {
+	coll *mongo.Collection
+}
+
+func (o *ModerationRule) Create(ctx context.Context, rule *chat.ModerationRule) error {
+	return mongoutil.InsertMany(ctx, o.coll, []*chat.ModerationRule{rule})
+}
+
+func (o *ModerationRule) Take(ctx context.Context, ruleID string) (*chat.ModerationRule, error) {
+	return mongoutil.FindOne[*chat.ModerationRule](ctx, o.coll, bson.M{"rule_id": ruleID})
+}
+
+func (o *ModerationRule) Update(ctx context.Context, ruleID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	data["update_time"] = time.Now()
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"rule_id": ruleID}, bson.M{"$set": data}, true)
+}
+
+func (o *ModerationRule) Delete(ctx context.Context, ruleIDs []string) error {
+	if len(ruleIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"rule_id": bson.M{"$in": ruleIDs}})
+}
+
+func (o *ModerationRule) FindAll(ctx context.Context) ([]*chat.ModerationRule, error) {
+	return mongoutil.Find[*chat.ModerationRule](ctx, o.coll, bson.M{})
+}
+
+func (o *ModerationRule) Search(ctx context.Context, keyword string, language string, pagination pagination.Pagination) (int64, []*chat.ModerationRule, error) {
+	filter := bson.M{}
+	if keyword != "" {
+		filter["$or"] = []bson.M{
+			{"name": bson.M{"$regex": keyword, "$options": "i"}},
+			{"pattern": bson.M{"$regex": keyword, "$options": "i"}},
+		}
+	}
+	if language != "" {
+		filter["language"] = language
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*chat.ModerationRule](ctx, o.coll, filter, pagination, opt)
+}
+
+func NewModerationAllowUser(db *mongo.Database) (chat.ModerationAllowUserInterface, error) {
+	coll := db.Collection("moderation_allow_user")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ModerationAllowUser{coll: coll}, nil
+}
+
+type ModerationAllowUser struct {
+	coll *mongo.Collection
+}
+
+func (o *ModerationAllowUser) Create(ctx context.Context, users []*chat.ModerationAllowUser) error {
+	return mongoutil.InsertMany(ctx, o.coll, users)
+}
+
+func (o *ModerationAllowUser) Delete(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
+}
+
+func (o *ModerationAllowUser) FindAll(ctx context.Context) ([]*chat.ModerationAllowUser, error) {
+	return mongoutil.Find[*chat.ModerationAllowUser](ctx, o.coll, bson.M{})
+}
+
+func (o *ModerationAllowUser) Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*chat.ModerationAllowUser, error) {
+	filter := bson.M{}
+	if keyword != "" {
+		filter["$or"] = []bson.M{
+			{"user_id": bson.M{"$regex": keyword, "$options": "i"}},
+			{"note": bson.M{"$regex": keyword, "$options": "i"}},
+		}
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*chat.ModerationAllowUser](ctx, o.coll, filter, pagination, opt)
+}
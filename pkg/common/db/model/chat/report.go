@@ -0,0 +1,209 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func NewReport(db *mongo.Database) (chat.ReportInterface, error) {
+	coll := db.Collection("report")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "report_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			// one pending report per reporter and target
+			Keys: bson.D{
+				{Key: "reporter_user_id", Value: 1},
+				{Key: "target_type", Value: 1},
+				{Key: "target_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"status": constant.ReportStatusPending}),
+		},
+		{
+			Keys: bson.D{
+				{Key: "target_type", Value: 1},
+				{Key: "target_id", Value: 1},
+				{Key: "status", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &Report{coll: coll}, nil
+}
+
+type Report struct {
+	coll *mongo.Collection
+}
+
+func (o *Report) Upsert(ctx context.Context, report *chat.Report) (*chat.Report, error) {
+	filter := bson.M{
+		"reporter_user_id": report.ReporterUserID,
+		"target_type":      report.TargetType,
+		"target_id":        report.TargetID,
+		"status":           constant.ReportStatusPending,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"target_user_id":  report.TargetUserID,
+			"conversation_id": report.ConversationID,
+			"seq":             report.Seq,
+			"category":        report.Category,
+			"content":         report.Content,
+			"update_time":     report.UpdateTime,
+		},
+		"$setOnInsert": bson.M{
+			"report_id":       report.ReportID,
+			"action":          "",
+			"note":            "",
+			"handler_user_id": "",
+			"handle_id":       "",
+			"create_time":     report.CreateTime,
+			"handle_time":     report.HandleTime,
+		},
+	}
+	opt := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*chat.Report](ctx, o.coll, filter, update, opt)
+}
+
+func (o *Report) targetFilter(targetType int32, targetID string, status int32) bson.M {
+	filter := bson.M{}
+	if targetType != 0 {
+		filter["target_type"] = targetType
+	}
+	if targetID != "" {
+		filter["target_id"] = targetID
+	}
+	if status != constant.ReportStatusAll {
+		filter["status"] = status
+	}
+	return filter
+}
+
+func (o *Report) Search(ctx context.Context, targetType int32, targetID string, status int32, category string, pagination pagination.Pagination) (int64, []*chat.Report, error) {
+	filter := o.targetFilter(targetType, targetID, status)
+	if category != "" {
+		filter["category"] = category
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*chat.Report](ctx, o.coll, filter, pagination, opt)
+}
+
+func (o *Report) SearchTarget(ctx context.Context, targetType int32, status int32, pagination pagination.Pagination) (int64, []*chat.ReportTarget, error) {
+	pipeline := []bson.M{
+		{"$match": o.targetFilter(targetType, "", status)},
+		{"$sort": bson.M{"create_time": -1}},
+		{"$group": bson.M{
+			"_id":              bson.M{"target_type": "$target_type", "target_id": "$target_id"},
+			"target_type":      bson.M{"$first": "$target_type"},
+			"target_id":        bson.M{"$first": "$target_id"},
+			"target_user_id":   bson.M{"$first": "$target_user_id"},
+			"count":            bson.M{"$sum": 1},
+			"categories":       bson.M{"$addToSet": "$category"},
+			"last_report_time": bson.M{"$first": "$create_time"},
+		}},
+		{"$facet": bson.M{
+			"total": []bson.M{{"$count": "count"}},
+			"targets": []bson.M{
+				{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "last_report_time", Value: -1}}},
+				{"$skip": int64(pagination.GetPageNumber()-1) * int64(pagination.GetShowNumber())},
+				{"$limit": int64(pagination.GetShowNumber())},
+			},
+		}},
+	}
+	type result struct {
+		Total []struct {
+			Count int64 `bson:"count"`
+		} `bson:"total"`
+		Targets []*chat.ReportTarget `bson:"targets"`
+	}
+	res, err := mongoutil.Aggregate[*result](ctx, o.coll, pipeline)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(res) == 0 || len(res[0].Total) == 0 {
+		return 0, nil, nil
+	}
+	return res[0].Total[0].Count, res[0].Targets, nil
+}
+
+func (o *Report) Claim(ctx context.Context, targetType int32, targetID string, handleID string, now time.Time, staleBefore time.Time) (int64, error) {
+	if targetType == 0 || targetID == "" {
+		return 0, errs.ErrArgs.WrapMsg("target is empty")
+	}
+	filter := bson.M{
+		"target_type": targetType,
+		"target_id":   targetID,
+		"$or": []bson.M{
+			{"status": constant.ReportStatusPending},
+			{"status": constant.ReportStatusHandling, "handle_time": bson.M{"$lt": staleBefore}},
+		},
+	}
+	update := bson.M{"$set": bson.M{
+		"status":      constant.ReportStatusHandling,
+		"handle_id":   handleID,
+		"handle_time": now,
+	}}
+	res, err := mongoutil.UpdateMany(ctx, o.coll, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
+func (o *Report) Finish(ctx context.Context, handleID string, update map[string]any) (int64, error) {
+	if handleID == "" {
+		return 0, errs.ErrArgs.WrapMsg("handleID is empty")
+	}
+	filter := bson.M{"handle_id": handleID, "status": constant.ReportStatusHandling}
+	res, err := mongoutil.UpdateMany(ctx, o.coll, filter, bson.M{"$set": update})
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
+func (o *Report) Release(ctx context.Context, handleID string) error {
+	if handleID == "" {
+		return errs.ErrArgs.WrapMsg("handleID is empty")
+	}
+	filter := bson.M{"handle_id": handleID, "status": constant.ReportStatusHandling}
+	update := bson.M{"$set": bson.M{"status": constant.ReportStatusPending, "handle_id": ""}}
+	_, err := mongoutil.UpdateMany(ctx, o.coll, filter, update)
+	return err
+}
@@ -0,0 +1,78 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func NewSuspiciousLogin(db *mongo.Database) (chat.SuspiciousLoginInterface, error) {
+	coll := db.Collection("suspicious_login")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "create_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &SuspiciousLogin{coll: coll}, nil
+}
+
+type SuspiciousLogin struct {
+	coll *mongo.Collection
+}
+
+func (o *SuspiciousLogin) Create(ctx context.Context, ms ...*chat.SuspiciousLogin) error {
+	for _, m := range ms {
+		if m.ID.IsZero() {
+			m.ID = primitive.NewObjectID()
+		}
+	}
+	return mongoutil.InsertMany(ctx, o.coll, ms)
+}
+
+func (o *SuspiciousLogin) Search(ctx context.Context, userIDs []string, reason string, result string, pagination pagination.Pagination) (int64, []*chat.SuspiciousLogin, error) {
+	filter := bson.M{}
+	if len(userIDs) > 0 {
+		filter["user_id"] = bson.M{"$in": userIDs}
+	}
+	if reason != "" {
+		filter["reasons"] = reason
+	}
+	if result != "" {
+		filter["result"] = result
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*chat.SuspiciousLogin](ctx, o.coll, filter, pagination, opt)
+}
@@ -0,0 +1,83 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func NewTOTP(db *mongo.Database) (chat.TOTPInterface, error) {
+	coll := db.Collection("totp")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &TOTP{coll: coll}, nil
+}
+
+type TOTP struct {
+	coll *mongo.Collection
+}
+
+func (o *TOTP) Set(ctx context.Context, totp *chat.TOTP) error {
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"user_id": totp.UserID}, bson.M{"$set": totp}, false, options.Update().SetUpsert(true))
+}
+
+func (o *TOTP) Take(ctx context.Context, userID string) (*chat.TOTP, error) {
+	return mongoutil.FindOne[*chat.TOTP](ctx, o.coll, bson.M{"user_id": userID})
+}
+
+func (o *TOTP) Update(ctx context.Context, userID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"user_id": userID}, bson.M{"$set": data}, false)
+}
+
+func (o *TOTP) UpdateCounter(ctx context.Context, userID string, counter int64) (bool, error) {
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, bson.M{"user_id": userID, "last_counter": bson.M{"$lt": counter}}, bson.M{"$set": bson.M{"last_counter": counter}})
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+func (o *TOTP) UseRecoveryCode(ctx context.Context, userID string, hash string) (bool, error) {
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, bson.M{"user_id": userID, "recovery_codes": hash}, bson.M{"$pull": bson.M{"recovery_codes": hash}})
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+func (o *TOTP) Delete(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
+}
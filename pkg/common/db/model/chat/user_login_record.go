@@ -19,6 +19,7 @@ import (
 	"github.com/openimsdk/tools/db/mongoutil"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"time"
 
 	"github.com/openimsdk/chat/pkg/common/db/table/chat"
@@ -32,6 +33,12 @@ func NewUserLoginRecord(db *mongo.Database) (chat.UserLoginRecordInterface, erro
 				{Key: "create_time", Value: 1},
 			},
 		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "login_time", Value: -1},
+			},
+		},
 	})
 	if err != nil {
 		return nil, err
@@ -118,3 +125,9 @@ func (o *UserLoginRecord) CountRangeEverydayTotal(ctx context.Context, start *ti
 	}
 	return countMap, loginCount, nil
 }
+
+func (o *UserLoginRecord) FindRecent(ctx context.Context, userID string, after time.Time, limit int64) ([]*chat.UserLoginRecord, error) {
+	filter := bson.M{"user_id": userID, "login_time": bson.M{"$gt": after}}
+	opt := options.Find().SetSort(bson.D{{Key: "login_time", Value: -1}}).SetLimit(limit)
+	return mongoutil.Find[*chat.UserLoginRecord](ctx, o.coll, filter, opt)
+}
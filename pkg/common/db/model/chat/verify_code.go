@@ -31,7 +31,8 @@ type mongoVerifyCode struct {
 	ID         primitive.ObjectID `bson:"_id"`
 	Account    string             `bson:"account"`
 	Platform   string             `bson:"platform"`
-	Code       string             `bson:"code"`
+	UsedFor    int32              `bson:"used_for"`
+	CodeHash   string             `bson:"code_hash"`
 	Duration   uint               `bson:"duration"`
 	Count      int                `bson:"count"`
 	Used       bool               `bson:"used"`
@@ -44,6 +45,7 @@ func NewVerifyCode(db *mongo.Database) (chat.VerifyCodeInterface, error) {
 		{
 			Keys: bson.D{
 				{Key: "account", Value: 1},
+				{Key: "used_for", Value: 1},
 			},
 		},
 	})
@@ -86,7 +88,8 @@ func (o *VerifyCode) Add(ctx context.Context, ms []*chat.VerifyCode) error {
 			ID:         objID,
 			Account:    m.Account,
 			Platform:   m.Platform,
-			Code:       m.Code,
+			UsedFor:    m.UsedFor,
+			CodeHash:   m.CodeHash,
 			Duration:   m.Duration,
 			Count:      m.Count,
 			Used:       m.Used,
@@ -107,9 +110,10 @@ func (o *VerifyCode) RangeNum(ctx context.Context, account string, start time.Ti
 	return mongoutil.Count(ctx, o.coll, filter)
 }
 
-func (o *VerifyCode) TakeLast(ctx context.Context, account string) (*chat.VerifyCode, error) {
+func (o *VerifyCode) TakeLast(ctx context.Context, account string, usedFor int32) (*chat.VerifyCode, error) {
 	filter := bson.M{
-		"account": account,
+		"account":  account,
+		"used_for": usedFor,
 	}
 	opt := options.FindOne().SetSort(bson.M{"_id": -1})
 	last, err := mongoutil.FindOne[*mongoVerifyCode](ctx, o.coll, filter, opt)
@@ -120,7 +124,8 @@ func (o *VerifyCode) TakeLast(ctx context.Context, account string) (*chat.Verify
 		ID:         last.ID.Hex(),
 		Account:    last.Account,
 		Platform:   last.Platform,
-		Code:       last.Code,
+		UsedFor:    last.UsedFor,
+		CodeHash:   last.CodeHash,
 		Duration:   last.Duration,
 		Count:      last.Count,
 		Used:       last.Used,
@@ -28,6 +28,7 @@ type Admin struct {
 	Nickname   string    `bson:"nickname"`
 	UserID     string    `bson:"user_id"`
 	Level      int32     `bson:"level"`
+	RoleIDs    []string  `bson:"role_ids"` // nil for admins created before roles, who get the built-in admin role
 	CreateTime time.Time `bson:"create_time"`
 }
 
@@ -43,4 +44,5 @@ type AdminInterface interface {
 	ChangePassword(ctx context.Context, userID string, newPassword string) error
 	Delete(ctx context.Context, userIDs []string) error
 	Search(ctx context.Context, pagination pagination.Pagination) (int64, []*Admin, error)
+	ExistRole(ctx context.Context, roleIDs []string) (bool, error)
 }
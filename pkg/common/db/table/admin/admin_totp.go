@@ -0,0 +1,45 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+)
+
+type AdminTOTP struct {
+	UserID        string    `bson:"user_id"`
+	Secret        string    `bson:"secret"`
+	Enabled       bool      `bson:"enabled"`
+	RecoveryCodes []string  `bson:"recovery_codes"` // sha256 of the unused recovery codes
+	LastCounter   int64     `bson:"last_counter"`   // last accepted time step, rejects replays
+	CreateTime    time.Time `bson:"create_time"`
+	EnableTime    time.Time `bson:"enable_time"`
+}
+
+func (AdminTOTP) TableName() string {
+	return "admin_totps"
+}
+
+type AdminTOTPInterface interface {
+	Set(ctx context.Context, totp *AdminTOTP) error
+	Take(ctx context.Context, userID string) (*AdminTOTP, error)
+	Update(ctx context.Context, userID string, data map[string]any) error
+	// UpdateCounter advances last_counter only if it is still below counter.
+	UpdateCounter(ctx context.Context, userID string, counter int64) (bool, error)
+	// UseRecoveryCode removes hash from the remaining codes, reporting whether it was present.
+	UseRecoveryCode(ctx context.Context, userID string, hash string) (bool, error)
+	Delete(ctx context.Context, userIDs []string) error
+}
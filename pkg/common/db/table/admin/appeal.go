@@ -0,0 +1,52 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// Appeal is a blocked user's request to lift a block, one per block.
+type Appeal struct {
+	AppealID       string    `bson:"appeal_id"`
+	UserID         string    `bson:"user_id"`
+	BlockTime      time.Time `bson:"block_time"` // create time of the block appealed against
+	Content        string    `bson:"content"`
+	Attachments    []string  `bson:"attachments"`
+	Status         int32     `bson:"status"`
+	AssigneeUserID string    `bson:"assignee_user_id"`
+	Response       string    `bson:"response"`
+	HandlerUserID  string    `bson:"handler_user_id"`
+	IP             string    `bson:"ip"`
+	CreateTime     time.Time `bson:"create_time"`
+	HandleTime     time.Time `bson:"handle_time"`
+}
+
+func (Appeal) TableName() string {
+	return "appeals"
+}
+
+type AppealInterface interface {
+	Create(ctx context.Context, appeal *Appeal) error
+	Take(ctx context.Context, appealID string) (*Appeal, error)
+	TakeByBlock(ctx context.Context, userID string, blockTime time.Time) (*Appeal, error)
+	Search(ctx context.Context, keyword string, status int32, assigneeUserID string, pagination pagination.Pagination) (int64, []*Appeal, error)
+	Assign(ctx context.Context, appealIDs []string, assigneeUserID string) error
+	// UpdatePending applies update if the appeal is still pending and reports whether it was.
+	UpdatePending(ctx context.Context, appealID string, update map[string]any) (bool, error)
+}
@@ -0,0 +1,103 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AuditLog records one admin operation, or the result of one. Each entry carries the HMAC of the
+// previous one keyed with a server secret, so changed entries break the hash chain and deleted
+// entries leave a gap in Seq. The chain head, kept apart from the entries, shows deleted last entries.
+type AuditLog struct {
+	ID             primitive.ObjectID `bson:"_id"`
+	Seq            int64              `bson:"seq"`
+	OperatorUserID string             `bson:"operator_user_id"`
+	Action         string             `bson:"action"`
+	Target         string             `bson:"target"`
+	Diff           string             `bson:"diff"` // request body with secrets redacted
+	IP             string             `bson:"ip"`
+	OperationID    string             `bson:"operation_id"`
+	ErrCode        int32              `bson:"err_code"`
+	ErrMsg         string             `bson:"err_msg"`
+	ResultOf       int64              `bson:"result_of"` // seq of the operation this is the result of, 0 for an operation
+	CreateTime     time.Time          `bson:"create_time"`
+	PrevHash       string             `bson:"prev_hash"`
+	Hash           string             `bson:"hash"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
+
+// ComputeHash returns the HMAC of the entry content and PrevHash, Hash itself excluded.
+func (a *AuditLog) ComputeHash(key []byte) string {
+	data, _ := json.Marshal([]any{
+		a.Seq,
+		a.PrevHash,
+		a.OperatorUserID,
+		a.Action,
+		a.Target,
+		a.Diff,
+		a.IP,
+		a.OperationID,
+		a.ErrCode,
+		a.ErrMsg,
+		a.ResultOf,
+		a.CreateTime.UnixMilli(),
+	})
+	return auditMAC(key, data)
+}
+
+// AuditLogHead is the last entry of the chain as of the latest append.
+type AuditLogHead struct {
+	Seq  int64  `bson:"seq"`
+	Hash string `bson:"hash"`
+	MAC  string `bson:"mac"`
+}
+
+// ComputeMAC returns the HMAC of the head, it differs from the hash of any entry.
+func (h *AuditLogHead) ComputeMAC(key []byte) string {
+	data, _ := json.Marshal([]any{"head", h.Seq, h.Hash})
+	return auditMAC(key, data)
+}
+
+func auditMAC(key []byte, data []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+type AuditLogInterface interface {
+	Create(ctx context.Context, log *AuditLog) error
+	Last(ctx context.Context) (*AuditLog, error)
+	FindAfter(ctx context.Context, seq int64, limit int64) ([]*AuditLog, error)
+	FindByOperationID(ctx context.Context, operationID string) ([]*AuditLog, error)
+	Search(ctx context.Context, operatorUserIDs []string, action string, target string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*AuditLog, error)
+	// Scan returns up to limit matching entries older than the one with ID cursor, newest first.
+	// A zero cursor starts at the newest entry.
+	Scan(ctx context.Context, operatorUserIDs []string, action string, target string, start time.Time, end time.Time, cursor primitive.ObjectID, limit int64) ([]*AuditLog, error)
+	TakeHead(ctx context.Context) (*AuditLogHead, error)
+	// AdvanceHead stores head unless the stored head is at the same or a later seq.
+	AdvanceHead(ctx context.Context, head *AuditLogHead) error
+}
@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// BlockHistory records a block, unblock or expiry of an account. Entries are only ever appended.
+type BlockHistory struct {
+	ID             primitive.ObjectID `bson:"_id"`
+	UserID         string             `bson:"user_id"`
+	Action         string             `bson:"action"`
+	Reason         string             `bson:"reason"`
+	Category       string             `bson:"category"`
+	OperatorUserID string             `bson:"operator_user_id"` // empty for the expiry job
+	ExpireTime     time.Time          `bson:"expire_time"`      // end time of the block the entry is about
+	CreateTime     time.Time          `bson:"create_time"`
+}
+
+func (BlockHistory) TableName() string {
+	return "block_histories"
+}
+
+type BlockHistoryInterface interface {
+	Create(ctx context.Context, ms ...*BlockHistory) error
+	Search(ctx context.Context, userIDs []string, action string, pagination pagination.Pagination) (int64, []*BlockHistory, error)
+}
@@ -24,10 +24,17 @@ import (
 type ForbiddenAccount struct {
 	UserID         string    `bson:"user_id"`
 	Reason         string    `bson:"reason"`
+	Category       string    `bson:"category"`
 	OperatorUserID string    `bson:"operator_user_id"`
+	ExpireTime     time.Time `bson:"expire_time"` // zero means the block never ends
 	CreateTime     time.Time `bson:"create_time"`
 }
 
+// Expired reports whether the block ended by now.
+func (f *ForbiddenAccount) Expired(now time.Time) bool {
+	return !f.ExpireTime.IsZero() && !f.ExpireTime.After(now)
+}
+
 func (ForbiddenAccount) TableName() string {
 	return "forbidden_accounts"
 }
@@ -36,7 +43,12 @@ type ForbiddenAccountInterface interface {
 	Create(ctx context.Context, ms []*ForbiddenAccount) error
 	Take(ctx context.Context, userID string) (*ForbiddenAccount, error)
 	Delete(ctx context.Context, userIDs []string) error
+	// DeleteExpired removes the block of userID ending at expireTime, it reports false if another
+	// instance lifted it first or the user was blocked again.
+	DeleteExpired(ctx context.Context, userID string, expireTime time.Time) (bool, error)
 	Find(ctx context.Context, userIDs []string) ([]*ForbiddenAccount, error)
-	Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*ForbiddenAccount, error)
+	FindExpired(ctx context.Context, now time.Time, limit int64) ([]*ForbiddenAccount, error)
+	Search(ctx context.Context, keyword string, category string, state int32, pagination pagination.Pagination) (int64, []*ForbiddenAccount, error)
+	// FindAllIDs returns the users whose block has not ended.
 	FindAllIDs(ctx context.Context) ([]string, error)
 }
@@ -0,0 +1,43 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// GeoRule applies to registrations and logins from a country, or from one region of it when Region is set.
+type GeoRule struct {
+	Country    string    `bson:"country"` // ISO 3166-1 alpha-2
+	Region     string    `bson:"region"`  // ISO 3166-2 subdivision code, empty for the whole country
+	Register   int32     `bson:"register"`
+	Login      int32     `bson:"login"`
+	Note       string    `bson:"note"`
+	CreateTime time.Time `bson:"create_time"`
+}
+
+func (GeoRule) TableName() string {
+	return "geo_rules"
+}
+
+type GeoRuleInterface interface {
+	Create(ctx context.Context, ms []*GeoRule) error
+	Delete(ctx context.Context, ms []*GeoRule) error
+	FindAll(ctx context.Context) ([]*GeoRule, error)
+	Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*GeoRule, error)
+}
@@ -20,10 +20,13 @@ import (
 	"time"
 )
 
+// IPForbidden blocks a single IP or, when IP is a CIDR block, a whole network.
 type IPForbidden struct {
 	IP            string    `bson:"ip"`
 	LimitRegister bool      `bson:"limit_register"`
 	LimitLogin    bool      `bson:"limit_login"`
+	Note          string    `bson:"note"`
+	ExpireTime    time.Time `bson:"expire_time"` // zero means the rule never expires
 	CreateTime    time.Time `bson:"create_time"`
 }
 
@@ -34,6 +37,7 @@ func (IPForbidden) IPForbidden() string {
 type IPForbiddenInterface interface {
 	Take(ctx context.Context, ip string) (*IPForbidden, error)
 	Find(ctx context.Context, ips []string) ([]*IPForbidden, error)
+	FindAll(ctx context.Context) ([]*IPForbidden, error)
 	Search(ctx context.Context, keyword string, state int32, pagination pagination.Pagination) (int64, []*IPForbidden, error)
 	Create(ctx context.Context, ms []*IPForbidden) error
 	Delete(ctx context.Context, ips []string) error
@@ -20,9 +20,12 @@ import (
 	"time"
 )
 
+// LimitUserLoginIP allows the user to log in only from IP, which may be a CIDR block.
 type LimitUserLoginIP struct {
 	UserID     string    `bson:"user_id"`
 	IP         string    `bson:"ip"`
+	Note       string    `bson:"note"`
+	ExpireTime time.Time `bson:"expire_time"` // zero means the rule never expires
 	CreateTime time.Time `bson:"create_time"`
 }
 
@@ -35,5 +38,6 @@ type LimitUserLoginIPInterface interface {
 	Delete(ctx context.Context, ms []*LimitUserLoginIP) error
 	Count(ctx context.Context, userID string) (uint32, error)
 	Take(ctx context.Context, userID string, ip string) (*LimitUserLoginIP, error)
+	FindAll(ctx context.Context) ([]*LimitUserLoginIP, error)
 	Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*LimitUserLoginIP, error)
 }
@@ -0,0 +1,45 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// Role is a named set of permissions assigned to admins.
+type Role struct {
+	RoleID      string    `bson:"role_id"`
+	Name        string    `bson:"name"`
+	Permissions []string  `bson:"permissions"`
+	Note        string    `bson:"note"`
+	Builtin     bool      `bson:"builtin"`
+	CreateTime  time.Time `bson:"create_time"`
+	ChangeTime  time.Time `bson:"change_time"`
+}
+
+func (Role) TableName() string {
+	return "roles"
+}
+
+type RoleInterface interface {
+	Create(ctx context.Context, roles []*Role) error
+	Update(ctx context.Context, roleID string, update map[string]any) error
+	Delete(ctx context.Context, roleIDs []string) error
+	Find(ctx context.Context, roleIDs []string) ([]*Role, error)
+	Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*Role, error)
+}
@@ -0,0 +1,57 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// Delivery is an outbound SMS or email, kept in a queue until a provider accepts it.
+type Delivery struct {
+	DeliveryID    string            `bson:"delivery_id"`
+	Channel       string            `bson:"channel"`
+	Kind          string            `bson:"kind"`
+	Account       string            `bson:"account"` // the email, or the area code and phone number as joined for verification codes
+	UserID        string            `bson:"user_id"` // empty when sent before registration
+	AreaCode      string            `bson:"area_code"`
+	PhoneNumber   string            `bson:"phone_number"`
+	Email         string            `bson:"email"`
+	Payload       map[string]string `bson:"payload"` // what to send, cleared once the delivery ends
+	Status        int32             `bson:"status"`
+	Attempts      int32             `bson:"attempts"`
+	Provider      string            `bson:"provider"` // of the last attempt
+	ProviderMsgID string            `bson:"provider_msg_id"`
+	LastError     string            `bson:"last_error"`
+	NextTime      time.Time         `bson:"next_time"`   // of the next attempt
+	ExpireTime    time.Time         `bson:"expire_time"` // no attempts after it
+	CreateTime    time.Time         `bson:"create_time"`
+	UpdateTime    time.Time         `bson:"update_time"`
+	SentTime      time.Time         `bson:"sent_time"`
+}
+
+func (Delivery) TableName() string {
+	return "deliveries"
+}
+
+type DeliveryInterface interface {
+	Create(ctx context.Context, delivery *Delivery) error
+	Update(ctx context.Context, deliveryID string, data map[string]any) error
+	// Claim takes the queued delivery due longest ago and holds it until lease, so other instances skip it.
+	Claim(ctx context.Context, now time.Time, lease time.Time) (*Delivery, error)
+	Search(ctx context.Context, account string, userID string, channel string, status int32, pagination pagination.Pagination) (int64, []*Delivery, error)
+}
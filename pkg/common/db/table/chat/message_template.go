@@ -0,0 +1,45 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// MessageTemplate is the text of the verification code SMS or email of a purpose in a locale.
+type MessageTemplate struct {
+	Channel    string    `bson:"channel"`
+	UsedFor    int32     `bson:"used_for"` // 0 for any purpose
+	Locale     string    `bson:"locale"`   // canonical BCP 47 tag such as "es" or "pt-BR"
+	Subject    string    `bson:"subject"`  // text/template, email only
+	HTML       string    `bson:"html"`     // html/template, email only
+	Text       string    `bson:"text"`     // text/template, the SMS or the plain-text part of the email
+	UpdateTime time.Time `bson:"update_time"`
+}
+
+func (MessageTemplate) TableName() string {
+	return "message_templates"
+}
+
+type MessageTemplateInterface interface {
+	// Set creates the template of its channel, purpose and locale or replaces it.
+	Set(ctx context.Context, template *MessageTemplate) error
+	Delete(ctx context.Context, channel string, usedFor int32, locale string) error
+	FindAll(ctx context.Context) ([]*MessageTemplate, error)
+	Search(ctx context.Context, channel string, locale string, pagination pagination.Pagination) (int64, []*MessageTemplate, error)
+}
@@ -0,0 +1,68 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// ModerationRule matches words or a pattern in messages and user info.
+type ModerationRule struct {
+	RuleID     string    `bson:"rule_id"`
+	Name       string    `bson:"name"`
+	Type       string    `bson:"type"`
+	Pattern    string    `bson:"pattern"`
+	Language   string    `bson:"language"` // BCP 47 language of a word list, empty for any
+	Scope      int32     `bson:"scope"`
+	Action     string    `bson:"action"`
+	Category   string    `bson:"category"` // of the reports filed for flagged content
+	Enabled    bool      `bson:"enabled"`
+	CreateTime time.Time `bson:"create_time"`
+	UpdateTime time.Time `bson:"update_time"`
+}
+
+func (ModerationRule) TableName() string {
+	return "moderation_rules"
+}
+
+// ModerationAllowUser is a user whose content is never moderated, such as an official account.
+type ModerationAllowUser struct {
+	UserID     string    `bson:"user_id"`
+	Note       string    `bson:"note"`
+	CreateTime time.Time `bson:"create_time"`
+}
+
+func (ModerationAllowUser) TableName() string {
+	return "moderation_allow_users"
+}
+
+type ModerationRuleInterface interface {
+	Create(ctx context.Context, rule *ModerationRule) error
+	Take(ctx context.Context, ruleID string) (*ModerationRule, error)
+	Update(ctx context.Context, ruleID string, data map[string]any) error
+	Delete(ctx context.Context, ruleIDs []string) error
+	FindAll(ctx context.Context) ([]*ModerationRule, error)
+	Search(ctx context.Context, keyword string, language string, pagination pagination.Pagination) (int64, []*ModerationRule, error)
+}
+
+type ModerationAllowUserInterface interface {
+	Create(ctx context.Context, users []*ModerationAllowUser) error
+	Delete(ctx context.Context, userIDs []string) error
+	FindAll(ctx context.Context) ([]*ModerationAllowUser, error)
+	Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*ModerationAllowUser, error)
+}
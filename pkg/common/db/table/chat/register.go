@@ -23,6 +23,7 @@ type Register struct {
 	UserID      string    `bson:"user_id"`
 	DeviceID    string    `bson:"device_id"`
 	IP          string    `bson:"ip"`
+	Country     string    `bson:"country"`
 	Platform    string    `bson:"platform"`
 	AccountType string    `bson:"account_type"`
 	Mode        string    `bson:"mode"`
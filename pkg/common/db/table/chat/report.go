@@ -0,0 +1,74 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// Report is a user's report of abuse by a user, group or message. A reporter has at most one
+// pending report per target, reporting again updates it.
+type Report struct {
+	ReportID       string    `bson:"report_id"`
+	ReporterUserID string    `bson:"reporter_user_id"`
+	TargetType     int32     `bson:"target_type"`
+	TargetID       string    `bson:"target_id"`       // user ID, group ID or client message ID
+	TargetUserID   string    `bson:"target_user_id"`  // the reported user, or the sender of a reported message as the IM has it
+	ConversationID string    `bson:"conversation_id"` // of a reported message
+	Seq            int64     `bson:"seq"`             // of a reported message
+	Category       string    `bson:"category"`
+	Content        string    `bson:"content"`
+	Status         int32     `bson:"status"`
+	Action         string    `bson:"action"`
+	Note           string    `bson:"note"` // from the admin who took the action
+	HandlerUserID  string    `bson:"handler_user_id"`
+	HandleID       string    `bson:"handle_id"` // claim of the admin handling the report
+	CreateTime     time.Time `bson:"create_time"`
+	UpdateTime     time.Time `bson:"update_time"`
+	HandleTime     time.Time `bson:"handle_time"`
+}
+
+func (Report) TableName() string {
+	return "reports"
+}
+
+// ReportTarget sums up the reports against one target for the moderation queue.
+type ReportTarget struct {
+	TargetType     int32     `bson:"target_type"`
+	TargetID       string    `bson:"target_id"`
+	TargetUserID   string    `bson:"target_user_id"` // of the latest report
+	Count          int64     `bson:"count"`
+	Categories     []string  `bson:"categories"`
+	LastReportTime time.Time `bson:"last_report_time"`
+}
+
+type ReportInterface interface {
+	// Upsert stores report, or updates the category and content of the reporter's pending report
+	// against the same target. It returns the stored report.
+	Upsert(ctx context.Context, report *Report) (*Report, error)
+	Search(ctx context.Context, targetType int32, targetID string, status int32, category string, pagination pagination.Pagination) (int64, []*Report, error)
+	// SearchTarget groups the reports by target, most reported first.
+	SearchTarget(ctx context.Context, targetType int32, status int32, pagination pagination.Pagination) (int64, []*ReportTarget, error)
+	// Claim marks the pending reports against the target, and the ones a claim older than staleBefore
+	// left handling, as handling under handleID. It returns how many were claimed.
+	Claim(ctx context.Context, targetType int32, targetID string, handleID string, now time.Time, staleBefore time.Time) (int64, error)
+	// Finish applies update to the reports claimed under handleID and returns how many there were.
+	Finish(ctx context.Context, handleID string, update map[string]any) (int64, error)
+	// Release puts the reports claimed under handleID back in the moderation queue.
+	Release(ctx context.Context, handleID string) error
+}
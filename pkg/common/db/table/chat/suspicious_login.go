@@ -0,0 +1,48 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SuspiciousLogin is a login flagged by the detector, kept for the user's notification and for admins.
+type SuspiciousLogin struct {
+	ID              primitive.ObjectID `bson:"_id"`
+	UserID          string             `bson:"user_id"`
+	Reasons         []string           `bson:"reasons"`
+	Result          string             `bson:"result"`
+	IP              string             `bson:"ip"`
+	Country         string             `bson:"country"`
+	DeviceID        string             `bson:"device_id"`
+	Platform        string             `bson:"platform"`
+	PreviousIP      string             `bson:"previous_ip"`
+	PreviousCountry string             `bson:"previous_country"`
+	PreviousTime    time.Time          `bson:"previous_time"`
+	CreateTime      time.Time          `bson:"create_time"`
+}
+
+func (SuspiciousLogin) TableName() string {
+	return "suspicious_logins"
+}
+
+type SuspiciousLoginInterface interface {
+	Create(ctx context.Context, ms ...*SuspiciousLogin) error
+	Search(ctx context.Context, userIDs []string, reason string, result string, pagination pagination.Pagination) (int64, []*SuspiciousLogin, error)
+}
@@ -23,6 +23,7 @@ type UserLoginRecord struct {
 	UserID    string    `bson:"user_id"`
 	LoginTime time.Time `bson:"login_time"`
 	IP        string    `bson:"ip"`
+	Country   string    `bson:"country"`
 	DeviceID  string    `bson:"device_id"`
 	Platform  string    `bson:"platform"`
 }
@@ -35,4 +36,6 @@ type UserLoginRecordInterface interface {
 	Create(ctx context.Context, records ...*UserLoginRecord) error
 	CountTotal(ctx context.Context, before *time.Time) (int64, error)
 	CountRangeEverydayTotal(ctx context.Context, start *time.Time, end *time.Time) (map[string]int64, int64, error)
+	// FindRecent returns at most limit logins of the user after the given time, the latest first.
+	FindRecent(ctx context.Context, userID string, after time.Time, limit int64) ([]*UserLoginRecord, error)
 }
@@ -19,11 +19,14 @@ import (
 	"time"
 )
 
+// VerifyCode is a sent verification code. Only a keyed hash of the code is stored, and the code
+// only verifies for the purpose it was sent for.
 type VerifyCode struct {
 	ID         string    `bson:"_id"`
 	Account    string    `bson:"account"`
 	Platform   string    `bson:"platform"`
-	Code       string    `bson:"code"`
+	UsedFor    int32     `bson:"used_for"`
+	CodeHash   string    `bson:"code_hash"`
 	Duration   uint      `bson:"duration"`
 	Count      int       `bson:"count"`
 	Used       bool      `bson:"used"`
@@ -37,7 +40,8 @@ func (VerifyCode) TableName() string {
 type VerifyCodeInterface interface {
 	Add(ctx context.Context, ms []*VerifyCode) error
 	RangeNum(ctx context.Context, account string, start time.Time, end time.Time) (int64, error)
-	TakeLast(ctx context.Context, account string) (*VerifyCode, error)
+	// TakeLast returns the last code sent to the account for the purpose.
+	TakeLast(ctx context.Context, account string, usedFor int32) (*VerifyCode, error)
 	Incr(ctx context.Context, id string) error
 	Delete(ctx context.Context, id string) error
 }
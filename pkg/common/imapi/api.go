@@ -18,6 +18,7 @@ import (
 	"github.com/openimsdk/protocol/auth"
 	"github.com/openimsdk/protocol/group"
 	"github.com/openimsdk/protocol/relation"
+	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/protocol/user"
 )
 
@@ -34,4 +35,9 @@ var (
 	registerUserCount = NewApiCaller[user.UserRegisterCountReq, user.UserRegisterCountResp]("/statistics/user/register")
 	friendUserIDs     = NewApiCaller[relation.GetFriendIDsReq, relation.GetFriendIDsResp]("/friend/get_friend_id")
 	accountCheck      = NewApiCaller[user.AccountCheckReq, user.AccountCheckResp]("/user/account_check")
+	joinedGroupList   = NewApiCaller[group.GetJoinedGroupListReq, group.GetJoinedGroupListResp]("/group/get_joined_group_list")
+	muteGroupMember   = NewApiCaller[group.MuteGroupMemberReq, group.MuteGroupMemberResp]("/group/mute_group_member")
+	cancelMuteMember  = NewApiCaller[group.CancelMuteGroupMemberReq, group.CancelMuteGroupMemberResp]("/group/cancel_mute_group_member")
+	pullMsgBySeq      = NewApiCaller[sdkws.PullMessageBySeqsReq, sdkws.PullMessageBySeqsResp]("/msg/pull_msg_by_seq")
+	sendMsg           = NewApiCaller[sendMsgReq, sendMsgResp]("/msg/send_msg")
 )
@@ -2,9 +2,11 @@ package imapi
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
+	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 
 	"github.com/openimsdk/chat/pkg/eerrs"
@@ -17,6 +19,9 @@ import (
 	"github.com/openimsdk/protocol/user"
 )
 
+// joinedGroupPageSize is how many joined groups are read per page.
+const joinedGroupPageSize = 500
+
 type CallerInterface interface {
 	ImAdminTokenWithDefaultAdmin(ctx context.Context) (string, error)
 	ImportFriend(ctx context.Context, ownerUserID string, friendUserID []string) error
@@ -25,11 +30,16 @@ type CallerInterface interface {
 	InviteToGroup(ctx context.Context, userID string, groupIDs []string) error
 	UpdateUserInfo(ctx context.Context, userID string, nickName string, faceURL string) error
 	ForceOffLine(ctx context.Context, userID string) error
+	ForceOffLinePlatform(ctx context.Context, userID string, platformID int32) error
 	RegisterUser(ctx context.Context, users []*sdkws.UserInfo) error
 	FindGroupInfo(ctx context.Context, groupIDs []string) ([]*sdkws.GroupInfo, error)
 	UserRegisterCount(ctx context.Context, start int64, end int64) (map[string]int64, int64, error)
 	FriendUserIDs(ctx context.Context, userID string) ([]string, error)
 	AccountCheckSingle(ctx context.Context, userID string) (bool, error)
+	MuteInJoinedGroups(ctx context.Context, userID string, mutedSeconds uint32) error
+	CancelMuteInJoinedGroups(ctx context.Context, userID string) error
+	MessageSender(ctx context.Context, userID string, conversationID string, seq int64) (string, error)
+	SendTextMessage(ctx context.Context, recvID string, text string) error
 }
 
 type authToken struct {
@@ -151,6 +161,14 @@ func (c *Caller) ForceOffLine(ctx context.Context, userID string) error {
 	return nil
 }
 
+func (c *Caller) ForceOffLinePlatform(ctx context.Context, userID string, platformID int32) error {
+	_, err := forceOffLine.Call(ctx, c.imApi, &auth.ForceLogoutReq{
+		PlatformID: platformID,
+		UserID:     userID,
+	})
+	return err
+}
+
 func (c *Caller) FindGroupInfo(ctx context.Context, groupIDs []string) ([]*sdkws.GroupInfo, error) {
 	resp, err := getGroupsInfo.Call(ctx, c.imApi, &group.GetGroupsInfoReq{
 		GroupIDs: groupIDs,
@@ -191,3 +209,120 @@ func (c *Caller) AccountCheckSingle(ctx context.Context, userID string) (bool, e
 	}
 	return true, nil
 }
+
+// mutableGroupIDs returns the groups the user joined but does not own, an owner cannot be muted.
+func (c *Caller) mutableGroupIDs(ctx context.Context, userID string) ([]string, error) {
+	var (
+		groupIDs []string
+		read     int
+	)
+	for page := int32(1); ; page++ {
+		resp, err := joinedGroupList.Call(ctx, c.imApi, &group.GetJoinedGroupListReq{
+			Pagination: &sdkws.RequestPagination{PageNumber: page, ShowNumber: joinedGroupPageSize},
+			FromUserID: userID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range resp.Groups {
+			if g.OwnerUserID != userID {
+				groupIDs = append(groupIDs, g.GroupID)
+			}
+		}
+		read += len(resp.Groups)
+		if len(resp.Groups) < joinedGroupPageSize || read >= int(resp.Total) {
+			return groupIDs, nil
+		}
+	}
+}
+
+// MuteInJoinedGroups mutes the user in every group they joined but do not own. It goes on past
+// the groups where it fails and returns their errors joined.
+func (c *Caller) MuteInJoinedGroups(ctx context.Context, userID string, mutedSeconds uint32) error {
+	groupIDs, err := c.mutableGroupIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+	var errList []error
+	for _, groupID := range groupIDs {
+		if _, err := muteGroupMember.Call(ctx, c.imApi, &group.MuteGroupMemberReq{
+			GroupID:      groupID,
+			UserID:       userID,
+			MutedSeconds: mutedSeconds,
+		}); err != nil {
+			log.ZWarn(ctx, "mute group member failed", err, "groupID", groupID, "userID", userID)
+			errList = append(errList, errs.WrapMsg(err, "mute group member failed", "groupID", groupID))
+		}
+	}
+	return errors.Join(errList...)
+}
+
+// CancelMuteInJoinedGroups lifts the mute of the user in every group they joined but do not own,
+// returning the errors of the groups where it fails joined.
+func (c *Caller) CancelMuteInJoinedGroups(ctx context.Context, userID string) error {
+	groupIDs, err := c.mutableGroupIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+	var errList []error
+	for _, groupID := range groupIDs {
+		if _, err := cancelMuteMember.Call(ctx, c.imApi, &group.CancelMuteGroupMemberReq{
+			GroupID: groupID,
+			UserID:  userID,
+		}); err != nil {
+			log.ZWarn(ctx, "cancel mute group member failed", err, "groupID", groupID, "userID", userID)
+			errList = append(errList, errs.WrapMsg(err, "cancel mute group member failed", "groupID", groupID))
+		}
+	}
+	return errors.Join(errList...)
+}
+
+// MessageSender returns the sender of the message at seq in the conversation, as the user sees it.
+// It fails when the user cannot see the message, such as one of a conversation they are not in.
+func (c *Caller) MessageSender(ctx context.Context, userID string, conversationID string, seq int64) (string, error) {
+	resp, err := pullMsgBySeq.Call(ctx, c.imApi, &sdkws.PullMessageBySeqsReq{
+		UserID:    userID,
+		SeqRanges: []*sdkws.SeqRange{{ConversationID: conversationID, Begin: seq, End: seq, Num: 1}},
+		Order:     sdkws.PullOrder_PullOrderAsc,
+	})
+	if err != nil {
+		return "", err
+	}
+	if msgs := resp.Msgs[conversationID]; msgs != nil {
+		for _, msg := range msgs.Msgs {
+			if msg.Seq == seq && msg.SendID != "" {
+				return msg.SendID, nil
+			}
+		}
+	}
+	return "", errs.ErrRecordNotFound.WrapMsg("message not found", "conversationID", conversationID, "seq", seq)
+}
+
+// sendMsgReq is the IM API's own send request rather than the rpc one.
+type sendMsgReq struct {
+	SendID           string         `json:"sendID"`
+	RecvID           string         `json:"recvID"`
+	SenderPlatformID int32          `json:"senderPlatformID"`
+	Content          map[string]any `json:"content"`
+	ContentType      int32          `json:"contentType"`
+	SessionType      int32          `json:"sessionType"`
+}
+
+type sendMsgResp struct {
+	ServerMsgID string `json:"serverMsgID"`
+	ClientMsgID string `json:"clientMsgID"`
+	SendTime    int64  `json:"sendTime"`
+}
+
+// SendTextMessage sends the user a text message from the default IM admin.
+func (c *Caller) SendTextMessage(ctx context.Context, recvID string, text string) error {
+	_, err := sendMsg.Call(ctx, c.imApi, &sendMsgReq{
+		SendID:           c.defaultIMUserID,
+		RecvID:           recvID,
+		SenderPlatformID: constantpb.AdminPlatformID,
+		Content:          map[string]any{"content": text},
+		ContentType:      constantpb.Text,
+		SessionType:      constantpb.SingleChatType,
+	})
+	return err
+}
@@ -0,0 +1,168 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+// imServer stands in for the OpenIM API, handlers get the decoded request body.
+type imServer struct {
+	t        *testing.T
+	mu       sync.Mutex
+	handlers map[string]func(body map[string]any) (any, int)
+}
+
+func (s *imServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	handler, ok := s.handlers[r.URL.Path]
+	if !ok {
+		s.t.Errorf("unexpected call to %s", r.URL.Path)
+		http.NotFound(w, r)
+		return
+	}
+	var body map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.t.Errorf("%s: %v", r.URL.Path, err)
+	}
+	data, errCode := handler(body)
+	resp := map[string]any{"errCode": errCode, "data": data}
+	if errCode != 0 {
+		resp["errMsg"] = "failed"
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func newTestCaller(t *testing.T, handlers map[string]func(body map[string]any) (any, int)) *Caller {
+	srv := httptest.NewServer(&imServer{t: t, handlers: handlers})
+	t.Cleanup(srv.Close)
+	return New(srv.URL, "secret", "imAdmin").(*Caller)
+}
+
+func joinedGroups(groups ...*sdkws.GroupInfo) func(map[string]any) (any, int) {
+	return func(map[string]any) (any, int) {
+		return &group.GetJoinedGroupListResp{Total: uint32(len(groups)), Groups: groups}, 0
+	}
+}
+
+func TestMuteInJoinedGroups(t *testing.T) {
+	var muted []string
+	c := newTestCaller(t, map[string]func(map[string]any) (any, int){
+		"/group/get_joined_group_list": joinedGroups(
+			&sdkws.GroupInfo{GroupID: "owned", OwnerUserID: "u1"},
+			&sdkws.GroupInfo{GroupID: "g1", OwnerUserID: "other"},
+			&sdkws.GroupInfo{GroupID: "g2", OwnerUserID: "other"},
+			&sdkws.GroupInfo{GroupID: "g3", OwnerUserID: "other"},
+		),
+		"/group/mute_group_member": func(body map[string]any) (any, int) {
+			groupID := body["groupID"].(string)
+			if groupID == "g2" {
+				return nil, 1004
+			}
+			muted = append(muted, groupID)
+			return &group.MuteGroupMemberResp{}, 0
+		},
+	})
+	err := c.MuteInJoinedGroups(context.Background(), "u1", 3600)
+	if err == nil || !strings.Contains(err.Error(), "g2") {
+		t.Fatalf("err = %v, want the failure of g2", err)
+	}
+	if !slices.Equal(muted, []string{"g1", "g3"}) {
+		t.Fatalf("muted %v, want g1 and g3", muted)
+	}
+}
+
+func TestCancelMuteInJoinedGroups(t *testing.T) {
+	var canceled []string
+	c := newTestCaller(t, map[string]func(map[string]any) (any, int){
+		"/group/get_joined_group_list": joinedGroups(
+			&sdkws.GroupInfo{GroupID: "owned", OwnerUserID: "u1"},
+			&sdkws.GroupInfo{GroupID: "g1", OwnerUserID: "other"},
+		),
+		"/group/cancel_mute_group_member": func(body map[string]any) (any, int) {
+			canceled = append(canceled, body["groupID"].(string))
+			return &group.CancelMuteGroupMemberResp{}, 0
+		},
+	})
+	if err := c.CancelMuteInJoinedGroups(context.Background(), "u1"); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(canceled, []string{"g1"}) {
+		t.Fatalf("canceled %v, want g1", canceled)
+	}
+}
+
+func TestMessageSender(t *testing.T) {
+	c := newTestCaller(t, map[string]func(map[string]any) (any, int){
+		"/msg/pull_msg_by_seq": func(body map[string]any) (any, int) {
+			if body["userID"] != "reporter" {
+				return nil, 1002
+			}
+			ranges := body["seqRanges"].([]any)
+			r := ranges[0].(map[string]any)
+			if r["conversationID"] != "si_a_b" || r["begin"] != float64(7) || r["end"] != float64(7) {
+				return &sdkws.PullMessageBySeqsResp{}, 0
+			}
+			return &sdkws.PullMessageBySeqsResp{Msgs: map[string]*sdkws.PullMsgs{
+				"si_a_b": {Msgs: []*sdkws.MsgData{{SendID: "sender", Seq: 7}}},
+			}}, 0
+		},
+	})
+	ctx := context.Background()
+	sendID, err := c.MessageSender(ctx, "reporter", "si_a_b", 7)
+	if err != nil || sendID != "sender" {
+		t.Fatalf("MessageSender = %q, %v, want sender", sendID, err)
+	}
+	if _, err := c.MessageSender(ctx, "reporter", "si_a_b", 8); err == nil {
+		t.Fatal("sender of a message that is not there")
+	}
+	if _, err := c.MessageSender(ctx, "stranger", "si_a_b", 7); err == nil {
+		t.Fatal("sender of a message the user cannot see")
+	}
+}
+
+func TestSendTextMessage(t *testing.T) {
+	var sent map[string]any
+	c := newTestCaller(t, map[string]func(map[string]any) (any, int){
+		"/msg/send_msg": func(body map[string]any) (any, int) {
+			sent = body
+			return &sendMsgResp{ServerMsgID: "m1"}, 0
+		},
+	})
+	if err := c.SendTextMessage(context.Background(), "u1", "warning"); err != nil {
+		t.Fatal(err)
+	}
+	if sent["sendID"] != "imAdmin" || sent["recvID"] != "u1" {
+		t.Fatalf("sent from %v to %v, want imAdmin to u1", sent["sendID"], sent["recvID"])
+	}
+	if sent["contentType"] != float64(constant.Text) || sent["sessionType"] != float64(constant.SingleChatType) {
+		t.Fatalf("contentType %v sessionType %v, want a single chat text", sent["contentType"], sent["sessionType"])
+	}
+	if content, _ := sent["content"].(map[string]any); content["content"] != "warning" {
+		t.Fatalf("content %v, want the warning", sent["content"])
+	}
+}
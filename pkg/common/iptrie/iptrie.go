@@ -0,0 +1,140 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package iptrie matches IP addresses against IPv4 and IPv6 networks with a binary prefix tree.
+package iptrie
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// ParsePrefix accepts a single address or a CIDR block; IPv4-mapped IPv6 addresses are treated as IPv4.
+func ParsePrefix(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, errs.ErrArgs.WrapMsg("invalid cidr", "cidr", s)
+		}
+		if addr := p.Addr(); addr.Is4In6() {
+			bits := p.Bits() - 96
+			if bits < 0 {
+				return netip.Prefix{}, errs.ErrArgs.WrapMsg("invalid cidr", "cidr", s)
+			}
+			p = netip.PrefixFrom(addr.Unmap(), bits)
+		}
+		return p.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, errs.ErrArgs.WrapMsg("invalid ip", "ip", s)
+	}
+	addr = addr.Unmap().WithZone("")
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// Canonical returns the form rules are stored in: a plain address for a single IP, otherwise the masked CIDR.
+func Canonical(s string) (string, error) {
+	p, err := ParsePrefix(s)
+	if err != nil {
+		return "", err
+	}
+	if p.IsSingleIP() {
+		return p.Addr().String(), nil
+	}
+	return p.String(), nil
+}
+
+// Trie holds values keyed by network. The zero value is ready to use; it is not safe for
+// concurrent writes, so build a new one and swap it in to update.
+type Trie[T any] struct {
+	v4   node[T]
+	v6   node[T]
+	size int
+}
+
+type node[T any] struct {
+	child  [2]*node[T]
+	values []T
+}
+
+func (t *Trie[T]) root(addr netip.Addr) *node[T] {
+	if addr.Is4() {
+		return &t.v4
+	}
+	return &t.v6
+}
+
+func (t *Trie[T]) Insert(p netip.Prefix, v T) {
+	p = p.Masked()
+	n := t.root(p.Addr())
+	key := p.Addr().AsSlice()
+	for i := 0; i < p.Bits(); i++ {
+		b := bit(key, i)
+		if n.child[b] == nil {
+			n.child[b] = &node[T]{}
+		}
+		n = n.child[b]
+	}
+	n.values = append(n.values, v)
+	t.size++
+}
+
+// Match returns the values of every network containing addr, the widest first.
+func (t *Trie[T]) Match(addr netip.Addr) []T {
+	addr = addr.Unmap()
+	if !addr.IsValid() {
+		return nil
+	}
+	n := t.root(addr)
+	key := addr.AsSlice()
+	var res []T
+	for i := 0; ; i++ {
+		res = append(res, n.values...)
+		if i == addr.BitLen() {
+			return res
+		}
+		if n = n.child[bit(key, i)]; n == nil {
+			return res
+		}
+	}
+}
+
+// All returns every value in the trie.
+func (t *Trie[T]) All() []T {
+	res := make([]T, 0, t.size)
+	stack := []*node[T]{&t.v4, &t.v6}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		res = append(res, n.values...)
+		for _, c := range n.child {
+			if c != nil {
+				stack = append(stack, c)
+			}
+		}
+	}
+	return res
+}
+
+func (t *Trie[T]) Len() int {
+	return t.size
+}
+
+func bit(key []byte, i int) int {
+	return int(key[i/8]>>(7-i%8)) & 1
+}
@@ -0,0 +1,117 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iptrie
+
+import (
+	"fmt"
+	"net/netip"
+	"testing"
+)
+
+func TestParsePrefix(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{in: "10.0.0.1", want: "10.0.0.1/32", ok: true},
+		{in: "::ffff:10.0.0.1", want: "10.0.0.1/32", ok: true},
+		{in: "10.0.0.7/24", want: "10.0.0.0/24", ok: true},
+		{in: "::ffff:10.0.0.0/120", want: "10.0.0.0/24", ok: true},
+		{in: "::ffff:0.0.0.0/64", ok: false},
+		{in: "2001:db8::1/32", want: "2001:db8::/32", ok: true},
+		{in: " fe80::1%eth0 ", want: "fe80::1/128", ok: true},
+		{in: "10.0.0.256", ok: false},
+		{in: "10.0.0.0/33", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			p, err := ParsePrefix(tt.in)
+			if (err == nil) != tt.ok {
+				t.Fatalf("err = %v, want ok %v", err, tt.ok)
+			}
+			if tt.ok && p.String() != tt.want {
+				t.Fatalf("prefix = %s, want %s", p, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "10.0.0.1", want: "10.0.0.1"},
+		{in: "10.0.0.1/32", want: "10.0.0.1"},
+		{in: "::ffff:10.0.0.1", want: "10.0.0.1"},
+		{in: "10.0.0.9/8", want: "10.0.0.0/8"},
+		{in: "2001:db8::1/128", want: "2001:db8::1"},
+	}
+	for _, tt := range tests {
+		if got, err := Canonical(tt.in); err != nil || got != tt.want {
+			t.Errorf("Canonical(%q) = %q, %v, want %q", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	var trie Trie[string]
+	for _, s := range []string{"0.0.0.0/0", "10.0.0.0/8", "10.1.0.0/16", "10.1.2.3", "::/0", "2001:db8::/32", "2001:db8:1::/48"} {
+		p, err := ParsePrefix(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		trie.Insert(p, s)
+	}
+	tests := []struct {
+		addr string
+		want []string // widest first, so the last is the longest prefix
+	}{
+		{addr: "10.1.2.3", want: []string{"0.0.0.0/0", "10.0.0.0/8", "10.1.0.0/16", "10.1.2.3"}},
+		{addr: "::ffff:10.1.2.3", want: []string{"0.0.0.0/0", "10.0.0.0/8", "10.1.0.0/16", "10.1.2.3"}},
+		{addr: "10.1.9.9", want: []string{"0.0.0.0/0", "10.0.0.0/8", "10.1.0.0/16"}},
+		{addr: "10.2.0.1", want: []string{"0.0.0.0/0", "10.0.0.0/8"}},
+		{addr: "192.168.0.1", want: []string{"0.0.0.0/0"}},
+		{addr: "2001:db8:1::5", want: []string{"::/0", "2001:db8::/32", "2001:db8:1::/48"}},
+		{addr: "2001:db9::1", want: []string{"::/0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			got := trie.Match(netip.MustParseAddr(tt.addr))
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("Match = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if trie.Len() != 7 || len(trie.All()) != 7 {
+		t.Fatalf("Len = %d, All = %d, want 7", trie.Len(), len(trie.All()))
+	}
+	if got := trie.Match(netip.Addr{}); got != nil {
+		t.Fatalf("invalid address matched %v", got)
+	}
+}
+
+func TestMatchIPv4AndIPv6Apart(t *testing.T) {
+	var trie Trie[string]
+	trie.Insert(netip.MustParsePrefix("::/8"), "v6")
+	trie.Insert(netip.MustParsePrefix("0.0.0.0/8"), "v4")
+	if got := trie.Match(netip.MustParseAddr("0.0.0.1")); fmt.Sprint(got) != "[v4]" {
+		t.Fatalf("IPv4 matched %v", got)
+	}
+	if got := trie.Match(netip.MustParseAddr("::1")); fmt.Sprint(got) != "[v6]" {
+		t.Fatalf("IPv6 matched %v", got)
+	}
+}
@@ -116,6 +116,22 @@ func GetUserType(ctx context.Context) (int, error) {
 	return userType, nil
 }
 
+// GetImpersonator returns the admin user ID when the request comes with a token an admin uses to act as the user.
+func GetImpersonator(ctx context.Context) string {
+	if arr, _ := ctx.Value(constant.RpcImpersonator).([]string); len(arr) > 0 {
+		return arr[0]
+	}
+	return ""
+}
+
+// CheckNotImpersonated refuses requests made with a token an admin uses to act as the user.
+func CheckNotImpersonated(ctx context.Context) error {
+	if impersonator := GetImpersonator(ctx); impersonator != "" {
+		return errs.ErrNoPermission.WrapMsg("not allowed while an admin acts as the user", "impersonator", impersonator)
+	}
+	return nil
+}
+
 func WithOpUserID(ctx context.Context, opUserID string, userType int) context.Context {
 	headers, _ := ctx.Value(constant.RpcCustomHeader).([]string)
 	ctx = context.WithValue(ctx, constant.RpcOpUserID, opUserID)
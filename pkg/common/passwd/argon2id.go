@@ -0,0 +1,101 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passwd
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2idSaltLen = 16
+	argon2idKeyLen  = 32
+)
+
+func newArgon2id(memory uint32, iterations uint32, parallelism uint8) Algorithm {
+	if memory == 0 {
+		memory = 64 * 1024
+	}
+	if iterations == 0 {
+		iterations = 3
+	}
+	if parallelism == 0 {
+		parallelism = 2
+	}
+	return &argon2id{memory: memory, iterations: iterations, parallelism: parallelism}
+}
+
+// argon2id encodes as m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<key>.
+type argon2id struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+func (a *argon2id) Name() string {
+	return Argon2id
+}
+
+func (a *argon2id) Hash(password string) (string, error) {
+	salt := make([]byte, argon2idSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", errs.Wrap(err)
+	}
+	key := argon2.IDKey([]byte(password), salt, a.iterations, a.memory, a.parallelism, argon2idKeyLen)
+	return fmt.Sprintf("m=%d,t=%d,p=%d$%s$%s", a.memory, a.iterations, a.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+func (a *argon2id) Verify(encoded string, password string) (bool, error) {
+	memory, iterations, parallelism, salt, key, err := a.decode(encoded)
+	if err != nil {
+		return false, err
+	}
+	other := argon2.IDKey([]byte(password), salt, iterations, memory, parallelism, uint32(len(key)))
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+func (a *argon2id) NeedsRehash(encoded string) bool {
+	memory, iterations, parallelism, _, _, err := a.decode(encoded)
+	if err != nil {
+		return true
+	}
+	return memory < a.memory || iterations < a.iterations || parallelism < a.parallelism
+}
+
+func (a *argon2id) decode(encoded string) (memory uint32, iterations uint32, parallelism uint8, salt []byte, key []byte, err error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 3 {
+		return 0, 0, 0, nil, nil, errs.ErrInternalServer.WrapMsg("invalid argon2id hash")
+	}
+	if _, err := fmt.Sscanf(parts[0], "m=%d,t=%d,p=%d", &memory, &iterations, &parallelism); err != nil {
+		return 0, 0, 0, nil, nil, errs.WrapMsg(err, "invalid argon2id params")
+	}
+	salt, err = base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return 0, 0, 0, nil, nil, errs.WrapMsg(err, "invalid argon2id salt")
+	}
+	key, err = base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return 0, 0, 0, nil, nil, errs.WrapMsg(err, "invalid argon2id key")
+	}
+	return memory, iterations, parallelism, salt, key, nil
+}
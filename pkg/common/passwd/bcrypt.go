@@ -0,0 +1,61 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passwd
+
+import (
+	"errors"
+
+	"github.com/openimsdk/tools/errs"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newBcrypt(cost int) Algorithm {
+	if cost == 0 {
+		cost = bcrypt.DefaultCost
+	}
+	return &bcryptHash{cost: cost}
+}
+
+type bcryptHash struct {
+	cost int
+}
+
+func (b *bcryptHash) Name() string {
+	return Bcrypt
+}
+
+func (b *bcryptHash) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), b.cost)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return string(hash), nil
+}
+
+func (b *bcryptHash) Verify(encoded string, password string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return false, errs.Wrap(err)
+}
+
+func (b *bcryptHash) NeedsRehash(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	return err != nil || cost < b.cost
+}
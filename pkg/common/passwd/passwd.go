@@ -0,0 +1,108 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passwd
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/config"
+)
+
+const (
+	Argon2id = "argon2id"
+	Bcrypt   = "bcrypt"
+)
+
+// version is the prefix of every value produced by a Hasher. Stored passwords
+// without it are legacy values (the MD5 hex sent by the client) and are
+// compared as-is until they are rehashed.
+const version = "$v1$"
+
+// Algorithm hashes and verifies a password with a single algorithm.
+// The encoded value does not include the version prefix.
+type Algorithm interface {
+	Name() string
+	Hash(password string) (string, error)
+	Verify(encoded string, password string) (bool, error)
+	// NeedsRehash reports whether encoded was produced with weaker parameters than the current ones.
+	NeedsRehash(encoded string) bool
+}
+
+type Hasher struct {
+	use        Algorithm
+	algorithms map[string]Algorithm
+}
+
+func New(conf config.PasswordHash) (*Hasher, error) {
+	algorithms := []Algorithm{
+		newArgon2id(conf.Argon2id.Memory, conf.Argon2id.Iterations, conf.Argon2id.Parallelism),
+		newBcrypt(conf.Bcrypt.Cost),
+	}
+	h := &Hasher{algorithms: make(map[string]Algorithm)}
+	for _, a := range algorithms {
+		h.algorithms[a.Name()] = a
+	}
+	use := conf.Use
+	if use == "" {
+		use = Argon2id
+	}
+	var ok bool
+	h.use, ok = h.algorithms[use]
+	if !ok {
+		return nil, errs.New("unknown password hash algorithm", "use", conf.Use).Wrap()
+	}
+	return h, nil
+}
+
+// Hash returns the versioned hash of password using the configured algorithm.
+func (h *Hasher) Hash(password string) (string, error) {
+	if password == "" {
+		return "", errs.ErrArgs.WrapMsg("password is empty")
+	}
+	encoded, err := h.use.Hash(password)
+	if err != nil {
+		return "", err
+	}
+	return version + h.use.Name() + "$" + encoded, nil
+}
+
+// Verify checks password against stored. When the password matches but stored
+// is a legacy value or uses another algorithm or weaker parameters, rehash is true
+// and the caller should replace it with Hash(password).
+func (h *Hasher) Verify(stored string, password string) (match bool, rehash bool, err error) {
+	if password == "" || stored == "" {
+		return false, false, nil
+	}
+	if !strings.HasPrefix(stored, version) {
+		match = subtle.ConstantTimeCompare([]byte(stored), []byte(password)) == 1
+		return match, match, nil
+	}
+	name, encoded, ok := strings.Cut(stored[len(version):], "$")
+	if !ok {
+		return false, false, errs.ErrInternalServer.WrapMsg("invalid password hash")
+	}
+	a, ok := h.algorithms[name]
+	if !ok {
+		return false, false, errs.ErrInternalServer.WrapMsg("unknown password hash algorithm", "algorithm", name)
+	}
+	match, err = a.Verify(encoded, password)
+	if err != nil || !match {
+		return false, false, err
+	}
+	return true, a != h.use || a.NeedsRehash(encoded), nil
+}
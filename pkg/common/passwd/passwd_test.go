@@ -0,0 +1,142 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passwd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openimsdk/chat/pkg/common/config"
+)
+
+// newHasher returns a Hasher of use with cheap parameters, raised by stronger.
+func newHasher(t *testing.T, use string, stronger uint32) *Hasher {
+	t.Helper()
+	var conf config.PasswordHash
+	conf.Use = use
+	conf.Argon2id.Memory = 1024 * stronger
+	conf.Argon2id.Iterations = 1
+	conf.Argon2id.Parallelism = 1
+	conf.Bcrypt.Cost = 4 + int(stronger)
+	h, err := New(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+// legacyMD5 is the MD5 hex of "secret", which clients sent and older servers stored as-is.
+const legacyMD5 = "5ebe2294ecd0e0f08eab7690d2a6ee69"
+
+func TestHashRoundTrip(t *testing.T) {
+	for _, use := range []string{Argon2id, Bcrypt} {
+		t.Run(use, func(t *testing.T) {
+			h := newHasher(t, use, 1)
+			stored, err := h.Hash(legacyMD5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if prefix := version + use + "$"; !strings.HasPrefix(stored, prefix) {
+				t.Fatalf("hash %q does not start with %q", stored, prefix)
+			}
+			if other, _ := h.Hash(legacyMD5); other == stored {
+				t.Fatal("two hashes of one password are equal, salt missing")
+			}
+			match, rehash, err := h.Verify(stored, legacyMD5)
+			if err != nil || !match || rehash {
+				t.Fatalf("Verify = %v, %v, %v, want match without rehash", match, rehash, err)
+			}
+			match, rehash, err = h.Verify(stored, "wrong")
+			if err != nil || match || rehash {
+				t.Fatalf("Verify of a wrong password = %v, %v, %v", match, rehash, err)
+			}
+		})
+	}
+}
+
+func TestVerifyLegacy(t *testing.T) {
+	h := newHasher(t, Argon2id, 1)
+	tests := []struct {
+		name     string
+		stored   string
+		password string
+		match    bool
+	}{
+		{name: "match", stored: legacyMD5, password: legacyMD5, match: true},
+		{name: "mismatch", stored: legacyMD5, password: "5ebe2294ecd0e0f08eab7690d2a6ee60"},
+		{name: "empty stored", stored: "", password: legacyMD5},
+		{name: "empty password", stored: legacyMD5, password: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, rehash, err := h.Verify(tt.stored, tt.password)
+			if err != nil || match != tt.match || rehash != tt.match {
+				t.Fatalf("Verify = %v, %v, %v, want match and rehash %v", match, rehash, err, tt.match)
+			}
+		})
+	}
+}
+
+func TestVerifyRehash(t *testing.T) {
+	argon := newHasher(t, Argon2id, 1)
+	bcrypt := newHasher(t, Bcrypt, 1)
+	argonStored, err := argon.Hash(legacyMD5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bcryptStored, err := bcrypt.Hash(legacyMD5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	strongerStored, err := newHasher(t, Bcrypt, 2).Hash(legacyMD5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		h      *Hasher
+		stored string
+		rehash bool
+	}{
+		{name: "same algorithm", h: argon, stored: argonStored, rehash: false},
+		{name: "other algorithm", h: argon, stored: bcryptStored, rehash: true},
+		{name: "weaker argon2id", h: newHasher(t, Argon2id, 2), stored: argonStored, rehash: true},
+		{name: "weaker bcrypt", h: newHasher(t, Bcrypt, 2), stored: bcryptStored, rehash: true},
+		{name: "stronger bcrypt", h: bcrypt, stored: strongerStored, rehash: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, rehash, err := tt.h.Verify(tt.stored, legacyMD5)
+			if err != nil || !match || rehash != tt.rehash {
+				t.Fatalf("Verify = %v, %v, %v, want match and rehash %v", match, rehash, err, tt.rehash)
+			}
+		})
+	}
+}
+
+func TestVerifyInvalid(t *testing.T) {
+	h := newHasher(t, Argon2id, 1)
+	for _, stored := range []string{version + "md5$abc", version + "argon2id", version + Argon2id + "$m=1$x"} {
+		if match, _, err := h.Verify(stored, legacyMD5); err == nil || match {
+			t.Errorf("Verify(%q) = %v, %v, want an error", stored, match, err)
+		}
+	}
+	if _, err := h.Hash(""); err == nil {
+		t.Error("empty password hashed")
+	}
+	if _, err := New(config.PasswordHash{Use: "md5"}); err == nil {
+		t.Error("unknown algorithm accepted")
+	}
+}
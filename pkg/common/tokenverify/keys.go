@@ -0,0 +1,213 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenverify
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/config"
+)
+
+// Key is an asymmetric key identified by the kid header of the tokens it signs.
+type Key struct {
+	ID          string
+	Method      jwt.SigningMethod
+	private     crypto.Signer
+	public      crypto.PublicKey
+	signFrom    time.Time
+	verifyUntil time.Time
+}
+
+func (k *Key) canSign(now time.Time) bool {
+	return k.private != nil && !now.Before(k.signFrom) && k.canVerify(now)
+}
+
+func (k *Key) canVerify(now time.Time) bool {
+	return k.verifyUntil.IsZero() || now.Before(k.verifyUntil)
+}
+
+// KeySet holds the configured signing keys ordered by signFrom.
+type KeySet struct {
+	keys []*Key
+}
+
+// NewKeySet loads the configured keys. They are not reloaded: rotating means redeploying with the
+// next key configured, ahead of its signFrom.
+func NewKeySet(conf config.TokenSigning) (*KeySet, error) {
+	var ks KeySet
+	ids := make(map[string]struct{})
+	for _, c := range conf.Keys {
+		if c.Kid == "" {
+			return nil, errs.New("signing key kid is empty")
+		}
+		if _, ok := ids[c.Kid]; ok {
+			return nil, errs.New("duplicate signing key", "kid", c.Kid)
+		}
+		ids[c.Kid] = struct{}{}
+		key, err := loadKey(c)
+		if err != nil {
+			return nil, err
+		}
+		ks.keys = append(ks.keys, key)
+	}
+	sort.SliceStable(ks.keys, func(i, j int) bool {
+		return ks.keys[i].signFrom.Before(ks.keys[j].signFrom)
+	})
+	return &ks, nil
+}
+
+func loadKey(c config.SigningKey) (*Key, error) {
+	key := &Key{ID: c.Kid}
+	var err error
+	if c.SignFrom != "" {
+		if key.signFrom, err = time.Parse(time.RFC3339, c.SignFrom); err != nil {
+			return nil, errs.WrapMsg(err, "invalid signFrom", "kid", c.Kid)
+		}
+	}
+	if c.VerifyUntil != "" {
+		if key.verifyUntil, err = time.Parse(time.RFC3339, c.VerifyUntil); err != nil {
+			return nil, errs.WrapMsg(err, "invalid verifyUntil", "kid", c.Kid)
+		}
+	}
+	switch {
+	case c.PrivateKeyFile != "":
+		block, err := readPEM(c.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		var private any
+		if block.Type == "RSA PRIVATE KEY" {
+			private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		} else {
+			private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		}
+		if err != nil {
+			return nil, errs.WrapMsg(err, "parse private key failed", "kid", c.Kid)
+		}
+		signer, ok := private.(crypto.Signer)
+		if !ok {
+			return nil, errs.New("unsupported private key", "kid", c.Kid)
+		}
+		key.private = signer
+		key.public = signer.Public()
+	case c.PublicKeyFile != "":
+		block, err := readPEM(c.PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		if block.Type == "RSA PUBLIC KEY" {
+			key.public, err = x509.ParsePKCS1PublicKey(block.Bytes)
+		} else {
+			key.public, err = x509.ParsePKIXPublicKey(block.Bytes)
+		}
+		if err != nil {
+			return nil, errs.WrapMsg(err, "parse public key failed", "kid", c.Kid)
+		}
+	default:
+		return nil, errs.New("signing key has no key file", "kid", c.Kid)
+	}
+	switch key.public.(type) {
+	case *rsa.PublicKey:
+		key.Method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		key.Method = jwt.SigningMethodEdDSA
+	default:
+		return nil, errs.New("signing key must be RSA or Ed25519", "kid", c.Kid)
+	}
+	return key, nil
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "read key file failed", "path", path)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errs.New("no PEM data in key file", "path", path)
+	}
+	return block, nil
+}
+
+// signingKey returns the key new tokens are signed with, or nil if no key is active.
+func (s *KeySet) signingKey(now time.Time) *Key {
+	for i := len(s.keys) - 1; i >= 0; i-- {
+		if s.keys[i].canSign(now) {
+			return s.keys[i]
+		}
+	}
+	return nil
+}
+
+func (s *KeySet) verifyKey(kid string, now time.Time) *Key {
+	for _, key := range s.keys {
+		if key.ID == kid && key.canVerify(now) {
+			return key
+		}
+	}
+	return nil
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS encodes the keys that still verify tokens, including those not signing yet,
+// so verifiers learn a key before the first token signed with it.
+func (s *KeySet) JWKS(now time.Time) ([]byte, error) {
+	keys := make([]jwk, 0, len(s.keys))
+	for _, key := range s.keys {
+		if !key.canVerify(now) {
+			continue
+		}
+		k := jwk{Kid: key.ID, Use: "sig", Alg: key.Method.Alg()}
+		switch public := key.public.(type) {
+		case *rsa.PublicKey:
+			k.Kty = "RSA"
+			k.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			k.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			k.Kty = "OKP"
+			k.Crv = "Ed25519"
+			k.X = base64.RawURLEncoding.EncodeToString(public)
+		}
+		keys = append(keys, k)
+	}
+	data, err := json.Marshal(map[string]any{"keys": keys})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return data, nil
+}
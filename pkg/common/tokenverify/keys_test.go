@@ -0,0 +1,201 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenverify
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/openimsdk/chat/pkg/common/config"
+)
+
+// writeKey writes a PKCS #8 PEM private key of kind "rsa" or "ed25519" and returns its path.
+func writeKey(t *testing.T, kind string) string {
+	t.Helper()
+	var private any
+	switch kind {
+	case "rsa":
+		k, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatal(err)
+		}
+		private = k
+	case "ed25519":
+		_, k, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		private = k
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), kind+".pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func rfc3339(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
+
+func newToken(t *testing.T, keys ...config.SigningKey) *Token {
+	t.Helper()
+	ks, err := NewKeySet(config.TokenSigning{Keys: keys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Token{Expires: time.Hour, Keys: ks}
+}
+
+// header returns the JOSE header of a signed token.
+func header(t *testing.T, token string) map[string]any {
+	t.Helper()
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &claims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed.Header
+}
+
+func TestKeySignVerify(t *testing.T) {
+	tests := []struct {
+		kind string
+		alg  string
+	}{
+		{kind: "rsa", alg: "RS256"},
+		{kind: "ed25519", alg: "EdDSA"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.alg, func(t *testing.T) {
+			tk := newToken(t, config.SigningKey{Kid: tt.kind, PrivateKeyFile: writeKey(t, tt.kind)})
+			token, _, err := tk.CreateToken("u1", TokenUser, "s1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if h := header(t, token); h["alg"] != tt.alg || h["kid"] != tt.kind {
+				t.Fatalf("header = %v, want alg %s kid %s", h, tt.alg, tt.kind)
+			}
+			info, err := tk.Parse(token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.UserID != "u1" || info.SessionID != "s1" {
+				t.Fatalf("parsed %+v", info)
+			}
+			jwks, err := tk.JWKS()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(jwks), `"alg":"`+tt.alg+`"`) {
+				t.Fatalf("jwks = %s", jwks)
+			}
+		})
+	}
+}
+
+func TestKeySelectionByKid(t *testing.T) {
+	now := time.Now()
+	old := config.SigningKey{Kid: "old", PrivateKeyFile: writeKey(t, "ed25519"), SignFrom: rfc3339(now.Add(-48 * time.Hour))}
+	current := config.SigningKey{Kid: "current", PrivateKeyFile: writeKey(t, "rsa"), SignFrom: rfc3339(now.Add(-time.Hour))}
+	next := config.SigningKey{Kid: "next", PrivateKeyFile: writeKey(t, "ed25519"), SignFrom: rfc3339(now.Add(time.Hour))}
+
+	oldToken, _, err := newToken(t, old).CreateToken("u1", TokenAdmin, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tk := newToken(t, next, old, current)
+	token, _, err := tk.CreateToken("u1", TokenAdmin, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kid := header(t, token)["kid"]; kid != "current" {
+		t.Fatalf("signed with %v, want the latest key whose signFrom passed", kid)
+	}
+	if _, err := tk.Parse(oldToken); err != nil {
+		t.Fatalf("token of an older key not verified: %v", err)
+	}
+	jwks, err := tk.JWKS()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(jwks), `"kid":"next"`) {
+		t.Fatalf("key signing later not published: %s", jwks)
+	}
+}
+
+func TestKeyRejectsMismatchedAlg(t *testing.T) {
+	tk := newToken(t, config.SigningKey{Kid: "rsa", PrivateKeyFile: writeKey(t, "rsa")})
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, tk.buildClaims("u1", TokenAdmin, "", time.Hour))
+	forged.Header["kid"] = "rsa"
+	token, err := forged.SignedString([]byte("guessed"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tk.Parse(token); err == nil {
+		t.Fatal("HS256 token accepted for an RS256 key")
+	}
+	unknown := jwt.NewWithClaims(jwt.SigningMethodHS256, tk.buildClaims("u1", TokenAdmin, "", time.Hour))
+	unknown.Header["kid"] = "nobody"
+	token, err = unknown.SignedString([]byte("guessed"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tk.Parse(token); err == nil {
+		t.Fatal("token of an unknown kid accepted")
+	}
+}
+
+func TestKeyVerifyUntil(t *testing.T) {
+	now := time.Now()
+	retired := config.SigningKey{Kid: "retired", PrivateKeyFile: writeKey(t, "ed25519")}
+	token, _, err := newToken(t, retired).CreateToken("u1", TokenUser, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	retired.VerifyUntil = rfc3339(now.Add(-time.Minute))
+	tk := newToken(t, retired)
+	tk.Secret = "secret"
+	if _, err := tk.Parse(token); err == nil {
+		t.Fatal("token of a key past verifyUntil accepted")
+	}
+	signed, _, err := tk.CreateToken("u1", TokenUser, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h := header(t, signed); h["alg"] != "HS256" || h["kid"] != nil {
+		t.Fatalf("signed with a key past verifyUntil: %v", h)
+	}
+	jwks, err := tk.JWKS()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(jwks), "retired") {
+		t.Fatalf("key past verifyUntil published: %s", jwks)
+	}
+}
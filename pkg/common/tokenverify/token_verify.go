@@ -31,82 +31,175 @@ type claims struct {
 	UserID     string
 	UserType   int32
 	PlatformID int32
+	SessionID  string
+	// Impersonator is the admin user ID of a user token issued to an admin.
+	Impersonator string `json:",omitempty"`
 	jwt.RegisteredClaims
 }
 
+// TokenInfo is what a verified token carries.
+type TokenInfo struct {
+	UserID       string
+	UserType     int32
+	SessionID    string
+	Impersonator string
+}
+
 type Token struct {
 	Expires time.Duration
-	Secret  string
+	// UserExpires is the lifetime of user access tokens, which are renewed with a refresh token.
+	// Zero means user tokens live as long as Expires.
+	UserExpires time.Duration
+	// Secret signs and verifies HS256 tokens, which carry no kid.
+	Secret string
+	// Keys signs with RS256/EdDSA when one of its keys is active, falling back to Secret.
+	Keys *KeySet
+}
+
+func (t *Token) expires(userType int32) time.Duration {
+	if userType == TokenUser && t.UserExpires > 0 {
+		return t.UserExpires
+	}
+	return t.Expires
 }
 
-func (t *Token) secret() jwt.Keyfunc {
+func (t *Token) keyFunc() jwt.Keyfunc {
 	return func(token *jwt.Token) (any, error) {
-		return []byte(t.Secret), nil
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			if t.Secret == "" || token.Method != jwt.SigningMethodHS256 {
+				return nil, errs.ErrTokenUnknown.WrapMsg("token without kid not accepted")
+			}
+			return []byte(t.Secret), nil
+		}
+		var key *Key
+		if t.Keys != nil {
+			key = t.Keys.verifyKey(kid, time.Now())
+		}
+		if key == nil {
+			return nil, errs.ErrTokenUnknown.WrapMsg("unknown token key", "kid", kid)
+		}
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, errs.ErrTokenUnknown.WrapMsg("token alg does not match key", "kid", kid)
+		}
+		return key.public, nil
+	}
+}
+
+func (t *Token) sign(c claims) (string, error) {
+	if t.Keys != nil {
+		if key := t.Keys.signingKey(time.Now()); key != nil {
+			token := jwt.NewWithClaims(key.Method, c)
+			token.Header["kid"] = key.ID
+			return token.SignedString(key.private)
+		}
+	}
+	if t.Secret == "" {
+		return "", errs.ErrInternalServer.WrapMsg("no active token signing key")
 	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString([]byte(t.Secret))
 }
 
-func (t *Token) buildClaims(userID string, userType int32) claims {
+// JWKS returns the public keys verifying tokens as a JSON Web Key Set.
+func (t *Token) JWKS() ([]byte, error) {
+	if t.Keys == nil {
+		return (&KeySet{}).JWKS(time.Now())
+	}
+	return t.Keys.JWKS(time.Now())
+}
+
+func (t *Token) buildClaims(userID string, userType int32, sessionID string, expires time.Duration) claims {
 	now := time.Now()
 	return claims{
-		UserID:   userID,
-		UserType: userType,
+		UserID:    userID,
+		UserType:  userType,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(t.Expires)),    // Expiration time
+			ExpiresAt: jwt.NewNumericDate(now.Add(expires)),      // Expiration time
 			IssuedAt:  jwt.NewNumericDate(now),                   // Issuing time
 			NotBefore: jwt.NewNumericDate(now.Add(-time.Minute)), // Begin Effective time
 		},
 	}
 }
 
-func (t *Token) getToken(str string) (string, int32, error) {
-	token, err := jwt.ParseWithClaims(str, &claims{}, t.secret())
+func (t *Token) getToken(str string) (*claims, error) {
+	token, err := jwt.ParseWithClaims(str, &claims{}, t.keyFunc())
 	if err != nil {
 		if ve, ok := err.(*jwt.ValidationError); ok {
 			if ve.Errors&jwt.ValidationErrorMalformed != 0 {
-				return "", 0, errs.ErrTokenMalformed.Wrap()
+				return nil, errs.ErrTokenMalformed.Wrap()
 			} else if ve.Errors&jwt.ValidationErrorExpired != 0 {
-				return "", 0, errs.ErrTokenExpired.Wrap()
+				return nil, errs.ErrTokenExpired.Wrap()
 			} else if ve.Errors&jwt.ValidationErrorNotValidYet != 0 {
-				return "", 0, errs.ErrTokenNotValidYet.Wrap()
+				return nil, errs.ErrTokenNotValidYet.Wrap()
 			} else {
-				return "", 0, errs.ErrTokenUnknown.Wrap()
+				return nil, errs.ErrTokenUnknown.Wrap()
 			}
 		} else {
-			return "", 0, errs.ErrTokenNotValidYet.Wrap()
+			return nil, errs.ErrTokenNotValidYet.Wrap()
 		}
 	} else {
 		claims, ok := token.Claims.(*claims)
 		if claims.PlatformID != 0 {
-			return "", 0, errs.ErrTokenExpired.Wrap()
+			return nil, errs.ErrTokenExpired.Wrap()
 		}
 		if ok && token.Valid {
-			return claims.UserID, claims.UserType, nil
+			return claims, nil
 		}
-		return "", 0, errs.ErrTokenNotValidYet.Wrap()
+		return nil, errs.ErrTokenNotValidYet.Wrap()
 	}
 }
 
-func (t *Token) CreateToken(UserID string, userType int32) (string, time.Duration, error) {
+func (t *Token) CreateToken(UserID string, userType int32, sessionID string) (string, time.Duration, error) {
 	if !(userType == TokenUser || userType == TokenAdmin) {
 		return "", 0, errs.ErrTokenUnknown.WrapMsg("token type unknown")
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, t.buildClaims(UserID, userType))
-	str, err := token.SignedString([]byte(t.Secret))
+	expires := t.expires(userType)
+	str, err := t.sign(t.buildClaims(UserID, userType, sessionID, expires))
 	if err != nil {
 		return "", 0, errs.Wrap(err)
 	}
-	return str, t.Expires, nil
+	return str, expires, nil
 }
 
-func (t *Token) GetToken(token string) (string, int32, error) {
-	userID, userType, err := t.getToken(token)
+// CreateImpersonationToken signs a user token for an admin, marked with the admin user ID.
+func (t *Token) CreateImpersonationToken(userID string, impersonator string, sessionID string, expires time.Duration) (string, error) {
+	c := t.buildClaims(userID, TokenUser, sessionID, expires)
+	c.Impersonator = impersonator
+	str, err := t.sign(c)
 	if err != nil {
-		return "", 0, err
+		return "", errs.Wrap(err)
 	}
-	if !(userType == TokenUser || userType == TokenAdmin) {
-		return "", 0, errs.ErrTokenUnknown.WrapMsg("token type unknown")
+	return str, nil
+}
+
+// GetToken returns the user ID, user type and session ID carried by token.
+func (t *Token) GetToken(token string) (string, int32, string, error) {
+	info, err := t.Parse(token)
+	if err != nil {
+		return "", 0, "", err
+	}
+	return info.UserID, info.UserType, info.SessionID, nil
+}
+
+// Parse verifies token and returns everything it carries.
+func (t *Token) Parse(token string) (*TokenInfo, error) {
+	c, err := t.getToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if !(c.UserType == TokenUser || c.UserType == TokenAdmin) {
+		return nil, errs.ErrTokenUnknown.WrapMsg("token type unknown")
+	}
+	if c.Impersonator != "" && c.UserType != TokenUser {
+		return nil, errs.ErrTokenUnknown.WrapMsg("impersonation token type error")
 	}
-	return userID, userType, nil
+	return &TokenInfo{
+		UserID:       c.UserID,
+		UserType:     c.UserType,
+		SessionID:    c.SessionID,
+		Impersonator: c.Impersonator,
+	}, nil
 }
 
 //func (t *Token) GetAdminTokenCache(token string) (string, error) {
@@ -0,0 +1,138 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package totp implements RFC 6238 time-based one-time passwords (SHA1, 6 digits, 30s)
+// and the recovery codes handed out alongside them.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	period = 30
+	digits = 6
+	// skew is the number of periods accepted before and after the current one.
+	skew = 1
+
+	secretLen         = 20
+	recoveryCodeCount = 10
+	recoveryCodeLen   = 10
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func GenerateSecret() (string, error) {
+	buf := make([]byte, secretLen)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return encoding.EncodeToString(buf), nil
+}
+
+// URL returns the otpauth URL rendered as a QR code by authenticator apps.
+func URL(issuer string, account string, secret string) string {
+	label := url.PathEscape(issuer + ":" + account)
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(digits))
+	v.Set("period", fmt.Sprint(period))
+	return "otpauth://totp/" + label + "?" + v.Encode()
+}
+
+func code(key []byte, counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", digits, value%1000000)
+}
+
+// Validate checks passcode against secret at now. On success it returns the matched
+// time step, which callers persist and pass back as last to reject replays.
+func Validate(secret string, passcode string, now time.Time, last int64) (int64, bool) {
+	passcode = strings.TrimSpace(passcode)
+	if len(passcode) != digits {
+		return 0, false
+	}
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, false
+	}
+	current := now.Unix() / period
+	for i := -skew; i <= skew; i++ {
+		counter := current + int64(i)
+		if counter <= last {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(code(key, counter)), []byte(passcode)) == 1 {
+			return counter, true
+		}
+	}
+	return 0, false
+}
+
+// GenerateRecoveryCodes returns the plain codes shown to the user once and the hashes to store.
+func GenerateRecoveryCodes() ([]string, []string, error) {
+	const chars = "abcdefghijklmnopqrstuvwxyz234567"
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	buf := make([]byte, recoveryCodeLen)
+	for i := range codes {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, errs.Wrap(err)
+		}
+		for j := range buf {
+			buf[j] = chars[int(buf[j])%len(chars)]
+		}
+		codes[i] = string(buf[:recoveryCodeLen/2]) + "-" + string(buf[recoveryCodeLen/2:])
+		hashes[i] = HashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// MatchRecoveryCode returns the index of code in hashes, or -1.
+func MatchRecoveryCode(hashes []string, code string) int {
+	hash := HashRecoveryCode(code)
+	for i, h := range hashes {
+		if subtle.ConstantTimeCompare([]byte(h), []byte(hash)) == 1 {
+			return i
+		}
+	}
+	return -1
+}
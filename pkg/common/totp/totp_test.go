@@ -0,0 +1,100 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA1 seed of RFC 6238 appendix B, "12345678901234567890", in base32.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestValidateRFC6238(t *testing.T) {
+	// the 8 digit codes of RFC 6238 appendix B, cut to their last 6 digits
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1111111111, code: "050471"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+		{unix: 20000000000, code: "353130"},
+	}
+	for _, tt := range tests {
+		counter, ok := Validate(rfcSecret, tt.code, time.Unix(tt.unix, 0), 0)
+		if !ok || counter != tt.unix/period {
+			t.Errorf("Validate(%s at %d) = %d, %v, want %d", tt.code, tt.unix, counter, ok, tt.unix/period)
+		}
+		if _, ok := Validate(strings.ToLower(rfcSecret), " "+tt.code+" ", time.Unix(tt.unix, 0), 0); !ok {
+			t.Errorf("Validate(%s at %d) with a lower case secret and spaces failed", tt.code, tt.unix)
+		}
+	}
+}
+
+func TestValidateWindow(t *testing.T) {
+	now := time.Unix(1111111111, 0) // code 050471 at counter 37037037
+	tests := []struct {
+		name string
+		at   time.Time
+		code string
+		last int64
+		ok   bool
+	}{
+		{name: "current", at: now, code: "050471", ok: true},
+		{name: "one period late", at: now.Add(period * time.Second), code: "050471", ok: true},
+		{name: "one period early", at: now.Add(-period * time.Second), code: "050471", ok: true},
+		{name: "two periods late", at: now.Add(2 * period * time.Second), code: "050471", ok: false},
+		{name: "replayed", at: now, code: "050471", last: 37037037, ok: false},
+		{name: "after an older code", at: now, code: "050471", last: 37037036, ok: true},
+		{name: "wrong", at: now, code: "050472", ok: false},
+		{name: "short", at: now, code: "05047", ok: false},
+	}
+	for _, tt := range tests {
+		if _, ok := Validate(rfcSecret, tt.code, tt.at, tt.last); ok != tt.ok {
+			t.Errorf("%s: ok = %v, want %v", tt.name, ok, tt.ok)
+		}
+	}
+	if _, ok := Validate("not base32!", "050471", now, 0); ok {
+		t.Error("invalid secret accepted")
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, hashes, err := GenerateRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != recoveryCodeCount || len(hashes) != recoveryCodeCount {
+		t.Fatalf("%d codes, %d hashes, want %d", len(codes), len(hashes), recoveryCodeCount)
+	}
+	for i, code := range codes {
+		if len(code) != recoveryCodeLen+1 || code[recoveryCodeLen/2] != '-' {
+			t.Fatalf("code %q not formatted as xxxxx-xxxxx", code)
+		}
+		if got := MatchRecoveryCode(hashes, code); got != i {
+			t.Fatalf("MatchRecoveryCode(%q) = %d, want %d", code, got, i)
+		}
+		if got := MatchRecoveryCode(hashes, strings.ToUpper(strings.ReplaceAll(code, "-", ""))); got != i {
+			t.Fatalf("code %q typed without dash in upper case not matched", code)
+		}
+	}
+	if got := MatchRecoveryCode(hashes, "aaaaa-aaaaa"); got != -1 {
+		t.Fatalf("unknown code matched %d", got)
+	}
+}
@@ -31,6 +31,19 @@ var (
 	ErrForbidden                = errs.NewCodeError(20012, "Forbidden")
 	ErrRefuseFriend             = errs.NewCodeError(20013, "RefuseFriend")
 	ErrEmailAlreadyRegister     = errs.NewCodeError(20014, "EmailAlreadyRegister")
+	ErrTOTPNotMatch             = errs.NewCodeError(20015, "TOTPNotMatch")
+	ErrTOTPTicketInvalid        = errs.NewCodeError(20016, "TOTPTicketInvalid")
+	ErrLoginLocked              = errs.NewCodeError(20017, "LoginLocked")
+	ErrTooManyRequests          = errs.NewCodeError(20018, "TooManyRequests")
+	ErrCaptchaRequired          = errs.NewCodeError(20019, "CaptchaRequired")
+	ErrCaptchaInvalid           = errs.NewCodeError(20020, "CaptchaInvalid")
+	ErrVerifyCodeRequired       = errs.NewCodeError(20021, "VerifyCodeRequired")
+	ErrAccountBlocked           = errs.NewCodeError(20022, "AccountBlocked")
+	ErrAppealTicketInvalid      = errs.NewCodeError(20023, "AppealTicketInvalid")
+	ErrContentRejected          = errs.NewCodeError(20024, "ContentRejected")
+	ErrAlreadyBlocked           = errs.NewCodeError(20025, "AlreadyBlocked")
 
-	ErrTokenNotExist = errs.NewCodeError(20101, "ErrTokenNotExist")
+	ErrTokenNotExist       = errs.NewCodeError(20101, "ErrTokenNotExist")
+	ErrRefreshTokenInvalid = errs.NewCodeError(20102, "RefreshTokenInvalid")
+	ErrRefreshTokenReused  = errs.NewCodeError(20103, "RefreshTokenReused")
 )
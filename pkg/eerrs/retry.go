@@ -0,0 +1,36 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eerrs
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// LoginLocked returns ErrLoginLocked with the seconds until the lock ends as its detail.
+func LoginLocked(remaining time.Duration) error {
+	return ErrLoginLocked.WithDetail(RetrySeconds(remaining)).Wrap()
+}
+
+// TooManyRequests returns ErrTooManyRequests with the seconds to wait before retrying as its detail.
+func TooManyRequests(wait time.Duration) error {
+	return ErrTooManyRequests.WithDetail(RetrySeconds(wait)).Wrap()
+}
+
+// RetrySeconds formats d as whole seconds, rounded up, as used by the Retry-After header.
+func RetrySeconds(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+}
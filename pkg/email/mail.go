@@ -16,40 +16,213 @@ package email
 
 import (
 	"context"
-	"fmt"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/idutil"
 	"gopkg.in/gomail.v2"
 )
 
 type Mail interface {
 	Name() string
-	SendMail(ctx context.Context, mail string, verifyCode string) error
+	// SendMail sends text with html as its alternative when html is set, and returns the Message-ID of the mail.
+	SendMail(ctx context.Context, mail string, subject string, html string, text string) (string, error)
+	SendNotice(ctx context.Context, mail string, subject string, body string) (string, error)
 }
 
-func NewMail(smtpAddr string, smtpPort int, senderMail, senderAuthorizationCode, title string) Mail {
-	dail := gomail.NewDialer(smtpAddr, smtpPort, senderMail, senderAuthorizationCode)
+// TLS modes of an SMTP server.
+const (
+	TLSAuto     = ""         // STARTTLS when the server offers it
+	TLSStartTLS = "starttls" // refuse servers without STARTTLS
+	TLSImplicit = "implicit" // TLS from the start of the connection, usually on port 465
+	TLSNone     = "none"     // plain text, for local test servers
+)
+
+// NewMail sends through an SMTP server. senderName is the display name of From, caFile a PEM bundle
+// to verify the server with instead of the system roots. Without senderAuthorizationCode it does not
+// authenticate.
+func NewMail(smtpAddr string, smtpPort int, senderMail, senderName, senderAuthorizationCode, tlsMode, caFile string) (Mail, error) {
+	if smtpAddr == "" || smtpPort <= 0 || senderMail == "" {
+		return nil, errs.New("smtp smtpAddr, smtpPort and senderMail are required")
+	}
+	tlsMode = strings.ToLower(tlsMode)
+	switch tlsMode {
+	case TLSAuto, TLSStartTLS, TLSImplicit, TLSNone:
+	default:
+		return nil, errs.New("unknown smtp tls mode", "tls", tlsMode)
+	}
+	tlsConfig := &tls.Config{ServerName: smtpAddr, MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "read smtp ca file failed", "caFile", caFile)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errs.New("no certificates in smtp ca file", "caFile", caFile)
+		}
+	}
 	return &mail{
-		title:      title,
+		addr:       net.JoinHostPort(smtpAddr, strconv.Itoa(smtpPort)),
+		host:       smtpAddr,
 		senderMail: senderMail,
-		dail:       dail,
-	}
+		senderName: senderName,
+		password:   senderAuthorizationCode,
+		tlsMode:    tlsMode,
+		tlsConfig:  tlsConfig,
+	}, nil
 }
 
 type mail struct {
+	addr       string
+	host       string
 	senderMail string
-	title      string
-	dail       *gomail.Dialer
+	senderName string
+	password   string
+	tlsMode    string
+	tlsConfig  *tls.Config
 }
 
 func (m *mail) Name() string {
-	return "mail"
+	return "smtp"
 }
 
-func (m *mail) SendMail(ctx context.Context, mail string, verifyCode string) error {
+// newMessage sets a Message-ID of the sender's domain, so a delivery can be found in the mail logs.
+func newMessage(senderMail string, senderName string, to string, subject string) (*gomail.Message, string) {
+	domain := "localhost"
+	if i := strings.LastIndex(senderMail, "@"); i >= 0 {
+		domain = senderMail[i+1:]
+	}
+	id := "<" + idutil.OperationIDGenerator() + "@" + domain + ">"
 	msg := gomail.NewMessage()
-	msg.SetHeader(`From`, m.senderMail)
-	msg.SetHeader(`To`, []string{mail}...)
-	msg.SetHeader(`Subject`, m.title)
-	msg.SetBody(`text/html`, fmt.Sprintf("Your verification code is: %s. This code is valid for 5 minutes and should not be shared with others", verifyCode))
-	return errs.Wrap(m.dail.DialAndSend(msg))
+	msg.SetHeader(`Message-ID`, id)
+	msg.SetAddressHeader(`From`, senderMail, senderName)
+	msg.SetHeader(`To`, []string{to}...)
+	msg.SetHeader(`Subject`, subject)
+	return msg, id
+}
+
+func (m *mail) SendMail(ctx context.Context, mail string, subject string, html string, text string) (string, error) {
+	msg, id := newMessage(m.senderMail, m.senderName, mail, subject)
+	msg.SetBody(`text/plain`, text)
+	if html != "" {
+		msg.AddAlternative(`text/html`, html)
+	}
+	if err := m.send(ctx, mail, msg); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func (m *mail) SendNotice(ctx context.Context, mail string, subject string, body string) (string, error) {
+	msg, id := newMessage(m.senderMail, m.senderName, mail, subject)
+	msg.SetBody(`text/plain`, body)
+	if err := m.send(ctx, mail, msg); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// send delivers msg in one SMTP session, upgrading it to TLS as the tls mode asks.
+func (m *mail) send(ctx context.Context, to string, msg *gomail.Message) error {
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	var (
+		conn net.Conn
+		err  error
+	)
+	if m.tlsMode == TLSImplicit {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: m.tlsConfig}).DialContext(ctx, "tcp", m.addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", m.addr)
+	}
+	if err != nil {
+		return errs.WrapMsg(err, "dial smtp server failed", "addr", m.addr)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	} else {
+		_ = conn.SetDeadline(time.Now().Add(time.Minute))
+	}
+	c, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return errs.Wrap(err)
+	}
+	defer c.Close()
+	if m.tlsMode == TLSAuto || m.tlsMode == TLSStartTLS {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(m.tlsConfig); err != nil {
+				return errs.WrapMsg(err, "smtp starttls failed", "addr", m.addr)
+			}
+		} else if m.tlsMode == TLSStartTLS {
+			return errs.New("smtp server does not offer STARTTLS", "addr", m.addr)
+		}
+	}
+	if m.password != "" {
+		ok, mechanisms := c.Extension("AUTH")
+		if !ok {
+			return errs.New("smtp server does not offer AUTH", "addr", m.addr)
+		}
+		var auth smtp.Auth
+		if strings.Contains(mechanisms, "PLAIN") || !strings.Contains(mechanisms, "LOGIN") {
+			auth = smtp.PlainAuth("", m.senderMail, m.password, m.host)
+		} else {
+			auth = &loginAuth{username: m.senderMail, password: m.password}
+		}
+		if err := c.Auth(auth); err != nil {
+			return errs.WrapMsg(err, "smtp auth failed", "addr", m.addr)
+		}
+	}
+	if err := c.Mail(m.senderMail); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := c.Rcpt(to); err != nil {
+		return errs.Wrap(err)
+	}
+	w, err := c.Data()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if _, err := msg.WriteTo(w); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := w.Close(); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(c.Quit())
+}
+
+// loginAuth is the LOGIN mechanism, for servers not offering PLAIN.
+type loginAuth struct {
+	username string
+	password string
+}
+
+func (a *loginAuth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	// like smtp.PlainAuth, only local test servers may see the password in plain text
+	if !server.TLS && server.Name != "localhost" && server.Name != "127.0.0.1" && server.Name != "::1" {
+		return "", nil, errs.New("smtp login auth over an unencrypted connection")
+	}
+	return "LOGIN", nil, nil
+}
+
+func (a *loginAuth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if !more {
+		return nil, nil
+	}
+	switch strings.ToLower(strings.TrimSpace(string(fromServer))) {
+	case "username:":
+		return []byte(a.username), nil
+	case "password:":
+		return []byte(a.password), nil
+	default:
+		return nil, errs.New("unexpected smtp login challenge", "challenge", string(fromServer))
+	}
 }
@@ -0,0 +1,93 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	netmail "net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// NewMailgun sends through the Mailgun messages API of domain; baseURL defaults to the US region,
+// set https://api.eu.mailgun.net for the EU one.
+func NewMailgun(domain, apiKey, baseURL, senderMail, senderName string) (Mail, error) {
+	if domain == "" || apiKey == "" || senderMail == "" {
+		return nil, errs.New("mailgun domain, apiKey and senderMail are required")
+	}
+	if baseURL == "" {
+		baseURL = "https://api.mailgun.net"
+	}
+	return &mailgun{
+		url:    strings.TrimSuffix(baseURL, "/") + "/v3/" + url.PathEscape(domain) + "/messages",
+		apiKey: apiKey,
+		from:   (&netmail.Address{Name: senderName, Address: senderMail}).String(),
+		client: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+type mailgun struct {
+	url    string
+	apiKey string
+	from   string
+	client *http.Client
+}
+
+func (m *mailgun) Name() string {
+	return "mailgun"
+}
+
+func (m *mailgun) SendMail(ctx context.Context, mail string, subject string, html string, text string) (string, error) {
+	form := url.Values{
+		"from":    {m.from},
+		"to":      {mail},
+		"subject": {subject},
+		"text":    {text},
+	}
+	if html != "" {
+		form.Set("html", html)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	req.SetBasicAuth("api", m.apiKey)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	var res struct {
+		ID      string `json:"id"`
+		Message string `json:"message"`
+	}
+	_ = json.Unmarshal(data, &res)
+	if resp.StatusCode < 300 {
+		return res.ID, nil
+	}
+	return "", errs.New("mailgun send failed", "status", resp.StatusCode, "message", res.Message)
+}
+
+func (m *mailgun) SendNotice(ctx context.Context, mail string, subject string, body string) (string, error) {
+	return m.SendMail(ctx, mail, subject, "", body)
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestMailgunSendMail(t *testing.T) {
+	var (
+		form     url.Values
+		path     string
+		user     string
+		password string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		user, password, _ = r.BasicAuth()
+		_ = r.ParseForm()
+		form = r.PostForm
+		_, _ = io.WriteString(w, `{"id":"<20260101.1@mg.example.com>","message":"Queued. Thank you."}`)
+	}))
+	defer server.Close()
+	m, err := NewMailgun("mg.example.com", "key-123", server.URL+"/", "noreply@example.com", "Example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := m.SendMail(context.Background(), "user@example.org", "Your code", "<b>123456</b>", "123456")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "<20260101.1@mg.example.com>" {
+		t.Errorf("message id = %q", id)
+	}
+	if path != "/v3/mg.example.com/messages" {
+		t.Errorf("path = %q", path)
+	}
+	if user != "api" || password != "key-123" {
+		t.Errorf("basic auth = %q/%q", user, password)
+	}
+	want := map[string]string{
+		"from":    `"Example" <noreply@example.com>`,
+		"to":      "user@example.org",
+		"subject": "Your code",
+		"text":    "123456",
+		"html":    "<b>123456</b>",
+	}
+	for k, v := range want {
+		if got := form.Get(k); got != v {
+			t.Errorf("%s = %q, want %q", k, got, v)
+		}
+	}
+}
+
+func TestMailgunNoticeAndError(t *testing.T) {
+	var form url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		form = r.PostForm
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = io.WriteString(w, `{"message":"Invalid private key"}`)
+	}))
+	defer server.Close()
+	m, err := NewMailgun("mg.example.com", "key-123", server.URL, "noreply@example.com", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.SendNotice(context.Background(), "user@example.org", "Notice", "body"); err == nil || !strings.Contains(err.Error(), "Invalid private key") {
+		t.Fatalf("err = %v, want the mailgun error", err)
+	}
+	if form.Has("html") || form.Get("from") != "<noreply@example.com>" {
+		t.Errorf("notice form = %v", form)
+	}
+}
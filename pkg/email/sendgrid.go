@@ -0,0 +1,98 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// NewSendGrid sends through the SendGrid v3 mail send API.
+func NewSendGrid(apiKey, senderMail, senderName string) (Mail, error) {
+	if apiKey == "" || senderMail == "" {
+		return nil, errs.New("sendgrid apiKey and senderMail are required")
+	}
+	return &sendGrid{
+		url:        "https://api.sendgrid.com/v3/mail/send",
+		apiKey:     apiKey,
+		senderMail: senderMail,
+		senderName: senderName,
+		client:     &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+type sendGrid struct {
+	url        string
+	apiKey     string
+	senderMail string
+	senderName string
+	client     *http.Client
+}
+
+type sendGridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type sendGridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+func (s *sendGrid) Name() string {
+	return "sendgrid"
+}
+
+func (s *sendGrid) SendMail(ctx context.Context, mail string, subject string, html string, text string) (string, error) {
+	content := []sendGridContent{{Type: "text/plain", Value: text}}
+	if html != "" {
+		content = append(content, sendGridContent{Type: "text/html", Value: html})
+	}
+	payload, err := json.Marshal(map[string]any{
+		"personalizations": []map[string]any{{"to": []sendGridAddress{{Email: mail}}}},
+		"from":             sendGridAddress{Email: s.senderMail, Name: s.senderName},
+		"subject":          subject,
+		"content":          content,
+	})
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(payload))
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 300 {
+		return resp.Header.Get("X-Message-Id"), nil
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	return "", errs.New("sendgrid send failed", "status", resp.StatusCode, "body", string(data))
+}
+
+func (s *sendGrid) SendNotice(ctx context.Context, mail string, subject string, body string) (string, error) {
+	return s.SendMail(ctx, mail, subject, "", body)
+}
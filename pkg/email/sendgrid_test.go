@@ -0,0 +1,91 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSendGridSendMail(t *testing.T) {
+	var (
+		payload map[string]any
+		header  http.Header
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		w.Header().Set("X-Message-Id", "sg-123")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	m, err := NewSendGrid("SG.key", "noreply@example.com", "Example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.(*sendGrid).url = server.URL
+	id, err := m.SendMail(context.Background(), "user@example.org", "Your code", "<b>123456</b>", "123456")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "sg-123" {
+		t.Errorf("message id = %q", id)
+	}
+	if got := header.Get("Authorization"); got != "Bearer SG.key" {
+		t.Errorf("authorization = %q", got)
+	}
+	want := map[string]any{
+		"personalizations": []any{map[string]any{"to": []any{map[string]any{"email": "user@example.org"}}}},
+		"from":             map[string]any{"email": "noreply@example.com", "name": "Example"},
+		"subject":          "Your code",
+		"content": []any{
+			map[string]any{"type": "text/plain", "value": "123456"},
+			map[string]any{"type": "text/html", "value": "<b>123456</b>"},
+		},
+	}
+	if !reflect.DeepEqual(payload, want) {
+		t.Errorf("payload = %v, want %v", payload, want)
+	}
+}
+
+func TestSendGridNoticeAndError(t *testing.T) {
+	var payload map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = io.WriteString(w, `{"errors":[{"message":"The from address does not match a verified Sender Identity."}]}`)
+	}))
+	defer server.Close()
+	m, err := NewSendGrid("SG.key", "noreply@example.com", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.(*sendGrid).url = server.URL
+	if _, err := m.SendNotice(context.Background(), "user@example.org", "Notice", "body"); err == nil || !strings.Contains(err.Error(), "verified Sender Identity") {
+		t.Fatalf("err = %v, want the sendgrid error", err)
+	}
+	if content := payload["content"].([]any); len(content) != 1 {
+		t.Errorf("notice content = %v, want the text part only", content)
+	}
+	if from := payload["from"].(map[string]any); len(from) != 1 {
+		t.Errorf("from = %v, want no empty name", from)
+	}
+}
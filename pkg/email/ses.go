@@ -0,0 +1,145 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net/http"
+	netmail "net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// NewSES sends through the Amazon SES API; configurationSet is optional.
+func NewSES(region, accessKeyID, secretAccessKey, configurationSet, senderMail, senderName string) (Mail, error) {
+	if region == "" || accessKeyID == "" || secretAccessKey == "" || senderMail == "" {
+		return nil, errs.New("ses region, accessKeyId, secretAccessKey and senderMail are required")
+	}
+	return &ses{
+		region:           region,
+		host:             "email." + region + ".amazonaws.com",
+		accessKeyID:      accessKeyID,
+		secretAccessKey:  secretAccessKey,
+		configurationSet: configurationSet,
+		source:           (&netmail.Address{Name: senderName, Address: senderMail}).String(),
+		client:           &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+type ses struct {
+	region           string
+	host             string
+	accessKeyID      string
+	secretAccessKey  string
+	configurationSet string
+	source           string
+	client           *http.Client
+}
+
+func (s *ses) Name() string {
+	return "aws-ses"
+}
+
+func (s *ses) SendMail(ctx context.Context, mail string, subject string, html string, text string) (string, error) {
+	form := url.Values{
+		"Action":                           {"SendEmail"},
+		"Version":                          {"2010-12-01"},
+		"Source":                           {s.source},
+		"Destination.ToAddresses.member.1": {mail},
+		"Message.Subject.Data":             {subject},
+		"Message.Subject.Charset":          {"UTF-8"},
+		"Message.Body.Text.Data":           {text},
+		"Message.Body.Text.Charset":        {"UTF-8"},
+	}
+	if html != "" {
+		form.Set("Message.Body.Html.Data", html)
+		form.Set("Message.Body.Html.Charset", "UTF-8")
+	}
+	if s.configurationSet != "" {
+		form.Set("ConfigurationSetName", s.configurationSet)
+	}
+	return s.call(ctx, form.Encode())
+}
+
+func (s *ses) SendNotice(ctx context.Context, mail string, subject string, body string) (string, error) {
+	return s.SendMail(ctx, mail, subject, "", body)
+}
+
+// call returns the message ID given by SES.
+func (s *ses) call(ctx context.Context, body string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+s.host+"/", strings.NewReader(body))
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	now := time.Now().UTC()
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	req.Header.Set("X-Amz-Date", now.Format("20060102T150405Z"))
+	req.Header.Set("Authorization", sigV4Authorization(http.MethodPost, s.host, "", s.region, "ses", s.accessKeyID, s.secretAccessKey, body, now))
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	var res struct {
+		SendEmailResult struct {
+			MessageID string `xml:"MessageId"`
+		} `xml:"SendEmailResult"`
+		Error struct {
+			Code    string `xml:"Code"`
+			Message string `xml:"Message"`
+		} `xml:"Error"`
+	}
+	_ = xml.Unmarshal(data, &res)
+	if resp.StatusCode < 300 {
+		return res.SendEmailResult.MessageID, nil
+	}
+	return "", errs.New("ses send email failed", "status", resp.StatusCode, "code", res.Error.Code, "message", res.Error.Message)
+}
+
+// sigV4Authorization signs a form request to the root of host with AWS Signature Version 4;
+// query is the canonical query string, empty for a POST.
+func sigV4Authorization(method, host, query, region, service, accessKeyID, secretAccessKey, body string, now time.Time) string {
+	const signedHeaders = "content-type;host;x-amz-date"
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	bodyHash := sha256.Sum256([]byte(body))
+	canonicalRequest := method + "\n/\n" + query + "\ncontent-type:application/x-www-form-urlencoded; charset=utf-8\nhost:" + host + "\nx-amz-date:" + amzDate + "\n\n" +
+		signedHeaders + "\n" + hex.EncodeToString(bodyHash[:])
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	return "AWS4-HMAC-SHA256 Credential=" + accessKeyID + "/" + scope + ", SignedHeaders=" + signedHeaders + ", Signature=" + signature
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
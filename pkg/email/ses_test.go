@@ -0,0 +1,109 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestSigV4Authorization signs the example request of the AWS Signature Version 4 documentation.
+func TestSigV4Authorization(t *testing.T) {
+	now := time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+	got := sigV4Authorization(http.MethodGet, "iam.amazonaws.com", "Action=ListUsers&Version=2010-05-08", "us-east-1", "iam",
+		"AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "", now)
+	want := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, SignedHeaders=content-type;host;x-amz-date, " +
+		"Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7"
+	if got != want {
+		t.Errorf("sigV4Authorization() = %s, want %s", got, want)
+	}
+}
+
+func TestSESSendMail(t *testing.T) {
+	var (
+		form   url.Values
+		header http.Header
+		body   string
+	)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		body, header = string(data), r.Header
+		form, _ = url.ParseQuery(body)
+		_, _ = io.WriteString(w, `<SendEmailResponse><SendEmailResult><MessageId>0100-abc</MessageId></SendEmailResult></SendEmailResponse>`)
+	}))
+	defer server.Close()
+	m, err := NewSES("eu-west-1", "AKIDEXAMPLE", "secret", "transactional", "noreply@example.com", "Example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := m.(*ses)
+	s.host = strings.TrimPrefix(server.URL, "https://")
+	s.client = server.Client()
+	id, err := s.SendMail(context.Background(), "user@example.org", "Your code", "<b>123456</b>", "123456")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "0100-abc" {
+		t.Errorf("message id = %q", id)
+	}
+	want := map[string]string{
+		"Action":                           "SendEmail",
+		"Source":                           `"Example" <noreply@example.com>`,
+		"Destination.ToAddresses.member.1": "user@example.org",
+		"Message.Subject.Data":             "Your code",
+		"Message.Body.Text.Data":           "123456",
+		"Message.Body.Html.Data":           "<b>123456</b>",
+		"ConfigurationSetName":             "transactional",
+	}
+	for k, v := range want {
+		if got := form.Get(k); got != v {
+			t.Errorf("%s = %q, want %q", k, got, v)
+		}
+	}
+	now, err := time.Parse("20060102T150405Z", header.Get("X-Amz-Date"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := sigV4Authorization(http.MethodPost, s.host, "", "eu-west-1", "ses", "AKIDEXAMPLE", "secret", body, now); header.Get("Authorization") != want {
+		t.Errorf("authorization = %s, want %s", header.Get("Authorization"), want)
+	}
+	if !strings.Contains(header.Get("Authorization"), "/eu-west-1/ses/aws4_request") {
+		t.Errorf("authorization scope is not ses: %s", header.Get("Authorization"))
+	}
+}
+
+func TestSESError(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = io.WriteString(w, `<ErrorResponse><Error><Code>MessageRejected</Code><Message>Email address is not verified.</Message></Error></ErrorResponse>`)
+	}))
+	defer server.Close()
+	m, err := NewSES("eu-west-1", "AKIDEXAMPLE", "secret", "", "noreply@example.com", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := m.(*ses)
+	s.host = strings.TrimPrefix(server.URL, "https://")
+	s.client = server.Client()
+	if _, err := s.SendNotice(context.Background(), "user@example.org", "Notice", "body"); err == nil || !strings.Contains(err.Error(), "MessageRejected") {
+		t.Fatalf("err = %v, want MessageRejected", err)
+	}
+}
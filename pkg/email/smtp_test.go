@@ -0,0 +1,341 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// smtpStandIn is a minimal SMTP server recording what a client did in its last session.
+type smtpStandIn struct {
+	t         *testing.T
+	listener  net.Listener
+	tlsConfig *tls.Config
+	implicit  bool     // TLS from the start of the connection
+	startTLS  bool     // offer STARTTLS
+	auth      []string // mechanisms offered, none without
+
+	lock    sync.Mutex
+	session smtpSession
+	done    chan struct{}
+}
+
+type smtpSession struct {
+	tls      bool
+	mech     string
+	username string
+	password string
+	from     string
+	to       string
+	data     string
+}
+
+func newSMTPStandIn(t *testing.T, cert tls.Certificate, implicit bool, startTLS bool, auth ...string) *smtpStandIn {
+	t.Helper()
+	s := &smtpStandIn{
+		t:         t,
+		tlsConfig: &tls.Config{Certificates: []tls.Certificate{cert}},
+		implicit:  implicit,
+		startTLS:  startTLS,
+		auth:      auth,
+		done:      make(chan struct{}),
+	}
+	var err error
+	if implicit {
+		s.listener, err = tls.Listen("tcp", "127.0.0.1:0", s.tlsConfig)
+	} else {
+		s.listener, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.listener.Close() })
+	go s.serve()
+	return s
+}
+
+func (s *smtpStandIn) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+// last waits for the session to end and returns it.
+func (s *smtpStandIn) last() smtpSession {
+	select {
+	case <-s.done:
+	case <-time.After(5 * time.Second):
+		s.t.Fatal("smtp session did not end")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.session
+}
+
+func (s *smtpStandIn) serve() {
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer close(s.done)
+	defer func() { conn.Close() }()
+	session := smtpSession{tls: s.implicit}
+	defer func() {
+		s.lock.Lock()
+		s.session = session
+		s.lock.Unlock()
+	}()
+	r, w := bufio.NewReader(conn), conn
+	reply := func(lines ...string) {
+		for _, line := range lines {
+			_, _ = w.Write([]byte(line + "\r\n"))
+		}
+	}
+	reply("220 stand-in ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			lines := []string{"250-stand-in"}
+			if s.startTLS && !session.tls {
+				lines = append(lines, "250-STARTTLS")
+			}
+			if len(s.auth) > 0 {
+				lines = append(lines, "250-AUTH "+strings.Join(s.auth, " "))
+			}
+			lines = append(lines, "250 8BITMIME")
+			reply(lines...)
+		case "STARTTLS":
+			reply("220 go ahead")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn, r, w = tlsConn, bufio.NewReader(tlsConn), tlsConn
+			session.tls = true
+		case "AUTH":
+			mech, initial, _ := strings.Cut(arg, " ")
+			session.mech = mech
+			switch mech {
+			case "PLAIN":
+				decoded, _ := base64.StdEncoding.DecodeString(initial)
+				parts := strings.Split(string(decoded), "\x00")
+				if len(parts) == 3 {
+					session.username, session.password = parts[1], parts[2]
+				}
+			case "LOGIN":
+				read := func(challenge string) string {
+					reply("334 " + base64.StdEncoding.EncodeToString([]byte(challenge)))
+					line, _ := r.ReadString('\n')
+					decoded, _ := base64.StdEncoding.DecodeString(strings.TrimSpace(line))
+					return string(decoded)
+				}
+				session.username = read("Username:")
+				session.password = read("Password:")
+			}
+			reply("235 authenticated")
+		case "MAIL":
+			session.from, _, _ = strings.Cut(strings.TrimPrefix(arg, "FROM:<"), ">")
+			reply("250 ok")
+		case "RCPT":
+			session.to, _, _ = strings.Cut(strings.TrimPrefix(arg, "TO:<"), ">")
+			reply("250 ok")
+		case "DATA":
+			reply("354 end with .")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			session.data = data.String()
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+// testCert returns a self-signed certificate for 127.0.0.1 and the path of its PEM file.
+func testCert(t *testing.T) (tls.Certificate, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "smtp stand-in"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, file
+}
+
+func TestSMTPSend(t *testing.T) {
+	cert, caFile := testCert(t)
+	tests := []struct {
+		name     string
+		implicit bool
+		startTLS bool
+		auth     []string
+		tlsMode  string
+		password string
+		wantErr  string
+		wantTLS  bool
+		wantMech string
+	}{
+		{name: "none", tlsMode: TLSNone},
+		{name: "none ignores starttls", startTLS: true, tlsMode: TLSNone},
+		{name: "auto upgrades", startTLS: true, auth: []string{"PLAIN", "LOGIN"}, tlsMode: TLSAuto, password: "secret", wantTLS: true, wantMech: "PLAIN"},
+		{name: "auto stays plain on localhost", auth: []string{"PLAIN"}, tlsMode: TLSAuto, password: "secret", wantMech: "PLAIN"},
+		{name: "starttls required", auth: []string{"PLAIN"}, tlsMode: TLSStartTLS, password: "secret", wantErr: "does not offer STARTTLS"},
+		{name: "starttls", startTLS: true, tlsMode: TLSStartTLS, wantTLS: true},
+		{name: "implicit", implicit: true, auth: []string{"PLAIN"}, tlsMode: TLSImplicit, password: "secret", wantTLS: true, wantMech: "PLAIN"},
+		{name: "login only", startTLS: true, auth: []string{"LOGIN"}, tlsMode: TLSStartTLS, password: "secret", wantTLS: true, wantMech: "LOGIN"},
+		{name: "unknown mechanisms try plain", startTLS: true, auth: []string{"CRAM-MD5"}, tlsMode: TLSAuto, password: "secret", wantTLS: true, wantMech: "PLAIN"},
+		{name: "no auth offered", startTLS: true, tlsMode: TLSAuto, password: "secret", wantErr: "does not offer AUTH"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newSMTPStandIn(t, cert, tt.implicit, tt.startTLS, tt.auth...)
+			m, err := NewMail("127.0.0.1", server.port(), "noreply@example.com", "Example", tt.password, tt.tlsMode, caFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			id, err := m.SendMail(context.Background(), "user@example.org", "Your code", "<b>123456</b>", "123456")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			session := server.last()
+			if session.tls != tt.wantTLS {
+				t.Errorf("tls = %v, want %v", session.tls, tt.wantTLS)
+			}
+			if session.mech != tt.wantMech {
+				t.Errorf("auth mechanism = %q, want %q", session.mech, tt.wantMech)
+			}
+			if tt.wantMech != "" && (session.username != "noreply@example.com" || session.password != "secret") {
+				t.Errorf("credentials = %q/%q", session.username, session.password)
+			}
+			if session.from != "noreply@example.com" || session.to != "user@example.org" {
+				t.Errorf("envelope = %q -> %q", session.from, session.to)
+			}
+			for _, want := range []string{"Message-ID: " + id, `From: "Example" <noreply@example.com>`, "Subject: Your code", "text/plain", "text/html"} {
+				if !strings.Contains(session.data, want) {
+					t.Errorf("message lacks %q:\n%s", want, session.data)
+				}
+			}
+		})
+	}
+}
+
+func TestSMTPUntrustedCertificate(t *testing.T) {
+	cert, _ := testCert(t)
+	server := newSMTPStandIn(t, cert, false, true)
+	m, err := NewMail("127.0.0.1", server.port(), "noreply@example.com", "", "", TLSAuto, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.SendNotice(context.Background(), "user@example.org", "Notice", "body"); err == nil || !strings.Contains(err.Error(), "starttls failed") {
+		t.Fatalf("err = %v, want a starttls failure", err)
+	}
+}
+
+func TestLoginAuth(t *testing.T) {
+	a := &loginAuth{username: "user", password: "secret"}
+	for _, server := range []smtp.ServerInfo{
+		{Name: "smtp.example.com", TLS: true},
+		{Name: "localhost"},
+		{Name: "127.0.0.1"},
+	} {
+		if mech, _, err := a.Start(&server); err != nil || mech != "LOGIN" {
+			t.Errorf("Start(%+v) = %q, %v", server, mech, err)
+		}
+	}
+	if _, _, err := a.Start(&smtp.ServerInfo{Name: "smtp.example.com"}); err == nil {
+		t.Error("Start over plain text to a remote server succeeded")
+	}
+	for challenge, want := range map[string]string{"Username:": "user", "password:": "secret", " Password: ": "secret"} {
+		if got, err := a.Next([]byte(challenge), true); err != nil || string(got) != want {
+			t.Errorf("Next(%q) = %q, %v, want %q", challenge, got, err, want)
+		}
+	}
+	if _, err := a.Next([]byte("Realm:"), true); err == nil {
+		t.Error("Next accepted an unknown challenge")
+	}
+	if got, err := a.Next(nil, false); got != nil || err != nil {
+		t.Errorf("Next at the end = %q, %v", got, err)
+	}
+}
+
+func TestNewMailInvalid(t *testing.T) {
+	if _, err := NewMail("smtp.example.com", 587, "noreply@example.com", "", "", "ssl", ""); err == nil {
+		t.Error("unknown tls mode accepted")
+	}
+	if _, err := NewMail("smtp.example.com", 587, "", "", "", TLSAuto, ""); err == nil {
+		t.Error("missing sender accepted")
+	}
+	if _, err := NewMail("smtp.example.com", 587, "noreply@example.com", "", "", TLSAuto, filepath.Join(t.TempDir(), "missing.pem")); err == nil {
+		t.Error("missing ca file accepted")
+	}
+}
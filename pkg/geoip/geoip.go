@@ -0,0 +1,372 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package geoip resolves IP addresses to countries with a local MaxMind DB (GeoIP2/GeoLite2 .mmdb) file,
+// so no network lookup is needed at runtime.
+package geoip
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"net/netip"
+	"os"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+var metadataMarker = []byte("\xAB\xCD\xEFMaxMind.com")
+
+const dataSectionSeparator = 16
+
+// Location is the part of a record the rules need.
+type Location struct {
+	Country string // ISO 3166-1 alpha-2 code, empty when unknown
+	Region  string // ISO 3166-2 subdivision code without the country prefix, empty when unknown
+}
+
+type Reader struct {
+	tree       []byte
+	data       []byte
+	nodeCount  uint
+	recordSize uint
+	ipVersion  uint
+	ipv4Start  uint
+}
+
+func Open(path string) (*Reader, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "read geoip database failed", "path", path)
+	}
+	return New(buf)
+}
+
+func New(buf []byte) (*Reader, error) {
+	i := bytes.LastIndex(buf, metadataMarker)
+	if i < 0 {
+		return nil, errs.New("invalid geoip database: metadata not found")
+	}
+	meta, _, err := (&decoder{buf: buf[i+len(metadataMarker):]}).decode(0, 0)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := meta.(map[string]any)
+	if !ok {
+		return nil, errs.New("invalid geoip database: metadata is not a map")
+	}
+	r := &Reader{
+		nodeCount:  toUint(m["node_count"]),
+		recordSize: toUint(m["record_size"]),
+		ipVersion:  toUint(m["ip_version"]),
+	}
+	switch r.recordSize {
+	case 24, 28, 32:
+	default:
+		return nil, errs.New("invalid geoip database: unsupported record size", "recordSize", r.recordSize)
+	}
+	// each node takes at least 6 bytes, checked first so the tree size cannot overflow
+	if r.nodeCount == 0 || r.nodeCount > uint(i)/6 {
+		return nil, errs.New("invalid geoip database: node count is invalid", "nodeCount", r.nodeCount)
+	}
+	treeSize := r.nodeCount * r.recordSize / 4
+	if treeSize+dataSectionSeparator > uint(i) {
+		return nil, errs.New("invalid geoip database: search tree is truncated")
+	}
+	r.tree = buf[:treeSize]
+	r.data = buf[treeSize+dataSectionSeparator : i]
+	if r.ipVersion == 6 {
+		// IPv4 addresses live under ::/96
+		node := uint(0)
+		for j := 0; j < 96 && node < r.nodeCount; j++ {
+			node = r.record(node, 0)
+		}
+		r.ipv4Start = node
+	}
+	return r, nil
+}
+
+func (r *Reader) record(node uint, bit uint) uint {
+	switch r.recordSize {
+	case 24:
+		b := r.tree[node*6+bit*3:]
+		return uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+	case 28:
+		b := r.tree[node*7:]
+		if bit == 0 {
+			return uint(b[3]&0xF0)<<20 | uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+		}
+		return uint(b[3]&0x0F)<<24 | uint(b[4])<<16 | uint(b[5])<<8 | uint(b[6])
+	default:
+		return uint(binary.BigEndian.Uint32(r.tree[node*8+bit*4:]))
+	}
+}
+
+// Lookup returns the record of ip, or nil when the database has none.
+func (r *Reader) Lookup(ip netip.Addr) (any, error) {
+	ip = ip.Unmap()
+	if !ip.IsValid() {
+		return nil, nil
+	}
+	node := uint(0)
+	if ip.Is4() {
+		if r.ipVersion == 6 {
+			node = r.ipv4Start
+		}
+	} else if r.ipVersion == 4 {
+		return nil, nil
+	}
+	key := ip.AsSlice()
+	for i := 0; i < len(key)*8 && node < r.nodeCount; i++ {
+		node = r.record(node, uint(key[i/8]>>(7-i%8))&1)
+	}
+	switch {
+	case node == r.nodeCount:
+		return nil, nil
+	case node < r.nodeCount:
+		return nil, errs.New("invalid geoip database: search tree too deep")
+	case node < r.nodeCount+dataSectionSeparator:
+		// records point past the separator, anything between would underflow the offset
+		return nil, errs.New("invalid geoip database: record points into the data section separator")
+	}
+	offset := node - r.nodeCount - dataSectionSeparator
+	if offset >= uint(len(r.data)) {
+		return nil, errs.New("invalid geoip database: record points past the data section")
+	}
+	val, _, err := (&decoder{buf: r.data}).decode(offset, 0)
+	return val, err
+}
+
+// Locate resolves ip to its country and first subdivision; an unknown or invalid ip returns an empty Location.
+func (r *Reader) Locate(ip string) (Location, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return Location{}, nil
+	}
+	val, err := r.Lookup(addr)
+	if err != nil {
+		return Location{}, err
+	}
+	m, _ := val.(map[string]any)
+	var loc Location
+	for _, key := range []string{"country", "registered_country"} {
+		if code := isoCode(m[key]); code != "" {
+			loc.Country = code
+			break
+		}
+	}
+	if subdivisions, _ := m["subdivisions"].([]any); len(subdivisions) > 0 {
+		loc.Region = isoCode(subdivisions[0])
+	}
+	return loc, nil
+}
+
+func isoCode(v any) string {
+	m, _ := v.(map[string]any)
+	code, _ := m["iso_code"].(string)
+	return code
+}
+
+func toUint(v any) uint {
+	switch n := v.(type) {
+	case uint64:
+		return uint(n)
+	case int64:
+		return uint(n)
+	}
+	return 0
+}
+
+const (
+	typeExtended = iota
+	typePointer
+	typeString
+	typeDouble
+	typeBytes
+	typeUint16
+	typeUint32
+	typeMap
+	typeInt32
+	typeUint64
+	typeUint128
+	typeArray
+	typeContainer
+	typeEndMarker
+	typeBool
+	typeFloat
+)
+
+// maxDepth bounds nesting so a corrupt file cannot recurse forever.
+const maxDepth = 32
+
+type decoder struct {
+	buf []byte
+}
+
+func (d *decoder) errTruncated() error {
+	return errs.New("invalid geoip database: data section is truncated")
+}
+
+func (d *decoder) bytes(offset uint, n uint) ([]byte, error) {
+	if offset+n > uint(len(d.buf)) {
+		return nil, d.errTruncated()
+	}
+	return d.buf[offset : offset+n], nil
+}
+
+// decode returns the value at offset and the offset following it.
+func (d *decoder) decode(offset uint, depth int) (any, uint, error) {
+	if depth > maxDepth {
+		return nil, 0, errs.New("invalid geoip database: data nested too deep")
+	}
+	b, err := d.bytes(offset, 1)
+	if err != nil {
+		return nil, 0, err
+	}
+	ctrl := b[0]
+	offset++
+	typ := uint(ctrl >> 5)
+	if typ == typePointer {
+		ss, vvv := uint(ctrl>>3)&0x3, uint(ctrl&0x7)
+		p, err := d.bytes(offset, ss+1)
+		if err != nil {
+			return nil, 0, err
+		}
+		var ptr uint
+		switch ss {
+		case 0:
+			ptr = vvv<<8 | uint(p[0])
+		case 1:
+			ptr = (vvv<<16 | uint(p[0])<<8 | uint(p[1])) + 2048
+		case 2:
+			ptr = (vvv<<24 | uint(p[0])<<16 | uint(p[1])<<8 | uint(p[2])) + 526336
+		default:
+			ptr = uint(binary.BigEndian.Uint32(p))
+		}
+		val, _, err := d.decode(ptr, depth+1)
+		return val, offset + ss + 1, err
+	}
+	if typ == typeExtended {
+		b, err := d.bytes(offset, 1)
+		if err != nil {
+			return nil, 0, err
+		}
+		typ = 7 + uint(b[0])
+		offset++
+	}
+	size := uint(ctrl & 0x1f)
+	if size >= 29 {
+		n := size - 28
+		b, err := d.bytes(offset, n)
+		if err != nil {
+			return nil, 0, err
+		}
+		offset += n
+		var v uint
+		for _, c := range b {
+			v = v<<8 | uint(c)
+		}
+		switch n {
+		case 1:
+			size = 29 + v
+		case 2:
+			size = 285 + v
+		default:
+			size = 65821 + v
+		}
+	}
+	// every entry takes at least a byte, so a corrupt size cannot make huge allocations
+	if size > uint(len(d.buf)) {
+		return nil, 0, d.errTruncated()
+	}
+	switch typ {
+	case typeMap:
+		m := make(map[string]any, size)
+		for i := uint(0); i < size; i++ {
+			key, next, err := d.decode(offset, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			k, ok := key.(string)
+			if !ok {
+				return nil, 0, errs.New("invalid geoip database: map key is not a string")
+			}
+			val, next, err := d.decode(next, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			m[k] = val
+			offset = next
+		}
+		return m, offset, nil
+	case typeArray:
+		a := make([]any, 0, size)
+		for i := uint(0); i < size; i++ {
+			val, next, err := d.decode(offset, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			a = append(a, val)
+			offset = next
+		}
+		return a, offset, nil
+	case typeBool:
+		return size != 0, offset, nil
+	case typeContainer, typeEndMarker:
+		return nil, offset, nil
+	}
+	b, err = d.bytes(offset, size)
+	if err != nil {
+		return nil, 0, err
+	}
+	offset += size
+	switch typ {
+	case typeString:
+		return string(b), offset, nil
+	case typeBytes:
+		return append([]byte(nil), b...), offset, nil
+	case typeDouble:
+		if size != 8 {
+			return nil, 0, errs.New("invalid geoip database: bad double size")
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), offset, nil
+	case typeFloat:
+		if size != 4 {
+			return nil, 0, errs.New("invalid geoip database: bad float size")
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), offset, nil
+	case typeUint16, typeUint32, typeUint64:
+		if size > 8 {
+			return nil, 0, errs.New("invalid geoip database: bad integer size")
+		}
+		var v uint64
+		for _, c := range b {
+			v = v<<8 | uint64(c)
+		}
+		return v, offset, nil
+	case typeInt32:
+		if size > 4 {
+			return nil, 0, errs.New("invalid geoip database: bad integer size")
+		}
+		var v uint32
+		for _, c := range b {
+			v = v<<8 | uint32(c)
+		}
+		return int64(int32(v)), offset, nil
+	case typeUint128:
+		return append([]byte(nil), b...), offset, nil
+	default:
+		return nil, 0, errs.New("invalid geoip database: unknown data type", "type", typ)
+	}
+}
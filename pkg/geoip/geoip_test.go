@@ -0,0 +1,290 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net/netip"
+	"sort"
+	"testing"
+)
+
+// The tests build small databases in the MaxMind DB format rather than ship binary fixtures.
+
+type encoder struct {
+	buf bytes.Buffer
+}
+
+func (e *encoder) ctrl(typ int, size int) {
+	if typ > 7 {
+		e.buf.WriteByte(byte(size))
+		e.buf.WriteByte(byte(typ - 7))
+		return
+	}
+	e.buf.WriteByte(byte(typ<<5 | size))
+}
+
+func (e *encoder) encode(v any) {
+	switch v := v.(type) {
+	case string:
+		e.ctrl(typeString, len(v))
+		e.buf.WriteString(v)
+	case uint32:
+		e.ctrl(typeUint32, 4)
+		_ = binary.Write(&e.buf, binary.BigEndian, v)
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		e.ctrl(typeMap, len(v))
+		for _, k := range keys {
+			e.encode(k)
+			e.encode(v[k])
+		}
+	case []any:
+		e.ctrl(typeArray, len(v))
+		for _, item := range v {
+			e.encode(item)
+		}
+	default:
+		panic("unsupported type")
+	}
+}
+
+type trieNode struct {
+	child [2]*trieNode
+	data  [2]int // 1 + offset in the data section of a leaf record, 0 when empty
+}
+
+type network struct {
+	prefix netip.Prefix
+	record map[string]any
+}
+
+// buildDB writes a database of the given ip version and record size holding the networks, which
+// must not overlap. IPv4 networks of an IPv6 database go under ::/96.
+func buildDB(t *testing.T, ipVersion int, recordSize int, networks []network) []byte {
+	t.Helper()
+	var data encoder
+	root := &trieNode{}
+	for _, n := range networks {
+		offset := data.buf.Len()
+		data.encode(n.record)
+		key, bits := n.prefix.Addr().AsSlice(), n.prefix.Bits()
+		if ipVersion == 6 && n.prefix.Addr().Is4() {
+			key = append(make([]byte, 12), key...)
+			bits += 96
+		}
+		node := root
+		for i := 0; i < bits; i++ {
+			bit := key[i/8] >> (7 - i%8) & 1
+			if i == bits-1 {
+				node.data[bit] = offset + 1
+				break
+			}
+			if node.child[bit] == nil {
+				node.child[bit] = &trieNode{}
+			}
+			node = node.child[bit]
+		}
+	}
+	var nodes []*trieNode
+	index := make(map[*trieNode]int)
+	queue := []*trieNode{root}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		index[n] = len(nodes)
+		nodes = append(nodes, n)
+		for _, c := range n.child {
+			if c != nil {
+				queue = append(queue, c)
+			}
+		}
+	}
+	nodeCount := len(nodes)
+	var tree bytes.Buffer
+	for _, n := range nodes {
+		var records [2]uint32
+		for bit := range records {
+			switch {
+			case n.child[bit] != nil:
+				records[bit] = uint32(index[n.child[bit]])
+			case n.data[bit] != 0:
+				records[bit] = uint32(nodeCount + dataSectionSeparator + n.data[bit] - 1)
+			default:
+				records[bit] = uint32(nodeCount)
+			}
+		}
+		writeNode(&tree, recordSize, records[0], records[1])
+	}
+	var meta encoder
+	meta.encode(map[string]any{
+		"node_count":  uint32(nodeCount),
+		"record_size": uint32(recordSize),
+		"ip_version":  uint32(ipVersion),
+	})
+	var db bytes.Buffer
+	db.Write(tree.Bytes())
+	db.Write(make([]byte, dataSectionSeparator))
+	db.Write(data.buf.Bytes())
+	db.Write(metadataMarker)
+	db.Write(meta.buf.Bytes())
+	return db.Bytes()
+}
+
+func writeNode(w *bytes.Buffer, recordSize int, left uint32, right uint32) {
+	switch recordSize {
+	case 24:
+		w.Write([]byte{byte(left >> 16), byte(left >> 8), byte(left), byte(right >> 16), byte(right >> 8), byte(right)})
+	case 28:
+		w.Write([]byte{byte(left >> 16), byte(left >> 8), byte(left), byte(left>>24)<<4 | byte(right>>24)&0x0F,
+			byte(right >> 16), byte(right >> 8), byte(right)})
+	default:
+		_ = binary.Write(w, binary.BigEndian, [2]uint32{left, right})
+	}
+}
+
+func country(code string, regions ...string) map[string]any {
+	record := map[string]any{"country": map[string]any{"iso_code": code}}
+	if len(regions) > 0 {
+		subdivisions := make([]any, 0, len(regions))
+		for _, region := range regions {
+			subdivisions = append(subdivisions, map[string]any{"iso_code": region})
+		}
+		record["subdivisions"] = subdivisions
+	}
+	return record
+}
+
+var testNetworks = []network{
+	{netip.MustParsePrefix("1.2.3.0/24"), country("US", "CA")},
+	{netip.MustParsePrefix("81.2.69.128/26"), country("GB", "ENG", "WSM")},
+	{netip.MustParsePrefix("175.16.199.0/24"), map[string]any{"registered_country": map[string]any{"iso_code": "CN"}}},
+	{netip.MustParsePrefix("2001:db8::/32"), country("DE", "BE")},
+}
+
+func TestLocate(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want Location
+	}{
+		{"1.2.3.4", Location{Country: "US", Region: "CA"}},
+		{"81.2.69.160", Location{Country: "GB", Region: "ENG"}},
+		{"175.16.199.1", Location{Country: "CN"}},
+		{"::ffff:1.2.3.4", Location{Country: "US", Region: "CA"}},
+		{"1.2.4.1", Location{}},
+		{"8.8.8.8", Location{}},
+		{"not an ip", Location{}},
+	}
+	for _, size := range []int{24, 28, 32} {
+		r, err := New(buildDB(t, 6, size, testNetworks))
+		if err != nil {
+			t.Fatalf("record size %d: %v", size, err)
+		}
+		for _, tt := range append(tests,
+			struct {
+				ip   string
+				want Location
+			}{"2001:db8::1", Location{Country: "DE", Region: "BE"}},
+		) {
+			got, err := r.Locate(tt.ip)
+			if err != nil {
+				t.Errorf("record size %d: Locate(%s): %v", size, tt.ip, err)
+			} else if got != tt.want {
+				t.Errorf("record size %d: Locate(%s) = %+v, want %+v", size, tt.ip, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestLocateIPv4Database(t *testing.T) {
+	r, err := New(buildDB(t, 4, 24, testNetworks[:3]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for ip, want := range map[string]Location{
+		"1.2.3.4":     {Country: "US", Region: "CA"},
+		"2001:db8::1": {},
+		"9.9.9.9":     {},
+	} {
+		got, err := r.Locate(ip)
+		if err != nil || got != want {
+			t.Errorf("Locate(%s) = %+v, %v, want %+v", ip, got, err, want)
+		}
+	}
+}
+
+// TestLookupSeparator covers records pointing between the tree and the data section, which
+// used to underflow the data offset.
+func TestLookupSeparator(t *testing.T) {
+	db := buildDB(t, 4, 32, testNetworks[:1])
+	r, err := New(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, record := range []uint32{uint32(r.nodeCount) + 1, uint32(r.nodeCount) + dataSectionSeparator - 1, uint32(r.nodeCount) + dataSectionSeparator + uint32(len(r.data))} {
+		corrupt := bytes.Clone(db)
+		// the root's left record covers 0.0.0.0/1
+		binary.BigEndian.PutUint32(corrupt, record)
+		cr, err := New(corrupt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := cr.Locate("1.2.3.4"); err == nil {
+			t.Errorf("record %d: no error", record)
+		}
+	}
+}
+
+// TestCorruptDatabase makes sure damaged files return errors rather than panic.
+func TestCorruptDatabase(t *testing.T) {
+	db := buildDB(t, 6, 28, testNetworks)
+	for n := 0; n < len(db); n++ {
+		if r, err := New(db[:n]); err == nil {
+			_, _ = r.Locate("1.2.3.4")
+		}
+	}
+	for i := range db {
+		for _, b := range []byte{0x00, 0xFF, 0x5D} {
+			corrupt := bytes.Clone(db)
+			corrupt[i] = b
+			if r, err := New(corrupt); err == nil {
+				for _, ip := range []string{"1.2.3.4", "81.2.69.160", "2001:db8::1", "8.8.8.8"} {
+					_, _ = r.Locate(ip)
+				}
+			}
+		}
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	for name, db := range map[string][]byte{
+		"empty":       nil,
+		"no metadata": bytes.Repeat([]byte{1}, 64),
+		"huge nodes": func() []byte {
+			var meta encoder
+			meta.encode(map[string]any{"node_count": uint32(0xFFFFFFFF), "record_size": uint32(32), "ip_version": uint32(6)})
+			return append(append(make([]byte, 32), metadataMarker...), meta.buf.Bytes()...)
+		}(),
+	} {
+		if _, err := New(db); err == nil {
+			t.Errorf("%s: no error", name)
+		}
+	}
+}
@@ -15,9 +15,13 @@
 package admin
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/utils/datautil"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func (x *LoginReq) Check() error {
@@ -236,6 +240,12 @@ func (x *BlockUserReq) Check() error {
 	if x.UserID == "" {
 		return errs.ErrArgs.WrapMsg("userID is empty")
 	}
+	if x.Category != "" && !datautil.Contain(x.Category, constant.BlockCategories...) {
+		return errs.ErrArgs.WrapMsg("category is invalid")
+	}
+	if x.ExpireTime < 0 {
+		return errs.ErrArgs.WrapMsg("expireTime is invalid")
+	}
 	return nil
 }
 
@@ -259,6 +269,97 @@ func (x *SearchBlockUserReq) Check() error {
 	return nil
 }
 
+func (x *SearchBlockHistoryReq) Check() error {
+	if x.Action != "" && !datautil.Contain(x.Action, constant.BlockActionBlock, constant.BlockActionUnblock, constant.BlockActionExpire) {
+		return errs.ErrArgs.WrapMsg("action is invalid")
+	}
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.WrapMsg("showNumber is invalid")
+	}
+	return nil
+}
+
+// Limits of an appeal.
+const (
+	appealMaxContent     = 2000
+	appealMaxAttachments = 9
+	appealMaxURL         = 1024
+)
+
+func (x *CreateAppealTicketReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetAppealReq) Check() error {
+	if x.Ticket == "" {
+		return errs.ErrArgs.WrapMsg("ticket is empty")
+	}
+	return nil
+}
+
+func (x *SubmitAppealReq) Check() error {
+	if x.Ticket == "" {
+		return errs.ErrArgs.WrapMsg("ticket is empty")
+	}
+	if x.Content == "" {
+		return errs.ErrArgs.WrapMsg("content is empty")
+	}
+	if len([]rune(x.Content)) > appealMaxContent {
+		return errs.ErrArgs.WrapMsg("content is too long")
+	}
+	if len(x.Attachments) > appealMaxAttachments {
+		return errs.ErrArgs.WrapMsg("too many attachments")
+	}
+	for _, attachment := range x.Attachments {
+		if len(attachment) > appealMaxURL || !(strings.HasPrefix(attachment, "https://") || strings.HasPrefix(attachment, "http://")) {
+			return errs.ErrArgs.WrapMsg("attachment is not a valid url", "attachment", attachment)
+		}
+	}
+	return nil
+}
+
+func (x *SearchAppealReq) Check() error {
+	if x.Status < constant.AppealStatusAll || x.Status > constant.AppealStatusRejected {
+		return errs.ErrArgs.WrapMsg("status is invalid")
+	}
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.WrapMsg("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *AssignAppealReq) Check() error {
+	if len(x.AppealIDs) == 0 {
+		return errs.ErrArgs.WrapMsg("appealIDs is empty")
+	}
+	return nil
+}
+
+func (x *HandleAppealReq) Check() error {
+	if x.AppealID == "" {
+		return errs.ErrArgs.WrapMsg("appealID is empty")
+	}
+	if len([]rune(x.Response)) > appealMaxContent {
+		return errs.ErrArgs.WrapMsg("response is too long")
+	}
+	return nil
+}
+
 func (x *FindUserBlockInfoReq) Check() error {
 	if x.UserIDs == nil {
 		return errs.ErrArgs.WrapMsg("userIDs is empty")
@@ -387,3 +488,217 @@ func (x *SearchAdminAccountReq) Check() error {
 	}
 	return nil
 }
+
+func (x *ConfirmAdminTOTPReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.WrapMsg("code is empty")
+	}
+	return nil
+}
+
+func (x *LoginAdminTOTPReq) Check() error {
+	if x.Ticket == "" {
+		return errs.ErrArgs.WrapMsg("ticket is empty")
+	}
+	if x.Code == "" {
+		return errs.ErrArgs.WrapMsg("code is empty")
+	}
+	return nil
+}
+
+func (x *DisableAdminTOTPReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.WrapMsg("code is empty")
+	}
+	return nil
+}
+
+func (x *RevokeUserSessionReq) Check() error {
+	if x.SessionID == "" {
+		return errs.ErrArgs.WrapMsg("sessionID is empty")
+	}
+	return nil
+}
+
+func (x *RefreshTokenReq) Check() error {
+	if x.RefreshToken == "" {
+		return errs.ErrArgs.WrapMsg("refreshToken is empty")
+	}
+	return nil
+}
+
+func (x *SearchLoginLockReq) Check() error {
+	if len(x.Targets) > 0 {
+		return nil
+	}
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.WrapMsg("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *ClearLoginLockReq) Check() error {
+	if len(x.Targets) == 0 {
+		return errs.ErrArgs.WrapMsg("targets is empty")
+	}
+	return nil
+}
+
+var (
+	geoCountryPattern = regexp.MustCompile(`^[A-Za-z]{2}$`)
+	geoRegionPattern  = regexp.MustCompile(`^[A-Za-z0-9]{1,3}$`)
+)
+
+func (x *AddGeoRuleReq) Check() error {
+	if len(x.Rules) == 0 {
+		return errs.ErrArgs.WrapMsg("rules is empty")
+	}
+	for _, rule := range x.Rules {
+		if !geoCountryPattern.MatchString(rule.Country) {
+			return errs.ErrArgs.WrapMsg("country must be an ISO 3166-1 alpha-2 code")
+		}
+		if rule.Region != "" && !geoRegionPattern.MatchString(rule.Region) {
+			return errs.ErrArgs.WrapMsg("region must be an ISO 3166-2 subdivision code without the country prefix")
+		}
+		if rule.Register < 0 || rule.Register > 2 || rule.Login < 0 || rule.Login > 2 {
+			return errs.ErrArgs.WrapMsg("action is invalid")
+		}
+	}
+	return nil
+}
+
+func (x *DelGeoRuleReq) Check() error {
+	if len(x.Rules) == 0 {
+		return errs.ErrArgs.WrapMsg("rules is empty")
+	}
+	return nil
+}
+
+func (x *SearchGeoRuleReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.WrapMsg("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *AddRoleReq) Check() error {
+	if x.RoleID == "" {
+		return errs.ErrArgs.WrapMsg("roleID is empty")
+	}
+	if x.Name == "" {
+		return errs.ErrArgs.WrapMsg("name is empty")
+	}
+	if len(x.Permissions) == 0 {
+		return errs.ErrArgs.WrapMsg("permissions is empty")
+	}
+	return nil
+}
+
+func (x *UpdateRoleReq) Check() error {
+	if x.RoleID == "" {
+		return errs.ErrArgs.WrapMsg("roleID is empty")
+	}
+	if x.Name != nil && x.Name.Value == "" {
+		return errs.ErrArgs.WrapMsg("name is empty")
+	}
+	return nil
+}
+
+func (x *DelRoleReq) Check() error {
+	if len(x.RoleIDs) == 0 {
+		return errs.ErrArgs.WrapMsg("roleIDs is empty")
+	}
+	return nil
+}
+
+func (x *SearchRoleReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.WrapMsg("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *AssignAdminRoleReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	if len(x.RoleIDs) == 0 {
+		return errs.ErrArgs.WrapMsg("roleIDs is empty")
+	}
+	return nil
+}
+
+func (x *CheckAdminPermissionReq) Check() error {
+	if len(x.Permissions) == 0 && x.Audit == nil {
+		return errs.ErrArgs.WrapMsg("permissions is empty")
+	}
+	if x.Audit != nil && x.Audit.Action == "" {
+		return errs.ErrArgs.WrapMsg("audit action is empty")
+	}
+	return nil
+}
+
+func (x *AddAuditLogReq) Check() error {
+	if x.OperationID == "" {
+		return errs.ErrArgs.WrapMsg("operationID is empty")
+	}
+	return nil
+}
+
+func (x *ScanAuditLogReq) Check() error {
+	if x.Limit < 1 || x.Limit > 1000 {
+		return errs.ErrArgs.WrapMsg("limit must be between 1 and 1000")
+	}
+	if x.Cursor != "" && !primitive.IsValidObjectID(x.Cursor) {
+		return errs.ErrArgs.WrapMsg("cursor is invalid")
+	}
+	return nil
+}
+
+func (x *SearchAuditLogReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.WrapMsg("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *TakeExpiredImpersonationReq) Check() error {
+	if x.Limit < 1 || x.Limit > 1000 {
+		return errs.ErrArgs.WrapMsg("limit must be between 1 and 1000")
+	}
+	return nil
+}
+
+func (x *ImpersonateUserReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	if x.Reason == "" {
+		return errs.ErrArgs.WrapMsg("reason is empty")
+	}
+	return nil
+}
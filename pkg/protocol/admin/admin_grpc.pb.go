@@ -19,58 +19,92 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Admin_Login_FullMethodName                    = "/openim.admin.admin/Login"
-	Admin_ChangePassword_FullMethodName           = "/openim.admin.admin/ChangePassword"
-	Admin_AdminUpdateInfo_FullMethodName          = "/openim.admin.admin/AdminUpdateInfo"
-	Admin_GetAdminInfo_FullMethodName             = "/openim.admin.admin/GetAdminInfo"
-	Admin_AddAdminAccount_FullMethodName          = "/openim.admin.admin/AddAdminAccount"
-	Admin_ChangeAdminPassword_FullMethodName      = "/openim.admin.admin/ChangeAdminPassword"
-	Admin_DelAdminAccount_FullMethodName          = "/openim.admin.admin/DelAdminAccount"
-	Admin_SearchAdminAccount_FullMethodName       = "/openim.admin.admin/SearchAdminAccount"
-	Admin_AddDefaultFriend_FullMethodName         = "/openim.admin.admin/AddDefaultFriend"
-	Admin_DelDefaultFriend_FullMethodName         = "/openim.admin.admin/DelDefaultFriend"
-	Admin_FindDefaultFriend_FullMethodName        = "/openim.admin.admin/FindDefaultFriend"
-	Admin_SearchDefaultFriend_FullMethodName      = "/openim.admin.admin/SearchDefaultFriend"
-	Admin_AddDefaultGroup_FullMethodName          = "/openim.admin.admin/AddDefaultGroup"
-	Admin_DelDefaultGroup_FullMethodName          = "/openim.admin.admin/DelDefaultGroup"
-	Admin_FindDefaultGroup_FullMethodName         = "/openim.admin.admin/FindDefaultGroup"
-	Admin_SearchDefaultGroup_FullMethodName       = "/openim.admin.admin/SearchDefaultGroup"
-	Admin_AddInvitationCode_FullMethodName        = "/openim.admin.admin/AddInvitationCode"
-	Admin_GenInvitationCode_FullMethodName        = "/openim.admin.admin/GenInvitationCode"
-	Admin_FindInvitationCode_FullMethodName       = "/openim.admin.admin/FindInvitationCode"
-	Admin_UseInvitationCode_FullMethodName        = "/openim.admin.admin/UseInvitationCode"
-	Admin_DelInvitationCode_FullMethodName        = "/openim.admin.admin/DelInvitationCode"
-	Admin_SearchInvitationCode_FullMethodName     = "/openim.admin.admin/SearchInvitationCode"
-	Admin_SearchUserIPLimitLogin_FullMethodName   = "/openim.admin.admin/SearchUserIPLimitLogin"
-	Admin_AddUserIPLimitLogin_FullMethodName      = "/openim.admin.admin/AddUserIPLimitLogin"
-	Admin_DelUserIPLimitLogin_FullMethodName      = "/openim.admin.admin/DelUserIPLimitLogin"
-	Admin_SearchIPForbidden_FullMethodName        = "/openim.admin.admin/SearchIPForbidden"
-	Admin_AddIPForbidden_FullMethodName           = "/openim.admin.admin/AddIPForbidden"
-	Admin_DelIPForbidden_FullMethodName           = "/openim.admin.admin/DelIPForbidden"
-	Admin_CancellationUser_FullMethodName         = "/openim.admin.admin/CancellationUser"
-	Admin_BlockUser_FullMethodName                = "/openim.admin.admin/BlockUser"
-	Admin_UnblockUser_FullMethodName              = "/openim.admin.admin/UnblockUser"
-	Admin_SearchBlockUser_FullMethodName          = "/openim.admin.admin/SearchBlockUser"
-	Admin_FindUserBlockInfo_FullMethodName        = "/openim.admin.admin/FindUserBlockInfo"
-	Admin_CheckRegisterForbidden_FullMethodName   = "/openim.admin.admin/CheckRegisterForbidden"
-	Admin_CheckLoginForbidden_FullMethodName      = "/openim.admin.admin/CheckLoginForbidden"
-	Admin_CreateToken_FullMethodName              = "/openim.admin.admin/CreateToken"
-	Admin_ParseToken_FullMethodName               = "/openim.admin.admin/ParseToken"
-	Admin_AddApplet_FullMethodName                = "/openim.admin.admin/AddApplet"
-	Admin_DelApplet_FullMethodName                = "/openim.admin.admin/DelApplet"
-	Admin_UpdateApplet_FullMethodName             = "/openim.admin.admin/UpdateApplet"
-	Admin_FindApplet_FullMethodName               = "/openim.admin.admin/FindApplet"
-	Admin_SearchApplet_FullMethodName             = "/openim.admin.admin/SearchApplet"
-	Admin_GetClientConfig_FullMethodName          = "/openim.admin.admin/GetClientConfig"
-	Admin_SetClientConfig_FullMethodName          = "/openim.admin.admin/SetClientConfig"
-	Admin_DelClientConfig_FullMethodName          = "/openim.admin.admin/DelClientConfig"
-	Admin_GetUserToken_FullMethodName             = "/openim.admin.admin/GetUserToken"
-	Admin_InvalidateToken_FullMethodName          = "/openim.admin.admin/InvalidateToken"
-	Admin_LatestApplicationVersion_FullMethodName = "/openim.admin.admin/LatestApplicationVersion"
-	Admin_AddApplicationVersion_FullMethodName    = "/openim.admin.admin/AddApplicationVersion"
-	Admin_UpdateApplicationVersion_FullMethodName = "/openim.admin.admin/UpdateApplicationVersion"
-	Admin_DeleteApplicationVersion_FullMethodName = "/openim.admin.admin/DeleteApplicationVersion"
-	Admin_PageApplicationVersion_FullMethodName   = "/openim.admin.admin/PageApplicationVersion"
+	Admin_Login_FullMethodName                            = "/openim.admin.admin/Login"
+	Admin_ChangePassword_FullMethodName                   = "/openim.admin.admin/ChangePassword"
+	Admin_AdminUpdateInfo_FullMethodName                  = "/openim.admin.admin/AdminUpdateInfo"
+	Admin_GetAdminInfo_FullMethodName                     = "/openim.admin.admin/GetAdminInfo"
+	Admin_AddAdminAccount_FullMethodName                  = "/openim.admin.admin/AddAdminAccount"
+	Admin_ChangeAdminPassword_FullMethodName              = "/openim.admin.admin/ChangeAdminPassword"
+	Admin_DelAdminAccount_FullMethodName                  = "/openim.admin.admin/DelAdminAccount"
+	Admin_SearchAdminAccount_FullMethodName               = "/openim.admin.admin/SearchAdminAccount"
+	Admin_AddRole_FullMethodName                          = "/openim.admin.admin/AddRole"
+	Admin_UpdateRole_FullMethodName                       = "/openim.admin.admin/UpdateRole"
+	Admin_DelRole_FullMethodName                          = "/openim.admin.admin/DelRole"
+	Admin_SearchRole_FullMethodName                       = "/openim.admin.admin/SearchRole"
+	Admin_ListPermission_FullMethodName                   = "/openim.admin.admin/ListPermission"
+	Admin_AssignAdminRole_FullMethodName                  = "/openim.admin.admin/AssignAdminRole"
+	Admin_CheckAdminPermission_FullMethodName             = "/openim.admin.admin/CheckAdminPermission"
+	Admin_AddAuditLog_FullMethodName                      = "/openim.admin.admin/AddAuditLog"
+	Admin_SearchAuditLog_FullMethodName                   = "/openim.admin.admin/SearchAuditLog"
+	Admin_ScanAuditLog_FullMethodName                     = "/openim.admin.admin/ScanAuditLog"
+	Admin_VerifyAuditLog_FullMethodName                   = "/openim.admin.admin/VerifyAuditLog"
+	Admin_EnrollAdminTOTP_FullMethodName                  = "/openim.admin.admin/EnrollAdminTOTP"
+	Admin_ConfirmAdminTOTP_FullMethodName                 = "/openim.admin.admin/ConfirmAdminTOTP"
+	Admin_LoginAdminTOTP_FullMethodName                   = "/openim.admin.admin/LoginAdminTOTP"
+	Admin_DisableAdminTOTP_FullMethodName                 = "/openim.admin.admin/DisableAdminTOTP"
+	Admin_RegenerateAdminTOTPRecoveryCodes_FullMethodName = "/openim.admin.admin/RegenerateAdminTOTPRecoveryCodes"
+	Admin_AddDefaultFriend_FullMethodName                 = "/openim.admin.admin/AddDefaultFriend"
+	Admin_DelDefaultFriend_FullMethodName                 = "/openim.admin.admin/DelDefaultFriend"
+	Admin_FindDefaultFriend_FullMethodName                = "/openim.admin.admin/FindDefaultFriend"
+	Admin_SearchDefaultFriend_FullMethodName              = "/openim.admin.admin/SearchDefaultFriend"
+	Admin_AddDefaultGroup_FullMethodName                  = "/openim.admin.admin/AddDefaultGroup"
+	Admin_DelDefaultGroup_FullMethodName                  = "/openim.admin.admin/DelDefaultGroup"
+	Admin_FindDefaultGroup_FullMethodName                 = "/openim.admin.admin/FindDefaultGroup"
+	Admin_SearchDefaultGroup_FullMethodName               = "/openim.admin.admin/SearchDefaultGroup"
+	Admin_AddInvitationCode_FullMethodName                = "/openim.admin.admin/AddInvitationCode"
+	Admin_GenInvitationCode_FullMethodName                = "/openim.admin.admin/GenInvitationCode"
+	Admin_FindInvitationCode_FullMethodName               = "/openim.admin.admin/FindInvitationCode"
+	Admin_UseInvitationCode_FullMethodName                = "/openim.admin.admin/UseInvitationCode"
+	Admin_DelInvitationCode_FullMethodName                = "/openim.admin.admin/DelInvitationCode"
+	Admin_SearchInvitationCode_FullMethodName             = "/openim.admin.admin/SearchInvitationCode"
+	Admin_SearchUserIPLimitLogin_FullMethodName           = "/openim.admin.admin/SearchUserIPLimitLogin"
+	Admin_AddUserIPLimitLogin_FullMethodName              = "/openim.admin.admin/AddUserIPLimitLogin"
+	Admin_DelUserIPLimitLogin_FullMethodName              = "/openim.admin.admin/DelUserIPLimitLogin"
+	Admin_SearchIPForbidden_FullMethodName                = "/openim.admin.admin/SearchIPForbidden"
+	Admin_AddIPForbidden_FullMethodName                   = "/openim.admin.admin/AddIPForbidden"
+	Admin_DelIPForbidden_FullMethodName                   = "/openim.admin.admin/DelIPForbidden"
+	Admin_CancellationUser_FullMethodName                 = "/openim.admin.admin/CancellationUser"
+	Admin_BlockUser_FullMethodName                        = "/openim.admin.admin/BlockUser"
+	Admin_UnblockUser_FullMethodName                      = "/openim.admin.admin/UnblockUser"
+	Admin_SearchBlockUser_FullMethodName                  = "/openim.admin.admin/SearchBlockUser"
+	Admin_FindUserBlockInfo_FullMethodName                = "/openim.admin.admin/FindUserBlockInfo"
+	Admin_SearchBlockHistory_FullMethodName               = "/openim.admin.admin/SearchBlockHistory"
+	Admin_CreateAppealTicket_FullMethodName               = "/openim.admin.admin/CreateAppealTicket"
+	Admin_GetAppeal_FullMethodName                        = "/openim.admin.admin/GetAppeal"
+	Admin_SubmitAppeal_FullMethodName                     = "/openim.admin.admin/SubmitAppeal"
+	Admin_SearchAppeal_FullMethodName                     = "/openim.admin.admin/SearchAppeal"
+	Admin_AssignAppeal_FullMethodName                     = "/openim.admin.admin/AssignAppeal"
+	Admin_HandleAppeal_FullMethodName                     = "/openim.admin.admin/HandleAppeal"
+	Admin_CheckRegisterForbidden_FullMethodName           = "/openim.admin.admin/CheckRegisterForbidden"
+	Admin_CheckLoginForbidden_FullMethodName              = "/openim.admin.admin/CheckLoginForbidden"
+	Admin_AddGeoRule_FullMethodName                       = "/openim.admin.admin/AddGeoRule"
+	Admin_DelGeoRule_FullMethodName                       = "/openim.admin.admin/DelGeoRule"
+	Admin_SearchGeoRule_FullMethodName                    = "/openim.admin.admin/SearchGeoRule"
+	Admin_CreateToken_FullMethodName                      = "/openim.admin.admin/CreateToken"
+	Admin_ParseToken_FullMethodName                       = "/openim.admin.admin/ParseToken"
+	Admin_AddApplet_FullMethodName                        = "/openim.admin.admin/AddApplet"
+	Admin_DelApplet_FullMethodName                        = "/openim.admin.admin/DelApplet"
+	Admin_UpdateApplet_FullMethodName                     = "/openim.admin.admin/UpdateApplet"
+	Admin_FindApplet_FullMethodName                       = "/openim.admin.admin/FindApplet"
+	Admin_SearchApplet_FullMethodName                     = "/openim.admin.admin/SearchApplet"
+	Admin_GetClientConfig_FullMethodName                  = "/openim.admin.admin/GetClientConfig"
+	Admin_SetClientConfig_FullMethodName                  = "/openim.admin.admin/SetClientConfig"
+	Admin_DelClientConfig_FullMethodName                  = "/openim.admin.admin/DelClientConfig"
+	Admin_GetUserToken_FullMethodName                     = "/openim.admin.admin/GetUserToken"
+	Admin_InvalidateToken_FullMethodName                  = "/openim.admin.admin/InvalidateToken"
+	Admin_GetUserSessions_FullMethodName                  = "/openim.admin.admin/GetUserSessions"
+	Admin_RevokeUserSession_FullMethodName                = "/openim.admin.admin/RevokeUserSession"
+	Admin_ImpersonateUser_FullMethodName                  = "/openim.admin.admin/ImpersonateUser"
+	Admin_TakeExpiredImpersonation_FullMethodName         = "/openim.admin.admin/TakeExpiredImpersonation"
+	Admin_RefreshToken_FullMethodName                     = "/openim.admin.admin/RefreshToken"
+	Admin_GetJWKS_FullMethodName                          = "/openim.admin.admin/GetJWKS"
+	Admin_SearchLoginLock_FullMethodName                  = "/openim.admin.admin/SearchLoginLock"
+	Admin_ClearLoginLock_FullMethodName                   = "/openim.admin.admin/ClearLoginLock"
+	Admin_LatestApplicationVersion_FullMethodName         = "/openim.admin.admin/LatestApplicationVersion"
+	Admin_AddApplicationVersion_FullMethodName            = "/openim.admin.admin/AddApplicationVersion"
+	Admin_UpdateApplicationVersion_FullMethodName         = "/openim.admin.admin/UpdateApplicationVersion"
+	Admin_DeleteApplicationVersion_FullMethodName         = "/openim.admin.admin/DeleteApplicationVersion"
+	Admin_PageApplicationVersion_FullMethodName           = "/openim.admin.admin/PageApplicationVersion"
 )
 
 // AdminClient is the client API for Admin service.
@@ -87,6 +121,25 @@ type AdminClient interface {
 	ChangeAdminPassword(ctx context.Context, in *ChangeAdminPasswordReq, opts ...grpc.CallOption) (*ChangeAdminPasswordResp, error)
 	DelAdminAccount(ctx context.Context, in *DelAdminAccountReq, opts ...grpc.CallOption) (*DelAdminAccountResp, error)
 	SearchAdminAccount(ctx context.Context, in *SearchAdminAccountReq, opts ...grpc.CallOption) (*SearchAdminAccountResp, error)
+	// Roles and permissions
+	AddRole(ctx context.Context, in *AddRoleReq, opts ...grpc.CallOption) (*AddRoleResp, error)
+	UpdateRole(ctx context.Context, in *UpdateRoleReq, opts ...grpc.CallOption) (*UpdateRoleResp, error)
+	DelRole(ctx context.Context, in *DelRoleReq, opts ...grpc.CallOption) (*DelRoleResp, error)
+	SearchRole(ctx context.Context, in *SearchRoleReq, opts ...grpc.CallOption) (*SearchRoleResp, error)
+	ListPermission(ctx context.Context, in *ListPermissionReq, opts ...grpc.CallOption) (*ListPermissionResp, error)
+	AssignAdminRole(ctx context.Context, in *AssignAdminRoleReq, opts ...grpc.CallOption) (*AssignAdminRoleResp, error)
+	CheckAdminPermission(ctx context.Context, in *CheckAdminPermissionReq, opts ...grpc.CallOption) (*CheckAdminPermissionResp, error)
+	// Audit log
+	AddAuditLog(ctx context.Context, in *AddAuditLogReq, opts ...grpc.CallOption) (*AddAuditLogResp, error)
+	SearchAuditLog(ctx context.Context, in *SearchAuditLogReq, opts ...grpc.CallOption) (*SearchAuditLogResp, error)
+	ScanAuditLog(ctx context.Context, in *ScanAuditLogReq, opts ...grpc.CallOption) (*ScanAuditLogResp, error)
+	VerifyAuditLog(ctx context.Context, in *VerifyAuditLogReq, opts ...grpc.CallOption) (*VerifyAuditLogResp, error)
+	// TOTP
+	EnrollAdminTOTP(ctx context.Context, in *EnrollAdminTOTPReq, opts ...grpc.CallOption) (*EnrollAdminTOTPResp, error)
+	ConfirmAdminTOTP(ctx context.Context, in *ConfirmAdminTOTPReq, opts ...grpc.CallOption) (*ConfirmAdminTOTPResp, error)
+	LoginAdminTOTP(ctx context.Context, in *LoginAdminTOTPReq, opts ...grpc.CallOption) (*LoginResp, error)
+	DisableAdminTOTP(ctx context.Context, in *DisableAdminTOTPReq, opts ...grpc.CallOption) (*DisableAdminTOTPResp, error)
+	RegenerateAdminTOTPRecoveryCodes(ctx context.Context, in *RegenerateAdminTOTPRecoveryCodesReq, opts ...grpc.CallOption) (*RegenerateAdminTOTPRecoveryCodesResp, error)
 	// Add Remove Get default friend list on registration
 	AddDefaultFriend(ctx context.Context, in *AddDefaultFriendReq, opts ...grpc.CallOption) (*AddDefaultFriendResp, error)
 	DelDefaultFriend(ctx context.Context, in *DelDefaultFriendReq, opts ...grpc.CallOption) (*DelDefaultFriendResp, error)
@@ -117,8 +170,19 @@ type AdminClient interface {
 	UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...grpc.CallOption) (*UnblockUserResp, error)
 	SearchBlockUser(ctx context.Context, in *SearchBlockUserReq, opts ...grpc.CallOption) (*SearchBlockUserResp, error)
 	FindUserBlockInfo(ctx context.Context, in *FindUserBlockInfoReq, opts ...grpc.CallOption) (*FindUserBlockInfoResp, error)
+	SearchBlockHistory(ctx context.Context, in *SearchBlockHistoryReq, opts ...grpc.CallOption) (*SearchBlockHistoryResp, error)
+	// Appeals against blocks, submitted with the ticket of a refused login
+	CreateAppealTicket(ctx context.Context, in *CreateAppealTicketReq, opts ...grpc.CallOption) (*CreateAppealTicketResp, error)
+	GetAppeal(ctx context.Context, in *GetAppealReq, opts ...grpc.CallOption) (*GetAppealResp, error)
+	SubmitAppeal(ctx context.Context, in *SubmitAppealReq, opts ...grpc.CallOption) (*SubmitAppealResp, error)
+	SearchAppeal(ctx context.Context, in *SearchAppealReq, opts ...grpc.CallOption) (*SearchAppealResp, error)
+	AssignAppeal(ctx context.Context, in *AssignAppealReq, opts ...grpc.CallOption) (*AssignAppealResp, error)
+	HandleAppeal(ctx context.Context, in *HandleAppealReq, opts ...grpc.CallOption) (*HandleAppealResp, error)
 	CheckRegisterForbidden(ctx context.Context, in *CheckRegisterForbiddenReq, opts ...grpc.CallOption) (*CheckRegisterForbiddenResp, error)
 	CheckLoginForbidden(ctx context.Context, in *CheckLoginForbiddenReq, opts ...grpc.CallOption) (*CheckLoginForbiddenResp, error)
+	AddGeoRule(ctx context.Context, in *AddGeoRuleReq, opts ...grpc.CallOption) (*AddGeoRuleResp, error)
+	DelGeoRule(ctx context.Context, in *DelGeoRuleReq, opts ...grpc.CallOption) (*DelGeoRuleResp, error)
+	SearchGeoRule(ctx context.Context, in *SearchGeoRuleReq, opts ...grpc.CallOption) (*SearchGeoRuleResp, error)
 	// create token
 	CreateToken(ctx context.Context, in *CreateTokenReq, opts ...grpc.CallOption) (*CreateTokenResp, error)
 	// parse token
@@ -136,6 +200,17 @@ type AdminClient interface {
 	GetUserToken(ctx context.Context, in *GetUserTokenReq, opts ...grpc.CallOption) (*GetUserTokenResp, error)
 	// invalidate token
 	InvalidateToken(ctx context.Context, in *InvalidateTokenReq, opts ...grpc.CallOption) (*InvalidateTokenResp, error)
+	// Sessions, called by the user or an administrator
+	GetUserSessions(ctx context.Context, in *GetUserSessionsReq, opts ...grpc.CallOption) (*GetUserSessionsResp, error)
+	RevokeUserSession(ctx context.Context, in *RevokeUserSessionReq, opts ...grpc.CallOption) (*RevokeUserSessionResp, error)
+	// Issue a short-lived token to act as the user
+	ImpersonateUser(ctx context.Context, in *ImpersonateUserReq, opts ...grpc.CallOption) (*ImpersonateUserResp, error)
+	// Take the IM tokens of impersonations that ended, the admin api signs the users out of the IM
+	TakeExpiredImpersonation(ctx context.Context, in *TakeExpiredImpersonationReq, opts ...grpc.CallOption) (*TakeExpiredImpersonationResp, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error)
+	GetJWKS(ctx context.Context, in *GetJWKSReq, opts ...grpc.CallOption) (*GetJWKSResp, error)
+	SearchLoginLock(ctx context.Context, in *SearchLoginLockReq, opts ...grpc.CallOption) (*SearchLoginLockResp, error)
+	ClearLoginLock(ctx context.Context, in *ClearLoginLockReq, opts ...grpc.CallOption) (*ClearLoginLockResp, error)
 	LatestApplicationVersion(ctx context.Context, in *LatestApplicationVersionReq, opts ...grpc.CallOption) (*LatestApplicationVersionResp, error)
 	AddApplicationVersion(ctx context.Context, in *AddApplicationVersionReq, opts ...grpc.CallOption) (*AddApplicationVersionResp, error)
 	UpdateApplicationVersion(ctx context.Context, in *UpdateApplicationVersionReq, opts ...grpc.CallOption) (*UpdateApplicationVersionResp, error)
@@ -231,6 +306,166 @@ func (c *adminClient) SearchAdminAccount(ctx context.Context, in *SearchAdminAcc
 	return out, nil
 }
 
+func (c *adminClient) AddRole(ctx context.Context, in *AddRoleReq, opts ...grpc.CallOption) (*AddRoleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddRoleResp)
+	err := c.cc.Invoke(ctx, Admin_AddRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) UpdateRole(ctx context.Context, in *UpdateRoleReq, opts ...grpc.CallOption) (*UpdateRoleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRoleResp)
+	err := c.cc.Invoke(ctx, Admin_UpdateRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DelRole(ctx context.Context, in *DelRoleReq, opts ...grpc.CallOption) (*DelRoleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DelRoleResp)
+	err := c.cc.Invoke(ctx, Admin_DelRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SearchRole(ctx context.Context, in *SearchRoleReq, opts ...grpc.CallOption) (*SearchRoleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchRoleResp)
+	err := c.cc.Invoke(ctx, Admin_SearchRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListPermission(ctx context.Context, in *ListPermissionReq, opts ...grpc.CallOption) (*ListPermissionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPermissionResp)
+	err := c.cc.Invoke(ctx, Admin_ListPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) AssignAdminRole(ctx context.Context, in *AssignAdminRoleReq, opts ...grpc.CallOption) (*AssignAdminRoleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignAdminRoleResp)
+	err := c.cc.Invoke(ctx, Admin_AssignAdminRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CheckAdminPermission(ctx context.Context, in *CheckAdminPermissionReq, opts ...grpc.CallOption) (*CheckAdminPermissionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckAdminPermissionResp)
+	err := c.cc.Invoke(ctx, Admin_CheckAdminPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) AddAuditLog(ctx context.Context, in *AddAuditLogReq, opts ...grpc.CallOption) (*AddAuditLogResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAuditLogResp)
+	err := c.cc.Invoke(ctx, Admin_AddAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SearchAuditLog(ctx context.Context, in *SearchAuditLogReq, opts ...grpc.CallOption) (*SearchAuditLogResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAuditLogResp)
+	err := c.cc.Invoke(ctx, Admin_SearchAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ScanAuditLog(ctx context.Context, in *ScanAuditLogReq, opts ...grpc.CallOption) (*ScanAuditLogResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScanAuditLogResp)
+	err := c.cc.Invoke(ctx, Admin_ScanAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) VerifyAuditLog(ctx context.Context, in *VerifyAuditLogReq, opts ...grpc.CallOption) (*VerifyAuditLogResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAuditLogResp)
+	err := c.cc.Invoke(ctx, Admin_VerifyAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) EnrollAdminTOTP(ctx context.Context, in *EnrollAdminTOTPReq, opts ...grpc.CallOption) (*EnrollAdminTOTPResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollAdminTOTPResp)
+	err := c.cc.Invoke(ctx, Admin_EnrollAdminTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ConfirmAdminTOTP(ctx context.Context, in *ConfirmAdminTOTPReq, opts ...grpc.CallOption) (*ConfirmAdminTOTPResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmAdminTOTPResp)
+	err := c.cc.Invoke(ctx, Admin_ConfirmAdminTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) LoginAdminTOTP(ctx context.Context, in *LoginAdminTOTPReq, opts ...grpc.CallOption) (*LoginResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResp)
+	err := c.cc.Invoke(ctx, Admin_LoginAdminTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DisableAdminTOTP(ctx context.Context, in *DisableAdminTOTPReq, opts ...grpc.CallOption) (*DisableAdminTOTPResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableAdminTOTPResp)
+	err := c.cc.Invoke(ctx, Admin_DisableAdminTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RegenerateAdminTOTPRecoveryCodes(ctx context.Context, in *RegenerateAdminTOTPRecoveryCodesReq, opts ...grpc.CallOption) (*RegenerateAdminTOTPRecoveryCodesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateAdminTOTPRecoveryCodesResp)
+	err := c.cc.Invoke(ctx, Admin_RegenerateAdminTOTPRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) AddDefaultFriend(ctx context.Context, in *AddDefaultFriendReq, opts ...grpc.CallOption) (*AddDefaultFriendResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddDefaultFriendResp)
@@ -481,6 +716,76 @@ func (c *adminClient) FindUserBlockInfo(ctx context.Context, in *FindUserBlockIn
 	return out, nil
 }
 
+func (c *adminClient) SearchBlockHistory(ctx context.Context, in *SearchBlockHistoryReq, opts ...grpc.CallOption) (*SearchBlockHistoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchBlockHistoryResp)
+	err := c.cc.Invoke(ctx, Admin_SearchBlockHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CreateAppealTicket(ctx context.Context, in *CreateAppealTicketReq, opts ...grpc.CallOption) (*CreateAppealTicketResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAppealTicketResp)
+	err := c.cc.Invoke(ctx, Admin_CreateAppealTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetAppeal(ctx context.Context, in *GetAppealReq, opts ...grpc.CallOption) (*GetAppealResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAppealResp)
+	err := c.cc.Invoke(ctx, Admin_GetAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SubmitAppeal(ctx context.Context, in *SubmitAppealReq, opts ...grpc.CallOption) (*SubmitAppealResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitAppealResp)
+	err := c.cc.Invoke(ctx, Admin_SubmitAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SearchAppeal(ctx context.Context, in *SearchAppealReq, opts ...grpc.CallOption) (*SearchAppealResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAppealResp)
+	err := c.cc.Invoke(ctx, Admin_SearchAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) AssignAppeal(ctx context.Context, in *AssignAppealReq, opts ...grpc.CallOption) (*AssignAppealResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignAppealResp)
+	err := c.cc.Invoke(ctx, Admin_AssignAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) HandleAppeal(ctx context.Context, in *HandleAppealReq, opts ...grpc.CallOption) (*HandleAppealResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HandleAppealResp)
+	err := c.cc.Invoke(ctx, Admin_HandleAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) CheckRegisterForbidden(ctx context.Context, in *CheckRegisterForbiddenReq, opts ...grpc.CallOption) (*CheckRegisterForbiddenResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CheckRegisterForbiddenResp)
@@ -501,6 +806,36 @@ func (c *adminClient) CheckLoginForbidden(ctx context.Context, in *CheckLoginFor
 	return out, nil
 }
 
+func (c *adminClient) AddGeoRule(ctx context.Context, in *AddGeoRuleReq, opts ...grpc.CallOption) (*AddGeoRuleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddGeoRuleResp)
+	err := c.cc.Invoke(ctx, Admin_AddGeoRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DelGeoRule(ctx context.Context, in *DelGeoRuleReq, opts ...grpc.CallOption) (*DelGeoRuleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DelGeoRuleResp)
+	err := c.cc.Invoke(ctx, Admin_DelGeoRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SearchGeoRule(ctx context.Context, in *SearchGeoRuleReq, opts ...grpc.CallOption) (*SearchGeoRuleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchGeoRuleResp)
+	err := c.cc.Invoke(ctx, Admin_SearchGeoRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) CreateToken(ctx context.Context, in *CreateTokenReq, opts ...grpc.CallOption) (*CreateTokenResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTokenResp)
@@ -621,6 +956,86 @@ func (c *adminClient) InvalidateToken(ctx context.Context, in *InvalidateTokenRe
 	return out, nil
 }
 
+func (c *adminClient) GetUserSessions(ctx context.Context, in *GetUserSessionsReq, opts ...grpc.CallOption) (*GetUserSessionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserSessionsResp)
+	err := c.cc.Invoke(ctx, Admin_GetUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RevokeUserSession(ctx context.Context, in *RevokeUserSessionReq, opts ...grpc.CallOption) (*RevokeUserSessionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeUserSessionResp)
+	err := c.cc.Invoke(ctx, Admin_RevokeUserSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ImpersonateUser(ctx context.Context, in *ImpersonateUserReq, opts ...grpc.CallOption) (*ImpersonateUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonateUserResp)
+	err := c.cc.Invoke(ctx, Admin_ImpersonateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) TakeExpiredImpersonation(ctx context.Context, in *TakeExpiredImpersonationReq, opts ...grpc.CallOption) (*TakeExpiredImpersonationResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TakeExpiredImpersonationResp)
+	err := c.cc.Invoke(ctx, Admin_TakeExpiredImpersonation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenResp)
+	err := c.cc.Invoke(ctx, Admin_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetJWKS(ctx context.Context, in *GetJWKSReq, opts ...grpc.CallOption) (*GetJWKSResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJWKSResp)
+	err := c.cc.Invoke(ctx, Admin_GetJWKS_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SearchLoginLock(ctx context.Context, in *SearchLoginLockReq, opts ...grpc.CallOption) (*SearchLoginLockResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchLoginLockResp)
+	err := c.cc.Invoke(ctx, Admin_SearchLoginLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ClearLoginLock(ctx context.Context, in *ClearLoginLockReq, opts ...grpc.CallOption) (*ClearLoginLockResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearLoginLockResp)
+	err := c.cc.Invoke(ctx, Admin_ClearLoginLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) LatestApplicationVersion(ctx context.Context, in *LatestApplicationVersionReq, opts ...grpc.CallOption) (*LatestApplicationVersionResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LatestApplicationVersionResp)
@@ -685,6 +1100,25 @@ type AdminServer interface {
 	ChangeAdminPassword(context.Context, *ChangeAdminPasswordReq) (*ChangeAdminPasswordResp, error)
 	DelAdminAccount(context.Context, *DelAdminAccountReq) (*DelAdminAccountResp, error)
 	SearchAdminAccount(context.Context, *SearchAdminAccountReq) (*SearchAdminAccountResp, error)
+	// Roles and permissions
+	AddRole(context.Context, *AddRoleReq) (*AddRoleResp, error)
+	UpdateRole(context.Context, *UpdateRoleReq) (*UpdateRoleResp, error)
+	DelRole(context.Context, *DelRoleReq) (*DelRoleResp, error)
+	SearchRole(context.Context, *SearchRoleReq) (*SearchRoleResp, error)
+	ListPermission(context.Context, *ListPermissionReq) (*ListPermissionResp, error)
+	AssignAdminRole(context.Context, *AssignAdminRoleReq) (*AssignAdminRoleResp, error)
+	CheckAdminPermission(context.Context, *CheckAdminPermissionReq) (*CheckAdminPermissionResp, error)
+	// Audit log
+	AddAuditLog(context.Context, *AddAuditLogReq) (*AddAuditLogResp, error)
+	SearchAuditLog(context.Context, *SearchAuditLogReq) (*SearchAuditLogResp, error)
+	ScanAuditLog(context.Context, *ScanAuditLogReq) (*ScanAuditLogResp, error)
+	VerifyAuditLog(context.Context, *VerifyAuditLogReq) (*VerifyAuditLogResp, error)
+	// TOTP
+	EnrollAdminTOTP(context.Context, *EnrollAdminTOTPReq) (*EnrollAdminTOTPResp, error)
+	ConfirmAdminTOTP(context.Context, *ConfirmAdminTOTPReq) (*ConfirmAdminTOTPResp, error)
+	LoginAdminTOTP(context.Context, *LoginAdminTOTPReq) (*LoginResp, error)
+	DisableAdminTOTP(context.Context, *DisableAdminTOTPReq) (*DisableAdminTOTPResp, error)
+	RegenerateAdminTOTPRecoveryCodes(context.Context, *RegenerateAdminTOTPRecoveryCodesReq) (*RegenerateAdminTOTPRecoveryCodesResp, error)
 	// Add Remove Get default friend list on registration
 	AddDefaultFriend(context.Context, *AddDefaultFriendReq) (*AddDefaultFriendResp, error)
 	DelDefaultFriend(context.Context, *DelDefaultFriendReq) (*DelDefaultFriendResp, error)
@@ -715,8 +1149,19 @@ type AdminServer interface {
 	UnblockUser(context.Context, *UnblockUserReq) (*UnblockUserResp, error)
 	SearchBlockUser(context.Context, *SearchBlockUserReq) (*SearchBlockUserResp, error)
 	FindUserBlockInfo(context.Context, *FindUserBlockInfoReq) (*FindUserBlockInfoResp, error)
+	SearchBlockHistory(context.Context, *SearchBlockHistoryReq) (*SearchBlockHistoryResp, error)
+	// Appeals against blocks, submitted with the ticket of a refused login
+	CreateAppealTicket(context.Context, *CreateAppealTicketReq) (*CreateAppealTicketResp, error)
+	GetAppeal(context.Context, *GetAppealReq) (*GetAppealResp, error)
+	SubmitAppeal(context.Context, *SubmitAppealReq) (*SubmitAppealResp, error)
+	SearchAppeal(context.Context, *SearchAppealReq) (*SearchAppealResp, error)
+	AssignAppeal(context.Context, *AssignAppealReq) (*AssignAppealResp, error)
+	HandleAppeal(context.Context, *HandleAppealReq) (*HandleAppealResp, error)
 	CheckRegisterForbidden(context.Context, *CheckRegisterForbiddenReq) (*CheckRegisterForbiddenResp, error)
 	CheckLoginForbidden(context.Context, *CheckLoginForbiddenReq) (*CheckLoginForbiddenResp, error)
+	AddGeoRule(context.Context, *AddGeoRuleReq) (*AddGeoRuleResp, error)
+	DelGeoRule(context.Context, *DelGeoRuleReq) (*DelGeoRuleResp, error)
+	SearchGeoRule(context.Context, *SearchGeoRuleReq) (*SearchGeoRuleResp, error)
 	// create token
 	CreateToken(context.Context, *CreateTokenReq) (*CreateTokenResp, error)
 	// parse token
@@ -734,6 +1179,17 @@ type AdminServer interface {
 	GetUserToken(context.Context, *GetUserTokenReq) (*GetUserTokenResp, error)
 	// invalidate token
 	InvalidateToken(context.Context, *InvalidateTokenReq) (*InvalidateTokenResp, error)
+	// Sessions, called by the user or an administrator
+	GetUserSessions(context.Context, *GetUserSessionsReq) (*GetUserSessionsResp, error)
+	RevokeUserSession(context.Context, *RevokeUserSessionReq) (*RevokeUserSessionResp, error)
+	// Issue a short-lived token to act as the user
+	ImpersonateUser(context.Context, *ImpersonateUserReq) (*ImpersonateUserResp, error)
+	// Take the IM tokens of impersonations that ended, the admin api signs the users out of the IM
+	TakeExpiredImpersonation(context.Context, *TakeExpiredImpersonationReq) (*TakeExpiredImpersonationResp, error)
+	RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error)
+	GetJWKS(context.Context, *GetJWKSReq) (*GetJWKSResp, error)
+	SearchLoginLock(context.Context, *SearchLoginLockReq) (*SearchLoginLockResp, error)
+	ClearLoginLock(context.Context, *ClearLoginLockReq) (*ClearLoginLockResp, error)
 	LatestApplicationVersion(context.Context, *LatestApplicationVersionReq) (*LatestApplicationVersionResp, error)
 	AddApplicationVersion(context.Context, *AddApplicationVersionReq) (*AddApplicationVersionResp, error)
 	UpdateApplicationVersion(context.Context, *UpdateApplicationVersionReq) (*UpdateApplicationVersionResp, error)
@@ -773,6 +1229,54 @@ func (UnimplementedAdminServer) DelAdminAccount(context.Context, *DelAdminAccoun
 func (UnimplementedAdminServer) SearchAdminAccount(context.Context, *SearchAdminAccountReq) (*SearchAdminAccountResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchAdminAccount not implemented")
 }
+func (UnimplementedAdminServer) AddRole(context.Context, *AddRoleReq) (*AddRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRole not implemented")
+}
+func (UnimplementedAdminServer) UpdateRole(context.Context, *UpdateRoleReq) (*UpdateRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (UnimplementedAdminServer) DelRole(context.Context, *DelRoleReq) (*DelRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelRole not implemented")
+}
+func (UnimplementedAdminServer) SearchRole(context.Context, *SearchRoleReq) (*SearchRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchRole not implemented")
+}
+func (UnimplementedAdminServer) ListPermission(context.Context, *ListPermissionReq) (*ListPermissionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermission not implemented")
+}
+func (UnimplementedAdminServer) AssignAdminRole(context.Context, *AssignAdminRoleReq) (*AssignAdminRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignAdminRole not implemented")
+}
+func (UnimplementedAdminServer) CheckAdminPermission(context.Context, *CheckAdminPermissionReq) (*CheckAdminPermissionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAdminPermission not implemented")
+}
+func (UnimplementedAdminServer) AddAuditLog(context.Context, *AddAuditLogReq) (*AddAuditLogResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuditLog not implemented")
+}
+func (UnimplementedAdminServer) SearchAuditLog(context.Context, *SearchAuditLogReq) (*SearchAuditLogResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAuditLog not implemented")
+}
+func (UnimplementedAdminServer) ScanAuditLog(context.Context, *ScanAuditLogReq) (*ScanAuditLogResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanAuditLog not implemented")
+}
+func (UnimplementedAdminServer) VerifyAuditLog(context.Context, *VerifyAuditLogReq) (*VerifyAuditLogResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditLog not implemented")
+}
+func (UnimplementedAdminServer) EnrollAdminTOTP(context.Context, *EnrollAdminTOTPReq) (*EnrollAdminTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollAdminTOTP not implemented")
+}
+func (UnimplementedAdminServer) ConfirmAdminTOTP(context.Context, *ConfirmAdminTOTPReq) (*ConfirmAdminTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmAdminTOTP not implemented")
+}
+func (UnimplementedAdminServer) LoginAdminTOTP(context.Context, *LoginAdminTOTPReq) (*LoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginAdminTOTP not implemented")
+}
+func (UnimplementedAdminServer) DisableAdminTOTP(context.Context, *DisableAdminTOTPReq) (*DisableAdminTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableAdminTOTP not implemented")
+}
+func (UnimplementedAdminServer) RegenerateAdminTOTPRecoveryCodes(context.Context, *RegenerateAdminTOTPRecoveryCodesReq) (*RegenerateAdminTOTPRecoveryCodesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateAdminTOTPRecoveryCodes not implemented")
+}
 func (UnimplementedAdminServer) AddDefaultFriend(context.Context, *AddDefaultFriendReq) (*AddDefaultFriendResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddDefaultFriend not implemented")
 }
@@ -848,12 +1352,42 @@ func (UnimplementedAdminServer) SearchBlockUser(context.Context, *SearchBlockUse
 func (UnimplementedAdminServer) FindUserBlockInfo(context.Context, *FindUserBlockInfoReq) (*FindUserBlockInfoResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindUserBlockInfo not implemented")
 }
-func (UnimplementedAdminServer) CheckRegisterForbidden(context.Context, *CheckRegisterForbiddenReq) (*CheckRegisterForbiddenResp, error) {
+func (UnimplementedAdminServer) SearchBlockHistory(context.Context, *SearchBlockHistoryReq) (*SearchBlockHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBlockHistory not implemented")
+}
+func (UnimplementedAdminServer) CreateAppealTicket(context.Context, *CreateAppealTicketReq) (*CreateAppealTicketResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppealTicket not implemented")
+}
+func (UnimplementedAdminServer) GetAppeal(context.Context, *GetAppealReq) (*GetAppealResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppeal not implemented")
+}
+func (UnimplementedAdminServer) SubmitAppeal(context.Context, *SubmitAppealReq) (*SubmitAppealResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAppeal not implemented")
+}
+func (UnimplementedAdminServer) SearchAppeal(context.Context, *SearchAppealReq) (*SearchAppealResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAppeal not implemented")
+}
+func (UnimplementedAdminServer) AssignAppeal(context.Context, *AssignAppealReq) (*AssignAppealResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignAppeal not implemented")
+}
+func (UnimplementedAdminServer) HandleAppeal(context.Context, *HandleAppealReq) (*HandleAppealResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleAppeal not implemented")
+}
+func (UnimplementedAdminServer) CheckRegisterForbidden(context.Context, *CheckRegisterForbiddenReq) (*CheckRegisterForbiddenResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckRegisterForbidden not implemented")
 }
 func (UnimplementedAdminServer) CheckLoginForbidden(context.Context, *CheckLoginForbiddenReq) (*CheckLoginForbiddenResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckLoginForbidden not implemented")
 }
+func (UnimplementedAdminServer) AddGeoRule(context.Context, *AddGeoRuleReq) (*AddGeoRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGeoRule not implemented")
+}
+func (UnimplementedAdminServer) DelGeoRule(context.Context, *DelGeoRuleReq) (*DelGeoRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelGeoRule not implemented")
+}
+func (UnimplementedAdminServer) SearchGeoRule(context.Context, *SearchGeoRuleReq) (*SearchGeoRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchGeoRule not implemented")
+}
 func (UnimplementedAdminServer) CreateToken(context.Context, *CreateTokenReq) (*CreateTokenResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateToken not implemented")
 }
@@ -890,6 +1424,30 @@ func (UnimplementedAdminServer) GetUserToken(context.Context, *GetUserTokenReq)
 func (UnimplementedAdminServer) InvalidateToken(context.Context, *InvalidateTokenReq) (*InvalidateTokenResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvalidateToken not implemented")
 }
+func (UnimplementedAdminServer) GetUserSessions(context.Context, *GetUserSessionsReq) (*GetUserSessionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSessions not implemented")
+}
+func (UnimplementedAdminServer) RevokeUserSession(context.Context, *RevokeUserSessionReq) (*RevokeUserSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserSession not implemented")
+}
+func (UnimplementedAdminServer) ImpersonateUser(context.Context, *ImpersonateUserReq) (*ImpersonateUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImpersonateUser not implemented")
+}
+func (UnimplementedAdminServer) TakeExpiredImpersonation(context.Context, *TakeExpiredImpersonationReq) (*TakeExpiredImpersonationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakeExpiredImpersonation not implemented")
+}
+func (UnimplementedAdminServer) RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedAdminServer) GetJWKS(context.Context, *GetJWKSReq) (*GetJWKSResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJWKS not implemented")
+}
+func (UnimplementedAdminServer) SearchLoginLock(context.Context, *SearchLoginLockReq) (*SearchLoginLockResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchLoginLock not implemented")
+}
+func (UnimplementedAdminServer) ClearLoginLock(context.Context, *ClearLoginLockReq) (*ClearLoginLockResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearLoginLock not implemented")
+}
 func (UnimplementedAdminServer) LatestApplicationVersion(context.Context, *LatestApplicationVersionReq) (*LatestApplicationVersionResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestApplicationVersion not implemented")
 }
@@ -1070,6 +1628,294 @@ func _Admin_SearchAdminAccount_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_AddRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AddRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_AddRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddRole(ctx, req.(*AddRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_UpdateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UpdateRole(ctx, req.(*UpdateRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DelRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DelRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_DelRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DelRole(ctx, req.(*DelRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SearchRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchRole(ctx, req.(*SearchRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ListPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListPermission(ctx, req.(*ListPermissionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AssignAdminRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignAdminRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AssignAdminRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_AssignAdminRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AssignAdminRole(ctx, req.(*AssignAdminRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CheckAdminPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAdminPermissionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CheckAdminPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_CheckAdminPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CheckAdminPermission(ctx, req.(*CheckAdminPermissionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AddAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAuditLogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AddAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_AddAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddAuditLog(ctx, req.(*AddAuditLogReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAuditLogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SearchAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchAuditLog(ctx, req.(*SearchAuditLogReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ScanAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanAuditLogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ScanAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ScanAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ScanAuditLog(ctx, req.(*ScanAuditLogReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_VerifyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditLogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).VerifyAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_VerifyAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).VerifyAuditLog(ctx, req.(*VerifyAuditLogReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_EnrollAdminTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollAdminTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).EnrollAdminTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_EnrollAdminTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).EnrollAdminTOTP(ctx, req.(*EnrollAdminTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ConfirmAdminTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmAdminTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ConfirmAdminTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ConfirmAdminTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ConfirmAdminTOTP(ctx, req.(*ConfirmAdminTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_LoginAdminTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginAdminTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).LoginAdminTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_LoginAdminTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).LoginAdminTOTP(ctx, req.(*LoginAdminTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DisableAdminTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableAdminTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DisableAdminTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_DisableAdminTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DisableAdminTOTP(ctx, req.(*DisableAdminTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RegenerateAdminTOTPRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateAdminTOTPRecoveryCodesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RegenerateAdminTOTPRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_RegenerateAdminTOTPRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RegenerateAdminTOTPRecoveryCodes(ctx, req.(*RegenerateAdminTOTPRecoveryCodesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Admin_AddDefaultFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddDefaultFriendReq)
 	if err := dec(in); err != nil {
@@ -1314,244 +2160,424 @@ func _Admin_SearchInvitationCode_Handler(srv interface{}, ctx context.Context, d
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_SearchInvitationCode_FullMethodName,
+		FullMethod: Admin_SearchInvitationCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchInvitationCode(ctx, req.(*SearchInvitationCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchUserIPLimitLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUserIPLimitLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchUserIPLimitLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SearchUserIPLimitLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchUserIPLimitLogin(ctx, req.(*SearchUserIPLimitLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AddUserIPLimitLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserIPLimitLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AddUserIPLimitLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_AddUserIPLimitLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddUserIPLimitLogin(ctx, req.(*AddUserIPLimitLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DelUserIPLimitLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelUserIPLimitLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DelUserIPLimitLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_DelUserIPLimitLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DelUserIPLimitLogin(ctx, req.(*DelUserIPLimitLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchIPForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchIPForbiddenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchIPForbidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SearchIPForbidden_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchIPForbidden(ctx, req.(*SearchIPForbiddenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AddIPForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddIPForbiddenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AddIPForbidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_AddIPForbidden_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddIPForbidden(ctx, req.(*AddIPForbiddenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DelIPForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelIPForbiddenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DelIPForbidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_DelIPForbidden_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DelIPForbidden(ctx, req.(*DelIPForbiddenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CancellationUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancellationUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CancellationUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_CancellationUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CancellationUser(ctx, req.(*CancellationUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).BlockUser(ctx, req.(*BlockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UnblockUser(ctx, req.(*UnblockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchBlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBlockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchBlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SearchBlockUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).SearchInvitationCode(ctx, req.(*SearchInvitationCodeReq))
+		return srv.(AdminServer).SearchBlockUser(ctx, req.(*SearchBlockUserReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_SearchUserIPLimitLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchUserIPLimitLoginReq)
+func _Admin_FindUserBlockInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserBlockInfoReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).SearchUserIPLimitLogin(ctx, in)
+		return srv.(AdminServer).FindUserBlockInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_SearchUserIPLimitLogin_FullMethodName,
+		FullMethod: Admin_FindUserBlockInfo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).SearchUserIPLimitLogin(ctx, req.(*SearchUserIPLimitLoginReq))
+		return srv.(AdminServer).FindUserBlockInfo(ctx, req.(*FindUserBlockInfoReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_AddUserIPLimitLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddUserIPLimitLoginReq)
+func _Admin_SearchBlockHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBlockHistoryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).AddUserIPLimitLogin(ctx, in)
+		return srv.(AdminServer).SearchBlockHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_AddUserIPLimitLogin_FullMethodName,
+		FullMethod: Admin_SearchBlockHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).AddUserIPLimitLogin(ctx, req.(*AddUserIPLimitLoginReq))
+		return srv.(AdminServer).SearchBlockHistory(ctx, req.(*SearchBlockHistoryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_DelUserIPLimitLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelUserIPLimitLoginReq)
+func _Admin_CreateAppealTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppealTicketReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).DelUserIPLimitLogin(ctx, in)
+		return srv.(AdminServer).CreateAppealTicket(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_DelUserIPLimitLogin_FullMethodName,
+		FullMethod: Admin_CreateAppealTicket_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).DelUserIPLimitLogin(ctx, req.(*DelUserIPLimitLoginReq))
+		return srv.(AdminServer).CreateAppealTicket(ctx, req.(*CreateAppealTicketReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_SearchIPForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchIPForbiddenReq)
+func _Admin_GetAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppealReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).SearchIPForbidden(ctx, in)
+		return srv.(AdminServer).GetAppeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_SearchIPForbidden_FullMethodName,
+		FullMethod: Admin_GetAppeal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).SearchIPForbidden(ctx, req.(*SearchIPForbiddenReq))
+		return srv.(AdminServer).GetAppeal(ctx, req.(*GetAppealReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_AddIPForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddIPForbiddenReq)
+func _Admin_SubmitAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAppealReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).AddIPForbidden(ctx, in)
+		return srv.(AdminServer).SubmitAppeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_AddIPForbidden_FullMethodName,
+		FullMethod: Admin_SubmitAppeal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).AddIPForbidden(ctx, req.(*AddIPForbiddenReq))
+		return srv.(AdminServer).SubmitAppeal(ctx, req.(*SubmitAppealReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_DelIPForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelIPForbiddenReq)
+func _Admin_SearchAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAppealReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).DelIPForbidden(ctx, in)
+		return srv.(AdminServer).SearchAppeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_DelIPForbidden_FullMethodName,
+		FullMethod: Admin_SearchAppeal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).DelIPForbidden(ctx, req.(*DelIPForbiddenReq))
+		return srv.(AdminServer).SearchAppeal(ctx, req.(*SearchAppealReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_CancellationUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancellationUserReq)
+func _Admin_AssignAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignAppealReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).CancellationUser(ctx, in)
+		return srv.(AdminServer).AssignAppeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_CancellationUser_FullMethodName,
+		FullMethod: Admin_AssignAppeal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).CancellationUser(ctx, req.(*CancellationUserReq))
+		return srv.(AdminServer).AssignAppeal(ctx, req.(*AssignAppealReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockUserReq)
+func _Admin_HandleAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleAppealReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).BlockUser(ctx, in)
+		return srv.(AdminServer).HandleAppeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_BlockUser_FullMethodName,
+		FullMethod: Admin_HandleAppeal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).BlockUser(ctx, req.(*BlockUserReq))
+		return srv.(AdminServer).HandleAppeal(ctx, req.(*HandleAppealReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnblockUserReq)
+func _Admin_CheckRegisterForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRegisterForbiddenReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).UnblockUser(ctx, in)
+		return srv.(AdminServer).CheckRegisterForbidden(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_UnblockUser_FullMethodName,
+		FullMethod: Admin_CheckRegisterForbidden_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).UnblockUser(ctx, req.(*UnblockUserReq))
+		return srv.(AdminServer).CheckRegisterForbidden(ctx, req.(*CheckRegisterForbiddenReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_SearchBlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchBlockUserReq)
+func _Admin_CheckLoginForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckLoginForbiddenReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).SearchBlockUser(ctx, in)
+		return srv.(AdminServer).CheckLoginForbidden(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_SearchBlockUser_FullMethodName,
+		FullMethod: Admin_CheckLoginForbidden_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).SearchBlockUser(ctx, req.(*SearchBlockUserReq))
+		return srv.(AdminServer).CheckLoginForbidden(ctx, req.(*CheckLoginForbiddenReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_FindUserBlockInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindUserBlockInfoReq)
+func _Admin_AddGeoRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGeoRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).FindUserBlockInfo(ctx, in)
+		return srv.(AdminServer).AddGeoRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_FindUserBlockInfo_FullMethodName,
+		FullMethod: Admin_AddGeoRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).FindUserBlockInfo(ctx, req.(*FindUserBlockInfoReq))
+		return srv.(AdminServer).AddGeoRule(ctx, req.(*AddGeoRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_CheckRegisterForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckRegisterForbiddenReq)
+func _Admin_DelGeoRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelGeoRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).CheckRegisterForbidden(ctx, in)
+		return srv.(AdminServer).DelGeoRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_CheckRegisterForbidden_FullMethodName,
+		FullMethod: Admin_DelGeoRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).CheckRegisterForbidden(ctx, req.(*CheckRegisterForbiddenReq))
+		return srv.(AdminServer).DelGeoRule(ctx, req.(*DelGeoRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_CheckLoginForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckLoginForbiddenReq)
+func _Admin_SearchGeoRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchGeoRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).CheckLoginForbidden(ctx, in)
+		return srv.(AdminServer).SearchGeoRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Admin_CheckLoginForbidden_FullMethodName,
+		FullMethod: Admin_SearchGeoRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).CheckLoginForbidden(ctx, req.(*CheckLoginForbiddenReq))
+		return srv.(AdminServer).SearchGeoRule(ctx, req.(*SearchGeoRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1772,6 +2798,150 @@ func _Admin_InvalidateToken_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_GetUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserSessionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_GetUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetUserSessions(ctx, req.(*GetUserSessionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RevokeUserSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RevokeUserSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_RevokeUserSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RevokeUserSession(ctx, req.(*RevokeUserSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ImpersonateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ImpersonateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ImpersonateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ImpersonateUser(ctx, req.(*ImpersonateUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_TakeExpiredImpersonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakeExpiredImpersonationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).TakeExpiredImpersonation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_TakeExpiredImpersonation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).TakeExpiredImpersonation(ctx, req.(*TakeExpiredImpersonationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RefreshToken(ctx, req.(*RefreshTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetJWKS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJWKSReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetJWKS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_GetJWKS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetJWKS(ctx, req.(*GetJWKSReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchLoginLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchLoginLockReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchLoginLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SearchLoginLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchLoginLock(ctx, req.(*SearchLoginLockReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ClearLoginLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearLoginLockReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ClearLoginLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ClearLoginLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ClearLoginLock(ctx, req.(*ClearLoginLockReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Admin_LatestApplicationVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LatestApplicationVersionReq)
 	if err := dec(in); err != nil {
@@ -1901,6 +3071,70 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchAdminAccount",
 			Handler:    _Admin_SearchAdminAccount_Handler,
 		},
+		{
+			MethodName: "AddRole",
+			Handler:    _Admin_AddRole_Handler,
+		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _Admin_UpdateRole_Handler,
+		},
+		{
+			MethodName: "DelRole",
+			Handler:    _Admin_DelRole_Handler,
+		},
+		{
+			MethodName: "SearchRole",
+			Handler:    _Admin_SearchRole_Handler,
+		},
+		{
+			MethodName: "ListPermission",
+			Handler:    _Admin_ListPermission_Handler,
+		},
+		{
+			MethodName: "AssignAdminRole",
+			Handler:    _Admin_AssignAdminRole_Handler,
+		},
+		{
+			MethodName: "CheckAdminPermission",
+			Handler:    _Admin_CheckAdminPermission_Handler,
+		},
+		{
+			MethodName: "AddAuditLog",
+			Handler:    _Admin_AddAuditLog_Handler,
+		},
+		{
+			MethodName: "SearchAuditLog",
+			Handler:    _Admin_SearchAuditLog_Handler,
+		},
+		{
+			MethodName: "ScanAuditLog",
+			Handler:    _Admin_ScanAuditLog_Handler,
+		},
+		{
+			MethodName: "VerifyAuditLog",
+			Handler:    _Admin_VerifyAuditLog_Handler,
+		},
+		{
+			MethodName: "EnrollAdminTOTP",
+			Handler:    _Admin_EnrollAdminTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmAdminTOTP",
+			Handler:    _Admin_ConfirmAdminTOTP_Handler,
+		},
+		{
+			MethodName: "LoginAdminTOTP",
+			Handler:    _Admin_LoginAdminTOTP_Handler,
+		},
+		{
+			MethodName: "DisableAdminTOTP",
+			Handler:    _Admin_DisableAdminTOTP_Handler,
+		},
+		{
+			MethodName: "RegenerateAdminTOTPRecoveryCodes",
+			Handler:    _Admin_RegenerateAdminTOTPRecoveryCodes_Handler,
+		},
 		{
 			MethodName: "AddDefaultFriend",
 			Handler:    _Admin_AddDefaultFriend_Handler,
@@ -2001,6 +3235,34 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindUserBlockInfo",
 			Handler:    _Admin_FindUserBlockInfo_Handler,
 		},
+		{
+			MethodName: "SearchBlockHistory",
+			Handler:    _Admin_SearchBlockHistory_Handler,
+		},
+		{
+			MethodName: "CreateAppealTicket",
+			Handler:    _Admin_CreateAppealTicket_Handler,
+		},
+		{
+			MethodName: "GetAppeal",
+			Handler:    _Admin_GetAppeal_Handler,
+		},
+		{
+			MethodName: "SubmitAppeal",
+			Handler:    _Admin_SubmitAppeal_Handler,
+		},
+		{
+			MethodName: "SearchAppeal",
+			Handler:    _Admin_SearchAppeal_Handler,
+		},
+		{
+			MethodName: "AssignAppeal",
+			Handler:    _Admin_AssignAppeal_Handler,
+		},
+		{
+			MethodName: "HandleAppeal",
+			Handler:    _Admin_HandleAppeal_Handler,
+		},
 		{
 			MethodName: "CheckRegisterForbidden",
 			Handler:    _Admin_CheckRegisterForbidden_Handler,
@@ -2009,6 +3271,18 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckLoginForbidden",
 			Handler:    _Admin_CheckLoginForbidden_Handler,
 		},
+		{
+			MethodName: "AddGeoRule",
+			Handler:    _Admin_AddGeoRule_Handler,
+		},
+		{
+			MethodName: "DelGeoRule",
+			Handler:    _Admin_DelGeoRule_Handler,
+		},
+		{
+			MethodName: "SearchGeoRule",
+			Handler:    _Admin_SearchGeoRule_Handler,
+		},
 		{
 			MethodName: "CreateToken",
 			Handler:    _Admin_CreateToken_Handler,
@@ -2057,6 +3331,38 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InvalidateToken",
 			Handler:    _Admin_InvalidateToken_Handler,
 		},
+		{
+			MethodName: "GetUserSessions",
+			Handler:    _Admin_GetUserSessions_Handler,
+		},
+		{
+			MethodName: "RevokeUserSession",
+			Handler:    _Admin_RevokeUserSession_Handler,
+		},
+		{
+			MethodName: "ImpersonateUser",
+			Handler:    _Admin_ImpersonateUser_Handler,
+		},
+		{
+			MethodName: "TakeExpiredImpersonation",
+			Handler:    _Admin_TakeExpiredImpersonation_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _Admin_RefreshToken_Handler,
+		},
+		{
+			MethodName: "GetJWKS",
+			Handler:    _Admin_GetJWKS_Handler,
+		},
+		{
+			MethodName: "SearchLoginLock",
+			Handler:    _Admin_SearchLoginLock_Handler,
+		},
+		{
+			MethodName: "ClearLoginLock",
+			Handler:    _Admin_ClearLoginLock_Handler,
+		},
 		{
 			MethodName: "LatestApplicationVersion",
 			Handler:    _Admin_LatestApplicationVersion_Handler,
@@ -17,10 +17,13 @@ package chat
 import (
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/openimsdk/chat/pkg/common/constant"
 	constantpb "github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+	"google.golang.org/protobuf/proto"
 )
 
 func (x *UpdateUserInfoReq) Check() error {
@@ -106,6 +109,9 @@ func (x *VerifyCodeReq) Check() error {
 	if x.VerifyCode == "" {
 		return errs.ErrArgs.WrapMsg("VerifyCode is empty")
 	}
+	if x.UsedFor < constant.VerificationCodeForRegister || x.UsedFor > constant.VerificationCodeForLogin {
+		return errs.ErrArgs.WrapMsg("usedFor is invalid")
+	}
 	return nil
 }
 
@@ -317,3 +323,378 @@ func (x *AddUserAccountReq) Check() error {
 
 	return nil
 }
+
+func (x *ConfirmTOTPReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.WrapMsg("code is empty")
+	}
+	return nil
+}
+
+func (x *DisableTOTPReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.WrapMsg("code is empty")
+	}
+	return nil
+}
+
+func (x *RegenerateTOTPRecoveryCodesReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.WrapMsg("code is empty")
+	}
+	return nil
+}
+
+func (x *LoginTOTPReq) Check() error {
+	if x.Ticket == "" {
+		return errs.ErrArgs.WrapMsg("ticket is empty")
+	}
+	if x.Code == "" {
+		return errs.ErrArgs.WrapMsg("code is empty")
+	}
+	return nil
+}
+
+func (x *ResetUserTOTPReq) Check() error {
+	if len(x.UserIDs) == 0 {
+		return errs.ErrArgs.WrapMsg("userIDs is empty")
+	}
+	return nil
+}
+
+func (x *SearchSuspiciousLoginReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.WrapMsg("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *NotifyImpersonationReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	if x.Impersonator == "" {
+		return errs.ErrArgs.WrapMsg("impersonator is empty")
+	}
+	return nil
+}
+
+func (x *NotifyAppealResultReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	return nil
+}
+
+// reportMaxContent is the longest report description in characters.
+const reportMaxContent = 1000
+
+func checkReportTarget(targetType int32, targetID string) error {
+	switch targetType {
+	case constant.ReportTargetUser, constant.ReportTargetGroup, constant.ReportTargetMessage:
+	default:
+		return errs.ErrArgs.WrapMsg("targetType is invalid")
+	}
+	if targetID == "" {
+		return errs.ErrArgs.WrapMsg("targetID is empty")
+	}
+	return nil
+}
+
+func (x *AddReportReq) Check() error {
+	if err := checkReportTarget(x.TargetType, x.TargetID); err != nil {
+		return err
+	}
+	if x.TargetType == constant.ReportTargetMessage && (x.ConversationID == "" || x.TargetUserID == "") {
+		return errs.ErrArgs.WrapMsg("conversationID and targetUserID of the message are required")
+	}
+	if !datautil.Contain(x.Category, constant.BlockCategories...) {
+		return errs.ErrArgs.WrapMsg("category is invalid")
+	}
+	if len([]rune(x.Content)) > reportMaxContent {
+		return errs.ErrArgs.WrapMsg("content is too long")
+	}
+	return nil
+}
+
+func (x *SearchReportReq) Check() error {
+	if x.Status < constant.ReportStatusAll || x.Status > constant.ReportStatusHandling {
+		return errs.ErrArgs.WrapMsg("status is invalid")
+	}
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.WrapMsg("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *SearchReportTargetReq) Check() error {
+	if x.Status < constant.ReportStatusAll || x.Status > constant.ReportStatusHandling {
+		return errs.ErrArgs.WrapMsg("status is invalid")
+	}
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.WrapMsg("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *HandleReportReq) Check() error {
+	if err := checkReportTarget(x.TargetType, x.TargetID); err != nil {
+		return err
+	}
+	switch x.Action {
+	case constant.ReportActionBlock, constant.ReportActionWarn:
+		if x.UserID == "" && x.TargetType != constant.ReportTargetUser {
+			return errs.ErrArgs.WrapMsg("userID is required to block or warn on a group or message report")
+		}
+	case constant.ReportActionDismiss:
+	default:
+		return errs.ErrArgs.WrapMsg("action is invalid")
+	}
+	if x.Category != "" && !datautil.Contain(x.Category, constant.BlockCategories...) {
+		return errs.ErrArgs.WrapMsg("category is invalid")
+	}
+	if x.BlockExpireTime < 0 {
+		return errs.ErrArgs.WrapMsg("blockExpireTime is invalid")
+	}
+	return nil
+}
+
+// moderationMaxPattern is the longest word or regex of a moderation rule in characters.
+const moderationMaxPattern = 500
+
+func checkModerationRule(ruleType string, pattern string, scope int32, action string, category string) error {
+	if pattern == "" {
+		return errs.ErrArgs.WrapMsg("pattern is empty")
+	}
+	if len([]rune(pattern)) > moderationMaxPattern {
+		return errs.ErrArgs.WrapMsg("pattern is too long")
+	}
+	switch ruleType {
+	case constant.ModerationRuleWord:
+	case constant.ModerationRuleRegex:
+		if _, err := regexp.Compile(pattern); err != nil {
+			return errs.ErrArgs.WrapMsg("pattern is not a valid regex", "err", err.Error())
+		}
+	default:
+		return errs.ErrArgs.WrapMsg("type is invalid")
+	}
+	if scope < constant.ModerationScopeAll || scope > constant.ModerationScopeUserInfo {
+		return errs.ErrArgs.WrapMsg("scope is invalid")
+	}
+	switch action {
+	case constant.ModerationActionReject, constant.ModerationActionMask, constant.ModerationActionFlag:
+	default:
+		return errs.ErrArgs.WrapMsg("action is invalid")
+	}
+	if category != "" && !datautil.Contain(category, constant.BlockCategories...) {
+		return errs.ErrArgs.WrapMsg("category is invalid")
+	}
+	return nil
+}
+
+func (x *AddModerationRuleReq) Check() error {
+	if x.Rule == nil {
+		return errs.ErrArgs.WrapMsg("rule is empty")
+	}
+	if x.Rule.Name == "" {
+		return errs.ErrArgs.WrapMsg("name is empty")
+	}
+	return checkModerationRule(x.Rule.Type, x.Rule.Pattern, x.Rule.Scope, x.Rule.Action, x.Rule.Category)
+}
+
+func (x *UpdateModerationRuleReq) Check() error {
+	if x.RuleID == "" {
+		return errs.ErrArgs.WrapMsg("ruleID is empty")
+	}
+	if x.Name != nil && x.Name.Value == "" {
+		return errs.ErrArgs.WrapMsg("name is empty")
+	}
+	return nil
+}
+
+func (x *DelModerationRuleReq) Check() error {
+	if len(x.RuleIDs) == 0 {
+		return errs.ErrArgs.WrapMsg("ruleIDs is empty")
+	}
+	return nil
+}
+
+func (x *SearchModerationRuleReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.WrapMsg("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *AddModerationAllowUserReq) Check() error {
+	if len(x.UserIDs) == 0 {
+		return errs.ErrArgs.WrapMsg("userIDs is empty")
+	}
+	if datautil.Duplicate(x.UserIDs) {
+		return errs.ErrArgs.WrapMsg("userIDs is duplicate")
+	}
+	return nil
+}
+
+func (x *DelModerationAllowUserReq) Check() error {
+	if len(x.UserIDs) == 0 {
+		return errs.ErrArgs.WrapMsg("userIDs is empty")
+	}
+	return nil
+}
+
+func (x *SearchModerationAllowUserReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.WrapMsg("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *SearchDeliveryReq) Check() error {
+	switch x.Channel {
+	case "", constant.DeliveryChannelSMS, constant.DeliveryChannelEmail:
+	default:
+		return errs.ErrArgs.WrapMsg("channel is invalid")
+	}
+	if x.Status < constant.DeliveryStatusAll || x.Status > constant.DeliveryStatusFailed {
+		return errs.ErrArgs.WrapMsg("status is invalid")
+	}
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.WrapMsg("showNumber is invalid")
+	}
+	return nil
+}
+
+func checkMessageTemplateKey(channel string, usedFor int32, locale string) error {
+	switch channel {
+	case constant.DeliveryChannelSMS, constant.DeliveryChannelEmail:
+	default:
+		return errs.ErrArgs.WrapMsg("channel is invalid")
+	}
+	if usedFor < 0 || usedFor > constant.VerificationCodeForLogin {
+		return errs.ErrArgs.WrapMsg("usedFor is invalid")
+	}
+	if locale == "" {
+		return errs.ErrArgs.WrapMsg("locale is empty")
+	}
+	return nil
+}
+
+func (x *SetMessageTemplateReq) Check() error {
+	if x.Template == nil {
+		return errs.ErrArgs.WrapMsg("template is empty")
+	}
+	if err := checkMessageTemplateKey(x.Template.Channel, x.Template.UsedFor, x.Template.Locale); err != nil {
+		return err
+	}
+	if x.Template.Text == "" {
+		return errs.ErrArgs.WrapMsg("text is empty")
+	}
+	if x.Template.Channel == constant.DeliveryChannelEmail {
+		if x.Template.Subject == "" {
+			return errs.ErrArgs.WrapMsg("subject is empty")
+		}
+	} else if x.Template.Subject != "" || x.Template.Html != "" {
+		return errs.ErrArgs.WrapMsg("an sms template has only text")
+	}
+	// the chat rpc renders the template to make sure the code is in it, this only catches the obvious
+	if !strings.Contains(x.Template.Text, ".Code") {
+		return errs.ErrArgs.WrapMsg("text does not contain {{.Code}}")
+	}
+	if x.Template.Html != "" && !strings.Contains(x.Template.Html, ".Code") {
+		return errs.ErrArgs.WrapMsg("html does not contain {{.Code}}")
+	}
+	return nil
+}
+
+func (x *DelMessageTemplateReq) Check() error {
+	return checkMessageTemplateKey(x.Channel, x.UsedFor, x.Locale)
+}
+
+func (x *SearchMessageTemplateReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.WrapMsg("showNumber is invalid")
+	}
+	return nil
+}
+
+// redactedVerifyCode replaces a verification code in the request logs.
+const redactedVerifyCode = "***"
+
+func (x *VerifyCodeReq) Format() any {
+	if x.VerifyCode == "" {
+		return x
+	}
+	c := proto.Clone(x).(*VerifyCodeReq)
+	c.VerifyCode = redactedVerifyCode
+	return c
+}
+
+func (x *RegisterUserReq) Format() any {
+	if x.VerifyCode == "" {
+		return x
+	}
+	c := proto.Clone(x).(*RegisterUserReq)
+	c.VerifyCode = redactedVerifyCode
+	return c
+}
+
+func (x *LoginReq) Format() any {
+	if x.VerifyCode == "" {
+		return x
+	}
+	c := proto.Clone(x).(*LoginReq)
+	c.VerifyCode = redactedVerifyCode
+	return c
+}
+
+func (x *ResetPasswordReq) Format() any {
+	if x.VerifyCode == "" {
+		return x
+	}
+	c := proto.Clone(x).(*ResetPasswordReq)
+	c.VerifyCode = redactedVerifyCode
+	return c
+}
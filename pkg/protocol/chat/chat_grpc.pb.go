@@ -33,28 +33,54 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Chat_UpdateUserInfo_FullMethodName          = "/openim.chat.chat/UpdateUserInfo"
-	Chat_AddUserAccount_FullMethodName          = "/openim.chat.chat/AddUserAccount"
-	Chat_SearchUserPublicInfo_FullMethodName    = "/openim.chat.chat/SearchUserPublicInfo"
-	Chat_FindUserPublicInfo_FullMethodName      = "/openim.chat.chat/FindUserPublicInfo"
-	Chat_SearchUserFullInfo_FullMethodName      = "/openim.chat.chat/SearchUserFullInfo"
-	Chat_FindUserFullInfo_FullMethodName        = "/openim.chat.chat/FindUserFullInfo"
-	Chat_SendVerifyCode_FullMethodName          = "/openim.chat.chat/SendVerifyCode"
-	Chat_VerifyCode_FullMethodName              = "/openim.chat.chat/VerifyCode"
-	Chat_RegisterUser_FullMethodName            = "/openim.chat.chat/RegisterUser"
-	Chat_Login_FullMethodName                   = "/openim.chat.chat/Login"
-	Chat_ResetPassword_FullMethodName           = "/openim.chat.chat/ResetPassword"
-	Chat_ChangePassword_FullMethodName          = "/openim.chat.chat/ChangePassword"
-	Chat_CheckUserExist_FullMethodName          = "/openim.chat.chat/CheckUserExist"
-	Chat_DelUserAccount_FullMethodName          = "/openim.chat.chat/DelUserAccount"
-	Chat_FindUserAccount_FullMethodName         = "/openim.chat.chat/FindUserAccount"
-	Chat_FindAccountUser_FullMethodName         = "/openim.chat.chat/FindAccountUser"
-	Chat_OpenIMCallback_FullMethodName          = "/openim.chat.chat/OpenIMCallback"
-	Chat_UserLoginCount_FullMethodName          = "/openim.chat.chat/UserLoginCount"
-	Chat_SearchUserInfo_FullMethodName          = "/openim.chat.chat/SearchUserInfo"
-	Chat_GetTokenForVideoMeeting_FullMethodName = "/openim.chat.chat/GetTokenForVideoMeeting"
-	Chat_SetAllowRegister_FullMethodName        = "/openim.chat.chat/SetAllowRegister"
-	Chat_GetAllowRegister_FullMethodName        = "/openim.chat.chat/GetAllowRegister"
+	Chat_UpdateUserInfo_FullMethodName              = "/openim.chat.chat/UpdateUserInfo"
+	Chat_AddUserAccount_FullMethodName              = "/openim.chat.chat/AddUserAccount"
+	Chat_SearchUserPublicInfo_FullMethodName        = "/openim.chat.chat/SearchUserPublicInfo"
+	Chat_FindUserPublicInfo_FullMethodName          = "/openim.chat.chat/FindUserPublicInfo"
+	Chat_SearchUserFullInfo_FullMethodName          = "/openim.chat.chat/SearchUserFullInfo"
+	Chat_FindUserFullInfo_FullMethodName            = "/openim.chat.chat/FindUserFullInfo"
+	Chat_SendVerifyCode_FullMethodName              = "/openim.chat.chat/SendVerifyCode"
+	Chat_GetCaptcha_FullMethodName                  = "/openim.chat.chat/GetCaptcha"
+	Chat_VerifyCode_FullMethodName                  = "/openim.chat.chat/VerifyCode"
+	Chat_RegisterUser_FullMethodName                = "/openim.chat.chat/RegisterUser"
+	Chat_Login_FullMethodName                       = "/openim.chat.chat/Login"
+	Chat_ResetPassword_FullMethodName               = "/openim.chat.chat/ResetPassword"
+	Chat_ChangePassword_FullMethodName              = "/openim.chat.chat/ChangePassword"
+	Chat_CheckUserExist_FullMethodName              = "/openim.chat.chat/CheckUserExist"
+	Chat_DelUserAccount_FullMethodName              = "/openim.chat.chat/DelUserAccount"
+	Chat_FindUserAccount_FullMethodName             = "/openim.chat.chat/FindUserAccount"
+	Chat_FindAccountUser_FullMethodName             = "/openim.chat.chat/FindAccountUser"
+	Chat_OpenIMCallback_FullMethodName              = "/openim.chat.chat/OpenIMCallback"
+	Chat_UserLoginCount_FullMethodName              = "/openim.chat.chat/UserLoginCount"
+	Chat_SearchUserInfo_FullMethodName              = "/openim.chat.chat/SearchUserInfo"
+	Chat_GetTokenForVideoMeeting_FullMethodName     = "/openim.chat.chat/GetTokenForVideoMeeting"
+	Chat_SetAllowRegister_FullMethodName            = "/openim.chat.chat/SetAllowRegister"
+	Chat_GetAllowRegister_FullMethodName            = "/openim.chat.chat/GetAllowRegister"
+	Chat_EnrollTOTP_FullMethodName                  = "/openim.chat.chat/EnrollTOTP"
+	Chat_ConfirmTOTP_FullMethodName                 = "/openim.chat.chat/ConfirmTOTP"
+	Chat_DisableTOTP_FullMethodName                 = "/openim.chat.chat/DisableTOTP"
+	Chat_RegenerateTOTPRecoveryCodes_FullMethodName = "/openim.chat.chat/RegenerateTOTPRecoveryCodes"
+	Chat_GetTOTPStatus_FullMethodName               = "/openim.chat.chat/GetTOTPStatus"
+	Chat_LoginTOTP_FullMethodName                   = "/openim.chat.chat/LoginTOTP"
+	Chat_ResetUserTOTP_FullMethodName               = "/openim.chat.chat/ResetUserTOTP"
+	Chat_SearchSuspiciousLogin_FullMethodName       = "/openim.chat.chat/SearchSuspiciousLogin"
+	Chat_NotifyImpersonation_FullMethodName         = "/openim.chat.chat/NotifyImpersonation"
+	Chat_NotifyAppealResult_FullMethodName          = "/openim.chat.chat/NotifyAppealResult"
+	Chat_AddReport_FullMethodName                   = "/openim.chat.chat/AddReport"
+	Chat_SearchReport_FullMethodName                = "/openim.chat.chat/SearchReport"
+	Chat_SearchReportTarget_FullMethodName          = "/openim.chat.chat/SearchReportTarget"
+	Chat_HandleReport_FullMethodName                = "/openim.chat.chat/HandleReport"
+	Chat_AddModerationRule_FullMethodName           = "/openim.chat.chat/AddModerationRule"
+	Chat_UpdateModerationRule_FullMethodName        = "/openim.chat.chat/UpdateModerationRule"
+	Chat_DelModerationRule_FullMethodName           = "/openim.chat.chat/DelModerationRule"
+	Chat_SearchModerationRule_FullMethodName        = "/openim.chat.chat/SearchModerationRule"
+	Chat_AddModerationAllowUser_FullMethodName      = "/openim.chat.chat/AddModerationAllowUser"
+	Chat_DelModerationAllowUser_FullMethodName      = "/openim.chat.chat/DelModerationAllowUser"
+	Chat_SearchModerationAllowUser_FullMethodName   = "/openim.chat.chat/SearchModerationAllowUser"
+	Chat_SearchDelivery_FullMethodName              = "/openim.chat.chat/SearchDelivery"
+	Chat_SetMessageTemplate_FullMethodName          = "/openim.chat.chat/SetMessageTemplate"
+	Chat_DelMessageTemplate_FullMethodName          = "/openim.chat.chat/DelMessageTemplate"
+	Chat_SearchMessageTemplate_FullMethodName       = "/openim.chat.chat/SearchMessageTemplate"
 )
 
 // ChatClient is the client API for Chat service.
@@ -71,6 +97,7 @@ type ChatClient interface {
 	SearchUserFullInfo(ctx context.Context, in *SearchUserFullInfoReq, opts ...grpc.CallOption) (*SearchUserFullInfoResp, error)
 	FindUserFullInfo(ctx context.Context, in *FindUserFullInfoReq, opts ...grpc.CallOption) (*FindUserFullInfoResp, error)
 	SendVerifyCode(ctx context.Context, in *SendVerifyCodeReq, opts ...grpc.CallOption) (*SendVerifyCodeResp, error)
+	GetCaptcha(ctx context.Context, in *GetCaptchaReq, opts ...grpc.CallOption) (*GetCaptchaResp, error)
 	VerifyCode(ctx context.Context, in *VerifyCodeReq, opts ...grpc.CallOption) (*VerifyCodeResp, error)
 	RegisterUser(ctx context.Context, in *RegisterUserReq, opts ...grpc.CallOption) (*RegisterUserResp, error)
 	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error)
@@ -88,6 +115,37 @@ type ChatClient interface {
 	GetTokenForVideoMeeting(ctx context.Context, in *GetTokenForVideoMeetingReq, opts ...grpc.CallOption) (*GetTokenForVideoMeetingResp, error)
 	SetAllowRegister(ctx context.Context, in *SetAllowRegisterReq, opts ...grpc.CallOption) (*SetAllowRegisterResp, error)
 	GetAllowRegister(ctx context.Context, in *GetAllowRegisterReq, opts ...grpc.CallOption) (*GetAllowRegisterResp, error)
+	// TOTP
+	EnrollTOTP(ctx context.Context, in *EnrollTOTPReq, opts ...grpc.CallOption) (*EnrollTOTPResp, error)
+	ConfirmTOTP(ctx context.Context, in *ConfirmTOTPReq, opts ...grpc.CallOption) (*ConfirmTOTPResp, error)
+	DisableTOTP(ctx context.Context, in *DisableTOTPReq, opts ...grpc.CallOption) (*DisableTOTPResp, error)
+	RegenerateTOTPRecoveryCodes(ctx context.Context, in *RegenerateTOTPRecoveryCodesReq, opts ...grpc.CallOption) (*RegenerateTOTPRecoveryCodesResp, error)
+	GetTOTPStatus(ctx context.Context, in *GetTOTPStatusReq, opts ...grpc.CallOption) (*GetTOTPStatusResp, error)
+	LoginTOTP(ctx context.Context, in *LoginTOTPReq, opts ...grpc.CallOption) (*LoginResp, error)
+	ResetUserTOTP(ctx context.Context, in *ResetUserTOTPReq, opts ...grpc.CallOption) (*ResetUserTOTPResp, error)
+	SearchSuspiciousLogin(ctx context.Context, in *SearchSuspiciousLoginReq, opts ...grpc.CallOption) (*SearchSuspiciousLoginResp, error)
+	// Tell the user an administrator logged in as them, called by the admin service
+	NotifyImpersonation(ctx context.Context, in *NotifyImpersonationReq, opts ...grpc.CallOption) (*NotifyImpersonationResp, error)
+	NotifyAppealResult(ctx context.Context, in *NotifyAppealResultReq, opts ...grpc.CallOption) (*NotifyAppealResultResp, error)
+	// Abuse reports by users and the moderation queue
+	AddReport(ctx context.Context, in *AddReportReq, opts ...grpc.CallOption) (*AddReportResp, error)
+	SearchReport(ctx context.Context, in *SearchReportReq, opts ...grpc.CallOption) (*SearchReportResp, error)
+	SearchReportTarget(ctx context.Context, in *SearchReportTargetReq, opts ...grpc.CallOption) (*SearchReportTargetResp, error)
+	HandleReport(ctx context.Context, in *HandleReportReq, opts ...grpc.CallOption) (*HandleReportResp, error)
+	// Content moderation rules applied through the OpenIM callbacks
+	AddModerationRule(ctx context.Context, in *AddModerationRuleReq, opts ...grpc.CallOption) (*AddModerationRuleResp, error)
+	UpdateModerationRule(ctx context.Context, in *UpdateModerationRuleReq, opts ...grpc.CallOption) (*UpdateModerationRuleResp, error)
+	DelModerationRule(ctx context.Context, in *DelModerationRuleReq, opts ...grpc.CallOption) (*DelModerationRuleResp, error)
+	SearchModerationRule(ctx context.Context, in *SearchModerationRuleReq, opts ...grpc.CallOption) (*SearchModerationRuleResp, error)
+	AddModerationAllowUser(ctx context.Context, in *AddModerationAllowUserReq, opts ...grpc.CallOption) (*AddModerationAllowUserResp, error)
+	DelModerationAllowUser(ctx context.Context, in *DelModerationAllowUserReq, opts ...grpc.CallOption) (*DelModerationAllowUserResp, error)
+	SearchModerationAllowUser(ctx context.Context, in *SearchModerationAllowUserReq, opts ...grpc.CallOption) (*SearchModerationAllowUserResp, error)
+	// Delivery status of verification codes and notices sent by SMS or email
+	SearchDelivery(ctx context.Context, in *SearchDeliveryReq, opts ...grpc.CallOption) (*SearchDeliveryResp, error)
+	// Localized verification code templates, on top of the built-in ones and those on disk
+	SetMessageTemplate(ctx context.Context, in *SetMessageTemplateReq, opts ...grpc.CallOption) (*SetMessageTemplateResp, error)
+	DelMessageTemplate(ctx context.Context, in *DelMessageTemplateReq, opts ...grpc.CallOption) (*DelMessageTemplateResp, error)
+	SearchMessageTemplate(ctx context.Context, in *SearchMessageTemplateReq, opts ...grpc.CallOption) (*SearchMessageTemplateResp, error)
 }
 
 type chatClient struct {
@@ -168,6 +226,16 @@ func (c *chatClient) SendVerifyCode(ctx context.Context, in *SendVerifyCodeReq,
 	return out, nil
 }
 
+func (c *chatClient) GetCaptcha(ctx context.Context, in *GetCaptchaReq, opts ...grpc.CallOption) (*GetCaptchaResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCaptchaResp)
+	err := c.cc.Invoke(ctx, Chat_GetCaptcha_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatClient) VerifyCode(ctx context.Context, in *VerifyCodeReq, opts ...grpc.CallOption) (*VerifyCodeResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(VerifyCodeResp)
@@ -318,6 +386,256 @@ func (c *chatClient) GetAllowRegister(ctx context.Context, in *GetAllowRegisterR
 	return out, nil
 }
 
+func (c *chatClient) EnrollTOTP(ctx context.Context, in *EnrollTOTPReq, opts ...grpc.CallOption) (*EnrollTOTPResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollTOTPResp)
+	err := c.cc.Invoke(ctx, Chat_EnrollTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPReq, opts ...grpc.CallOption) (*ConfirmTOTPResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTOTPResp)
+	err := c.cc.Invoke(ctx, Chat_ConfirmTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) DisableTOTP(ctx context.Context, in *DisableTOTPReq, opts ...grpc.CallOption) (*DisableTOTPResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTOTPResp)
+	err := c.cc.Invoke(ctx, Chat_DisableTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) RegenerateTOTPRecoveryCodes(ctx context.Context, in *RegenerateTOTPRecoveryCodesReq, opts ...grpc.CallOption) (*RegenerateTOTPRecoveryCodesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateTOTPRecoveryCodesResp)
+	err := c.cc.Invoke(ctx, Chat_RegenerateTOTPRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetTOTPStatus(ctx context.Context, in *GetTOTPStatusReq, opts ...grpc.CallOption) (*GetTOTPStatusResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTOTPStatusResp)
+	err := c.cc.Invoke(ctx, Chat_GetTOTPStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) LoginTOTP(ctx context.Context, in *LoginTOTPReq, opts ...grpc.CallOption) (*LoginResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResp)
+	err := c.cc.Invoke(ctx, Chat_LoginTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ResetUserTOTP(ctx context.Context, in *ResetUserTOTPReq, opts ...grpc.CallOption) (*ResetUserTOTPResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetUserTOTPResp)
+	err := c.cc.Invoke(ctx, Chat_ResetUserTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SearchSuspiciousLogin(ctx context.Context, in *SearchSuspiciousLoginReq, opts ...grpc.CallOption) (*SearchSuspiciousLoginResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchSuspiciousLoginResp)
+	err := c.cc.Invoke(ctx, Chat_SearchSuspiciousLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) NotifyImpersonation(ctx context.Context, in *NotifyImpersonationReq, opts ...grpc.CallOption) (*NotifyImpersonationResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotifyImpersonationResp)
+	err := c.cc.Invoke(ctx, Chat_NotifyImpersonation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) NotifyAppealResult(ctx context.Context, in *NotifyAppealResultReq, opts ...grpc.CallOption) (*NotifyAppealResultResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotifyAppealResultResp)
+	err := c.cc.Invoke(ctx, Chat_NotifyAppealResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) AddReport(ctx context.Context, in *AddReportReq, opts ...grpc.CallOption) (*AddReportResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddReportResp)
+	err := c.cc.Invoke(ctx, Chat_AddReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SearchReport(ctx context.Context, in *SearchReportReq, opts ...grpc.CallOption) (*SearchReportResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchReportResp)
+	err := c.cc.Invoke(ctx, Chat_SearchReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SearchReportTarget(ctx context.Context, in *SearchReportTargetReq, opts ...grpc.CallOption) (*SearchReportTargetResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchReportTargetResp)
+	err := c.cc.Invoke(ctx, Chat_SearchReportTarget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) HandleReport(ctx context.Context, in *HandleReportReq, opts ...grpc.CallOption) (*HandleReportResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HandleReportResp)
+	err := c.cc.Invoke(ctx, Chat_HandleReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) AddModerationRule(ctx context.Context, in *AddModerationRuleReq, opts ...grpc.CallOption) (*AddModerationRuleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddModerationRuleResp)
+	err := c.cc.Invoke(ctx, Chat_AddModerationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) UpdateModerationRule(ctx context.Context, in *UpdateModerationRuleReq, opts ...grpc.CallOption) (*UpdateModerationRuleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateModerationRuleResp)
+	err := c.cc.Invoke(ctx, Chat_UpdateModerationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) DelModerationRule(ctx context.Context, in *DelModerationRuleReq, opts ...grpc.CallOption) (*DelModerationRuleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DelModerationRuleResp)
+	err := c.cc.Invoke(ctx, Chat_DelModerationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SearchModerationRule(ctx context.Context, in *SearchModerationRuleReq, opts ...grpc.CallOption) (*SearchModerationRuleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchModerationRuleResp)
+	err := c.cc.Invoke(ctx, Chat_SearchModerationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) AddModerationAllowUser(ctx context.Context, in *AddModerationAllowUserReq, opts ...grpc.CallOption) (*AddModerationAllowUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddModerationAllowUserResp)
+	err := c.cc.Invoke(ctx, Chat_AddModerationAllowUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) DelModerationAllowUser(ctx context.Context, in *DelModerationAllowUserReq, opts ...grpc.CallOption) (*DelModerationAllowUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DelModerationAllowUserResp)
+	err := c.cc.Invoke(ctx, Chat_DelModerationAllowUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SearchModerationAllowUser(ctx context.Context, in *SearchModerationAllowUserReq, opts ...grpc.CallOption) (*SearchModerationAllowUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchModerationAllowUserResp)
+	err := c.cc.Invoke(ctx, Chat_SearchModerationAllowUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SearchDelivery(ctx context.Context, in *SearchDeliveryReq, opts ...grpc.CallOption) (*SearchDeliveryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchDeliveryResp)
+	err := c.cc.Invoke(ctx, Chat_SearchDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SetMessageTemplate(ctx context.Context, in *SetMessageTemplateReq, opts ...grpc.CallOption) (*SetMessageTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMessageTemplateResp)
+	err := c.cc.Invoke(ctx, Chat_SetMessageTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) DelMessageTemplate(ctx context.Context, in *DelMessageTemplateReq, opts ...grpc.CallOption) (*DelMessageTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DelMessageTemplateResp)
+	err := c.cc.Invoke(ctx, Chat_DelMessageTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SearchMessageTemplate(ctx context.Context, in *SearchMessageTemplateReq, opts ...grpc.CallOption) (*SearchMessageTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMessageTemplateResp)
+	err := c.cc.Invoke(ctx, Chat_SearchMessageTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatServer is the server API for Chat service.
 // All implementations must embed UnimplementedChatServer
 // for forward compatibility.
@@ -332,6 +650,7 @@ type ChatServer interface {
 	SearchUserFullInfo(context.Context, *SearchUserFullInfoReq) (*SearchUserFullInfoResp, error)
 	FindUserFullInfo(context.Context, *FindUserFullInfoReq) (*FindUserFullInfoResp, error)
 	SendVerifyCode(context.Context, *SendVerifyCodeReq) (*SendVerifyCodeResp, error)
+	GetCaptcha(context.Context, *GetCaptchaReq) (*GetCaptchaResp, error)
 	VerifyCode(context.Context, *VerifyCodeReq) (*VerifyCodeResp, error)
 	RegisterUser(context.Context, *RegisterUserReq) (*RegisterUserResp, error)
 	Login(context.Context, *LoginReq) (*LoginResp, error)
@@ -349,6 +668,37 @@ type ChatServer interface {
 	GetTokenForVideoMeeting(context.Context, *GetTokenForVideoMeetingReq) (*GetTokenForVideoMeetingResp, error)
 	SetAllowRegister(context.Context, *SetAllowRegisterReq) (*SetAllowRegisterResp, error)
 	GetAllowRegister(context.Context, *GetAllowRegisterReq) (*GetAllowRegisterResp, error)
+	// TOTP
+	EnrollTOTP(context.Context, *EnrollTOTPReq) (*EnrollTOTPResp, error)
+	ConfirmTOTP(context.Context, *ConfirmTOTPReq) (*ConfirmTOTPResp, error)
+	DisableTOTP(context.Context, *DisableTOTPReq) (*DisableTOTPResp, error)
+	RegenerateTOTPRecoveryCodes(context.Context, *RegenerateTOTPRecoveryCodesReq) (*RegenerateTOTPRecoveryCodesResp, error)
+	GetTOTPStatus(context.Context, *GetTOTPStatusReq) (*GetTOTPStatusResp, error)
+	LoginTOTP(context.Context, *LoginTOTPReq) (*LoginResp, error)
+	ResetUserTOTP(context.Context, *ResetUserTOTPReq) (*ResetUserTOTPResp, error)
+	SearchSuspiciousLogin(context.Context, *SearchSuspiciousLoginReq) (*SearchSuspiciousLoginResp, error)
+	// Tell the user an administrator logged in as them, called by the admin service
+	NotifyImpersonation(context.Context, *NotifyImpersonationReq) (*NotifyImpersonationResp, error)
+	NotifyAppealResult(context.Context, *NotifyAppealResultReq) (*NotifyAppealResultResp, error)
+	// Abuse reports by users and the moderation queue
+	AddReport(context.Context, *AddReportReq) (*AddReportResp, error)
+	SearchReport(context.Context, *SearchReportReq) (*SearchReportResp, error)
+	SearchReportTarget(context.Context, *SearchReportTargetReq) (*SearchReportTargetResp, error)
+	HandleReport(context.Context, *HandleReportReq) (*HandleReportResp, error)
+	// Content moderation rules applied through the OpenIM callbacks
+	AddModerationRule(context.Context, *AddModerationRuleReq) (*AddModerationRuleResp, error)
+	UpdateModerationRule(context.Context, *UpdateModerationRuleReq) (*UpdateModerationRuleResp, error)
+	DelModerationRule(context.Context, *DelModerationRuleReq) (*DelModerationRuleResp, error)
+	SearchModerationRule(context.Context, *SearchModerationRuleReq) (*SearchModerationRuleResp, error)
+	AddModerationAllowUser(context.Context, *AddModerationAllowUserReq) (*AddModerationAllowUserResp, error)
+	DelModerationAllowUser(context.Context, *DelModerationAllowUserReq) (*DelModerationAllowUserResp, error)
+	SearchModerationAllowUser(context.Context, *SearchModerationAllowUserReq) (*SearchModerationAllowUserResp, error)
+	// Delivery status of verification codes and notices sent by SMS or email
+	SearchDelivery(context.Context, *SearchDeliveryReq) (*SearchDeliveryResp, error)
+	// Localized verification code templates, on top of the built-in ones and those on disk
+	SetMessageTemplate(context.Context, *SetMessageTemplateReq) (*SetMessageTemplateResp, error)
+	DelMessageTemplate(context.Context, *DelMessageTemplateReq) (*DelMessageTemplateResp, error)
+	SearchMessageTemplate(context.Context, *SearchMessageTemplateReq) (*SearchMessageTemplateResp, error)
 	mustEmbedUnimplementedChatServer()
 }
 
@@ -380,6 +730,9 @@ func (UnimplementedChatServer) FindUserFullInfo(context.Context, *FindUserFullIn
 func (UnimplementedChatServer) SendVerifyCode(context.Context, *SendVerifyCodeReq) (*SendVerifyCodeResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendVerifyCode not implemented")
 }
+func (UnimplementedChatServer) GetCaptcha(context.Context, *GetCaptchaReq) (*GetCaptchaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCaptcha not implemented")
+}
 func (UnimplementedChatServer) VerifyCode(context.Context, *VerifyCodeReq) (*VerifyCodeResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyCode not implemented")
 }
@@ -425,6 +778,81 @@ func (UnimplementedChatServer) SetAllowRegister(context.Context, *SetAllowRegist
 func (UnimplementedChatServer) GetAllowRegister(context.Context, *GetAllowRegisterReq) (*GetAllowRegisterResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllowRegister not implemented")
 }
+func (UnimplementedChatServer) EnrollTOTP(context.Context, *EnrollTOTPReq) (*EnrollTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedChatServer) ConfirmTOTP(context.Context, *ConfirmTOTPReq) (*ConfirmTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (UnimplementedChatServer) DisableTOTP(context.Context, *DisableTOTPReq) (*DisableTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (UnimplementedChatServer) RegenerateTOTPRecoveryCodes(context.Context, *RegenerateTOTPRecoveryCodesReq) (*RegenerateTOTPRecoveryCodesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateTOTPRecoveryCodes not implemented")
+}
+func (UnimplementedChatServer) GetTOTPStatus(context.Context, *GetTOTPStatusReq) (*GetTOTPStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTOTPStatus not implemented")
+}
+func (UnimplementedChatServer) LoginTOTP(context.Context, *LoginTOTPReq) (*LoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginTOTP not implemented")
+}
+func (UnimplementedChatServer) ResetUserTOTP(context.Context, *ResetUserTOTPReq) (*ResetUserTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetUserTOTP not implemented")
+}
+func (UnimplementedChatServer) SearchSuspiciousLogin(context.Context, *SearchSuspiciousLoginReq) (*SearchSuspiciousLoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSuspiciousLogin not implemented")
+}
+func (UnimplementedChatServer) NotifyImpersonation(context.Context, *NotifyImpersonationReq) (*NotifyImpersonationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyImpersonation not implemented")
+}
+func (UnimplementedChatServer) NotifyAppealResult(context.Context, *NotifyAppealResultReq) (*NotifyAppealResultResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyAppealResult not implemented")
+}
+func (UnimplementedChatServer) AddReport(context.Context, *AddReportReq) (*AddReportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReport not implemented")
+}
+func (UnimplementedChatServer) SearchReport(context.Context, *SearchReportReq) (*SearchReportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchReport not implemented")
+}
+func (UnimplementedChatServer) SearchReportTarget(context.Context, *SearchReportTargetReq) (*SearchReportTargetResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchReportTarget not implemented")
+}
+func (UnimplementedChatServer) HandleReport(context.Context, *HandleReportReq) (*HandleReportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleReport not implemented")
+}
+func (UnimplementedChatServer) AddModerationRule(context.Context, *AddModerationRuleReq) (*AddModerationRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddModerationRule not implemented")
+}
+func (UnimplementedChatServer) UpdateModerationRule(context.Context, *UpdateModerationRuleReq) (*UpdateModerationRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateModerationRule not implemented")
+}
+func (UnimplementedChatServer) DelModerationRule(context.Context, *DelModerationRuleReq) (*DelModerationRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelModerationRule not implemented")
+}
+func (UnimplementedChatServer) SearchModerationRule(context.Context, *SearchModerationRuleReq) (*SearchModerationRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchModerationRule not implemented")
+}
+func (UnimplementedChatServer) AddModerationAllowUser(context.Context, *AddModerationAllowUserReq) (*AddModerationAllowUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddModerationAllowUser not implemented")
+}
+func (UnimplementedChatServer) DelModerationAllowUser(context.Context, *DelModerationAllowUserReq) (*DelModerationAllowUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelModerationAllowUser not implemented")
+}
+func (UnimplementedChatServer) SearchModerationAllowUser(context.Context, *SearchModerationAllowUserReq) (*SearchModerationAllowUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchModerationAllowUser not implemented")
+}
+func (UnimplementedChatServer) SearchDelivery(context.Context, *SearchDeliveryReq) (*SearchDeliveryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchDelivery not implemented")
+}
+func (UnimplementedChatServer) SetMessageTemplate(context.Context, *SetMessageTemplateReq) (*SetMessageTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMessageTemplate not implemented")
+}
+func (UnimplementedChatServer) DelMessageTemplate(context.Context, *DelMessageTemplateReq) (*DelMessageTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelMessageTemplate not implemented")
+}
+func (UnimplementedChatServer) SearchMessageTemplate(context.Context, *SearchMessageTemplateReq) (*SearchMessageTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMessageTemplate not implemented")
+}
 func (UnimplementedChatServer) mustEmbedUnimplementedChatServer() {}
 func (UnimplementedChatServer) testEmbeddedByValue()              {}
 
@@ -572,6 +1000,24 @@ func _Chat_SendVerifyCode_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chat_GetCaptcha_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCaptchaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetCaptcha(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_GetCaptcha_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetCaptcha(ctx, req.(*GetCaptchaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chat_VerifyCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VerifyCodeReq)
 	if err := dec(in); err != nil {
@@ -842,40 +1288,494 @@ func _Chat_GetAllowRegister_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-// Chat_ServiceDesc is the grpc.ServiceDesc for Chat service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Chat_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "openim.chat.chat",
-	HandlerType: (*ChatServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateUserInfo",
-			Handler:    _Chat_UpdateUserInfo_Handler,
-		},
-		{
-			MethodName: "AddUserAccount",
-			Handler:    _Chat_AddUserAccount_Handler,
-		},
-		{
-			MethodName: "SearchUserPublicInfo",
-			Handler:    _Chat_SearchUserPublicInfo_Handler,
-		},
-		{
-			MethodName: "FindUserPublicInfo",
-			Handler:    _Chat_FindUserPublicInfo_Handler,
-		},
-		{
-			MethodName: "SearchUserFullInfo",
-			Handler:    _Chat_SearchUserFullInfo_Handler,
-		},
-		{
-			MethodName: "FindUserFullInfo",
-			Handler:    _Chat_FindUserFullInfo_Handler,
-		},
-		{
-			MethodName: "SendVerifyCode",
-			Handler:    _Chat_SendVerifyCode_Handler,
+func _Chat_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_EnrollTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).EnrollTOTP(ctx, req.(*EnrollTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_ConfirmTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ConfirmTOTP(ctx, req.(*ConfirmTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_DisableTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).DisableTOTP(ctx, req.(*DisableTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_RegenerateTOTPRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateTOTPRecoveryCodesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).RegenerateTOTPRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_RegenerateTOTPRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).RegenerateTOTPRecoveryCodes(ctx, req.(*RegenerateTOTPRecoveryCodesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetTOTPStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTOTPStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetTOTPStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_GetTOTPStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetTOTPStatus(ctx, req.(*GetTOTPStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_LoginTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).LoginTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_LoginTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).LoginTOTP(ctx, req.(*LoginTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_ResetUserTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetUserTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ResetUserTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_ResetUserTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ResetUserTOTP(ctx, req.(*ResetUserTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SearchSuspiciousLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSuspiciousLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SearchSuspiciousLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_SearchSuspiciousLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SearchSuspiciousLogin(ctx, req.(*SearchSuspiciousLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_NotifyImpersonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyImpersonationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).NotifyImpersonation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_NotifyImpersonation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).NotifyImpersonation(ctx, req.(*NotifyImpersonationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_NotifyAppealResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyAppealResultReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).NotifyAppealResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_NotifyAppealResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).NotifyAppealResult(ctx, req.(*NotifyAppealResultReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_AddReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).AddReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_AddReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).AddReport(ctx, req.(*AddReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SearchReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SearchReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_SearchReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SearchReport(ctx, req.(*SearchReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SearchReportTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchReportTargetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SearchReportTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_SearchReportTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SearchReportTarget(ctx, req.(*SearchReportTargetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_HandleReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).HandleReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_HandleReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).HandleReport(ctx, req.(*HandleReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_AddModerationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddModerationRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).AddModerationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_AddModerationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).AddModerationRule(ctx, req.(*AddModerationRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_UpdateModerationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateModerationRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).UpdateModerationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_UpdateModerationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).UpdateModerationRule(ctx, req.(*UpdateModerationRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_DelModerationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelModerationRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).DelModerationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_DelModerationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).DelModerationRule(ctx, req.(*DelModerationRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SearchModerationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchModerationRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SearchModerationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_SearchModerationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SearchModerationRule(ctx, req.(*SearchModerationRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_AddModerationAllowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddModerationAllowUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).AddModerationAllowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_AddModerationAllowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).AddModerationAllowUser(ctx, req.(*AddModerationAllowUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_DelModerationAllowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelModerationAllowUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).DelModerationAllowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_DelModerationAllowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).DelModerationAllowUser(ctx, req.(*DelModerationAllowUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SearchModerationAllowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchModerationAllowUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SearchModerationAllowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_SearchModerationAllowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SearchModerationAllowUser(ctx, req.(*SearchModerationAllowUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SearchDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchDeliveryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SearchDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_SearchDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SearchDelivery(ctx, req.(*SearchDeliveryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SetMessageTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMessageTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SetMessageTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_SetMessageTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SetMessageTemplate(ctx, req.(*SetMessageTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_DelMessageTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelMessageTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).DelMessageTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_DelMessageTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).DelMessageTemplate(ctx, req.(*DelMessageTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SearchMessageTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMessageTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SearchMessageTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_SearchMessageTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SearchMessageTemplate(ctx, req.(*SearchMessageTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Chat_ServiceDesc is the grpc.ServiceDesc for Chat service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Chat_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.chat.chat",
+	HandlerType: (*ChatServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateUserInfo",
+			Handler:    _Chat_UpdateUserInfo_Handler,
+		},
+		{
+			MethodName: "AddUserAccount",
+			Handler:    _Chat_AddUserAccount_Handler,
+		},
+		{
+			MethodName: "SearchUserPublicInfo",
+			Handler:    _Chat_SearchUserPublicInfo_Handler,
+		},
+		{
+			MethodName: "FindUserPublicInfo",
+			Handler:    _Chat_FindUserPublicInfo_Handler,
+		},
+		{
+			MethodName: "SearchUserFullInfo",
+			Handler:    _Chat_SearchUserFullInfo_Handler,
+		},
+		{
+			MethodName: "FindUserFullInfo",
+			Handler:    _Chat_FindUserFullInfo_Handler,
+		},
+		{
+			MethodName: "SendVerifyCode",
+			Handler:    _Chat_SendVerifyCode_Handler,
+		},
+		{
+			MethodName: "GetCaptcha",
+			Handler:    _Chat_GetCaptcha_Handler,
 		},
 		{
 			MethodName: "VerifyCode",
@@ -937,6 +1837,106 @@ var Chat_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllowRegister",
 			Handler:    _Chat_GetAllowRegister_Handler,
 		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _Chat_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _Chat_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _Chat_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "RegenerateTOTPRecoveryCodes",
+			Handler:    _Chat_RegenerateTOTPRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "GetTOTPStatus",
+			Handler:    _Chat_GetTOTPStatus_Handler,
+		},
+		{
+			MethodName: "LoginTOTP",
+			Handler:    _Chat_LoginTOTP_Handler,
+		},
+		{
+			MethodName: "ResetUserTOTP",
+			Handler:    _Chat_ResetUserTOTP_Handler,
+		},
+		{
+			MethodName: "SearchSuspiciousLogin",
+			Handler:    _Chat_SearchSuspiciousLogin_Handler,
+		},
+		{
+			MethodName: "NotifyImpersonation",
+			Handler:    _Chat_NotifyImpersonation_Handler,
+		},
+		{
+			MethodName: "NotifyAppealResult",
+			Handler:    _Chat_NotifyAppealResult_Handler,
+		},
+		{
+			MethodName: "AddReport",
+			Handler:    _Chat_AddReport_Handler,
+		},
+		{
+			MethodName: "SearchReport",
+			Handler:    _Chat_SearchReport_Handler,
+		},
+		{
+			MethodName: "SearchReportTarget",
+			Handler:    _Chat_SearchReportTarget_Handler,
+		},
+		{
+			MethodName: "HandleReport",
+			Handler:    _Chat_HandleReport_Handler,
+		},
+		{
+			MethodName: "AddModerationRule",
+			Handler:    _Chat_AddModerationRule_Handler,
+		},
+		{
+			MethodName: "UpdateModerationRule",
+			Handler:    _Chat_UpdateModerationRule_Handler,
+		},
+		{
+			MethodName: "DelModerationRule",
+			Handler:    _Chat_DelModerationRule_Handler,
+		},
+		{
+			MethodName: "SearchModerationRule",
+			Handler:    _Chat_SearchModerationRule_Handler,
+		},
+		{
+			MethodName: "AddModerationAllowUser",
+			Handler:    _Chat_AddModerationAllowUser_Handler,
+		},
+		{
+			MethodName: "DelModerationAllowUser",
+			Handler:    _Chat_DelModerationAllowUser_Handler,
+		},
+		{
+			MethodName: "SearchModerationAllowUser",
+			Handler:    _Chat_SearchModerationAllowUser_Handler,
+		},
+		{
+			MethodName: "SearchDelivery",
+			Handler:    _Chat_SearchDelivery_Handler,
+		},
+		{
+			MethodName: "SetMessageTemplate",
+			Handler:    _Chat_SetMessageTemplate_Handler,
+		},
+		{
+			MethodName: "DelMessageTemplate",
+			Handler:    _Chat_DelMessageTemplate_Handler,
+		},
+		{
+			MethodName: "SearchMessageTemplate",
+			Handler:    _Chat_SearchMessageTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chat/chat.proto",
@@ -57,14 +57,25 @@ func (o *AdminClient) CheckInvitationCode(ctx context.Context, invitationCode st
 	return nil
 }
 
-func (o *AdminClient) CheckRegister(ctx context.Context, ip string) error {
-	_, err := o.client.CheckRegisterForbidden(ctx, &admin.CheckRegisterForbiddenReq{Ip: ip})
-	return err
+func (o *AdminClient) CheckRegister(ctx context.Context, ip string) (*admin.CheckRegisterForbiddenResp, error) {
+	return o.client.CheckRegisterForbidden(ctx, &admin.CheckRegisterForbiddenReq{Ip: ip})
 }
 
-func (o *AdminClient) CheckLogin(ctx context.Context, userID string, ip string) error {
-	_, err := o.client.CheckLoginForbidden(ctx, &admin.CheckLoginForbiddenReq{Ip: ip, UserID: userID})
-	return err
+func (o *AdminClient) CheckLogin(ctx context.Context, userID string, ip string) (*admin.CheckLoginForbiddenResp, error) {
+	return o.client.CheckLoginForbidden(ctx, &admin.CheckLoginForbiddenReq{Ip: ip, UserID: userID})
+}
+
+// CheckLoginBlocked is CheckLogin telling about a block in the response, with the geo check result kept.
+func (o *AdminClient) CheckLoginBlocked(ctx context.Context, userID string, ip string) (*admin.CheckLoginForbiddenResp, error) {
+	return o.client.CheckLoginForbidden(ctx, &admin.CheckLoginForbiddenReq{Ip: ip, UserID: userID, AllowBlocked: true})
+}
+
+func (o *AdminClient) CreateAppealTicket(ctx context.Context, userID string) (string, error) {
+	resp, err := o.client.CreateAppealTicket(ctx, &admin.CreateAppealTicketReq{UserID: userID})
+	if err != nil {
+		return "", err
+	}
+	return resp.Ticket, nil
 }
 
 func (o *AdminClient) UseInvitationCode(ctx context.Context, userID string, invitationCode string) error {
@@ -83,8 +94,17 @@ func (o *AdminClient) CheckNilOrAdmin(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
-func (o *AdminClient) CreateToken(ctx context.Context, userID string, userType int32) (*admin.CreateTokenResp, error) {
-	return o.client.CreateToken(ctx, &admin.CreateTokenReq{UserID: userID, UserType: userType})
+// CheckPermission requires the op user to be an admin holding any of the permissions.
+func (o *AdminClient) CheckPermission(ctx context.Context, permissions ...string) error {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return err
+	}
+	_, err := o.client.CheckAdminPermission(ctx, &admin.CheckAdminPermissionReq{Permissions: permissions})
+	return err
+}
+
+func (o *AdminClient) CreateToken(ctx context.Context, userID string, userType int32, platform int32, deviceID string, ip string) (*admin.CreateTokenResp, error) {
+	return o.client.CreateToken(ctx, &admin.CreateTokenReq{UserID: userID, UserType: userType, Platform: platform, DeviceID: deviceID, Ip: ip})
 }
 
 func (o *AdminClient) GetDefaultFriendUserID(ctx context.Context) ([]string, error) {
@@ -107,3 +127,8 @@ func (o *AdminClient) InvalidateToken(ctx context.Context, userID string) error
 	_, err := o.client.InvalidateToken(ctx, &admin.InvalidateTokenReq{UserID: userID})
 	return err
 }
+
+func (o *AdminClient) BlockUser(ctx context.Context, userID string, reason string, category string, expireTime int64) error {
+	_, err := o.client.BlockUser(ctx, &admin.BlockUserReq{UserID: userID, Reason: reason, Category: category, ExpireTime: expireTime})
+	return err
+}
@@ -113,3 +113,13 @@ func (o *ChatClient) DelUserAccount(ctx context.Context, req *chat.DelUserAccoun
 	resp, err = o.client.DelUserAccount(ctx, req)
 	return resp, err
 }
+
+func (o *ChatClient) NotifyImpersonation(ctx context.Context, userID string, impersonator string, expireTime int64) error {
+	_, err := o.client.NotifyImpersonation(ctx, &chat.NotifyImpersonationReq{UserID: userID, Impersonator: impersonator, ExpireTime: expireTime})
+	return err
+}
+
+func (o *ChatClient) NotifyAppealResult(ctx context.Context, userID string, accepted bool, response string) error {
+	_, err := o.client.NotifyAppealResult(ctx, &chat.NotifyAppealResultReq{UserID: userID, Accepted: accepted, Response: response})
+	return err
+}
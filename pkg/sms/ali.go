@@ -24,7 +24,7 @@ import (
 	"github.com/openimsdk/tools/errs"
 )
 
-func NewAli(endpoint, accessKeyId, accessKeySecret, signName, verificationCodeTemplateCode string) (SMS, error) {
+func NewAli(endpoint, accessKeyId, accessKeySecret, signName, verificationCodeTemplateCode, loginNoticeTemplateCode string) (SMS, error) {
 	conf := &aliconf.Config{
 		Endpoint:        tea.String(endpoint),
 		AccessKeyId:     tea.String(accessKeyId),
@@ -37,6 +37,7 @@ func NewAli(endpoint, accessKeyId, accessKeySecret, signName, verificationCodeTe
 	return &ali{
 		signName:                     signName,
 		verificationCodeTemplateCode: verificationCodeTemplateCode,
+		loginNoticeTemplateCode:      loginNoticeTemplateCode,
 		client:                       client,
 	}, nil
 }
@@ -44,6 +45,7 @@ func NewAli(endpoint, accessKeyId, accessKeySecret, signName, verificationCodeTe
 type ali struct {
 	signName                     string
 	verificationCodeTemplateCode string
+	loginNoticeTemplateCode      string
 	client                       *dysmsapi.Client
 }
 
@@ -51,12 +53,12 @@ func (a *ali) Name() string {
 	return "ali-sms"
 }
 
-func (a *ali) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
+func (a *ali) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) (string, error) {
 	data, err := json.Marshal(&struct {
 		Code string `json:"code"`
 	}{Code: verifyCode})
 	if err != nil {
-		return errs.Wrap(err)
+		return "", errs.Wrap(err)
 	}
 	req := &dysmsapi.SendSmsRequest{
 		PhoneNumbers:  tea.String(areaCode + phoneNumber),
@@ -64,6 +66,37 @@ func (a *ali) SendCode(ctx context.Context, areaCode string, phoneNumber string,
 		TemplateCode:  tea.String(a.verificationCodeTemplateCode),
 		TemplateParam: tea.String(string(data)),
 	}
-	_, err = a.client.SendSms(req)
-	return errs.Wrap(err)
+	return a.send(req)
+}
+
+func (a *ali) SendLoginNotice(ctx context.Context, areaCode string, phoneNumber string, params map[string]string) (string, error) {
+	if a.loginNoticeTemplateCode == "" {
+		return "", errs.New("ali sms login notice template is not configured")
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	req := &dysmsapi.SendSmsRequest{
+		PhoneNumbers:  tea.String(areaCode + phoneNumber),
+		SignName:      tea.String(a.signName),
+		TemplateCode:  tea.String(a.loginNoticeTemplateCode),
+		TemplateParam: tea.String(string(data)),
+	}
+	return a.send(req)
+}
+
+// send returns the BizId of the message; Aliyun reports refused messages in the response code.
+func (a *ali) send(req *dysmsapi.SendSmsRequest) (string, error) {
+	resp, err := a.client.SendSms(req)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	if resp.Body == nil {
+		return "", errs.New("ali sms response is empty")
+	}
+	if code := tea.StringValue(resp.Body.Code); code != "OK" {
+		return "", errs.New("ali sms send failed", "code", code, "message", tea.StringValue(resp.Body.Message))
+	}
+	return tea.StringValue(resp.Body.BizId), nil
 }
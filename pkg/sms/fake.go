@@ -0,0 +1,92 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/idutil"
+)
+
+// NewFake sends nothing: it logs each message without its code or text and, when file is set, appends
+// it there as a JSON line, so codes can be read back during local development. Never use it in production.
+func NewFake(file string) (SMS, error) {
+	f := &fake{}
+	if file != "" {
+		var err error
+		f.file, err = os.OpenFile(file, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "open fake sms file failed", "file", file)
+		}
+	}
+	return f, nil
+}
+
+type fake struct {
+	lock sync.Mutex
+	file *os.File
+}
+
+type fakeRecord struct {
+	ID          string            `json:"id"`
+	Time        time.Time         `json:"time"`
+	AreaCode    string            `json:"areaCode"`
+	PhoneNumber string            `json:"phoneNumber"`
+	Code        string            `json:"code,omitempty"`
+	Text        string            `json:"text,omitempty"`
+	LoginNotice map[string]string `json:"loginNotice,omitempty"`
+}
+
+func (f *fake) Name() string {
+	return "fake-sms"
+}
+
+func (f *fake) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) (string, error) {
+	id := idutil.OperationIDGenerator()
+	log.ZInfo(ctx, "fake sms verification code", "id", id, "areaCode", areaCode, "phoneNumber", phoneNumber)
+	return id, f.record(&fakeRecord{ID: id, Time: time.Now(), AreaCode: areaCode, PhoneNumber: phoneNumber, Code: verifyCode})
+}
+
+func (f *fake) SendText(ctx context.Context, areaCode string, phoneNumber string, text string) (string, error) {
+	id := idutil.OperationIDGenerator()
+	log.ZInfo(ctx, "fake sms text", "id", id, "areaCode", areaCode, "phoneNumber", phoneNumber, "length", len(text))
+	return id, f.record(&fakeRecord{ID: id, Time: time.Now(), AreaCode: areaCode, PhoneNumber: phoneNumber, Text: text})
+}
+
+func (f *fake) SendLoginNotice(ctx context.Context, areaCode string, phoneNumber string, params map[string]string) (string, error) {
+	id := idutil.OperationIDGenerator()
+	log.ZInfo(ctx, "fake sms login notice", "id", id, "areaCode", areaCode, "phoneNumber", phoneNumber, "params", params)
+	return id, f.record(&fakeRecord{ID: id, Time: time.Now(), AreaCode: areaCode, PhoneNumber: phoneNumber, LoginNotice: params})
+}
+
+func (f *fake) record(r *fakeRecord) error {
+	if f.file == nil {
+		return nil
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	_, err = f.file.Write(append(data, '\n'))
+	return errs.Wrap(err)
+}
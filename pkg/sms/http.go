@@ -0,0 +1,137 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"text/template"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// httpTemplateData is what the request body templates of the HTTP provider can use.
+type httpTemplateData struct {
+	AreaCode    string
+	PhoneNumber string
+	E164        string // +<area code><number>
+	Code        string
+	Platform    string
+	IP          string
+	Country     string
+}
+
+var httpTemplateFuncs = template.FuncMap{
+	// json quotes a value for a JSON body, as in {"to": {{json .E164}}}
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+// NewHTTP sends through any HTTP API: the bodies are text/template templates of httpTemplateData,
+// and any 2xx status counts as sent.
+func NewHTTP(url, method string, headers map[string]string, verificationCodeBody, loginNoticeBody string) (SMS, error) {
+	if url == "" {
+		return nil, errs.New("http sms url is required")
+	}
+	if method == "" {
+		method = http.MethodPost
+	}
+	codeBody, err := template.New("verificationCode").Funcs(httpTemplateFuncs).Parse(verificationCodeBody)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "http sms verification code body is invalid")
+	}
+	h := &httpSMS{
+		url:                  url,
+		method:               method,
+		headers:              headers,
+		verificationCodeBody: codeBody,
+		client:               &http.Client{Timeout: 10 * time.Second},
+	}
+	if loginNoticeBody != "" {
+		h.loginNoticeBody, err = template.New("loginNotice").Funcs(httpTemplateFuncs).Parse(loginNoticeBody)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "http sms login notice body is invalid")
+		}
+	}
+	return h, nil
+}
+
+type httpSMS struct {
+	url                  string
+	method               string
+	headers              map[string]string
+	verificationCodeBody *template.Template
+	loginNoticeBody      *template.Template
+	client               *http.Client
+}
+
+func (h *httpSMS) Name() string {
+	return "http-sms"
+}
+
+func (h *httpSMS) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) (string, error) {
+	return h.send(ctx, h.verificationCodeBody, &httpTemplateData{
+		AreaCode:    areaCode,
+		PhoneNumber: phoneNumber,
+		E164:        e164(areaCode, phoneNumber),
+		Code:        verifyCode,
+	})
+}
+
+func (h *httpSMS) SendLoginNotice(ctx context.Context, areaCode string, phoneNumber string, params map[string]string) (string, error) {
+	if h.loginNoticeBody == nil {
+		return "", errs.New("http sms login notice body is not configured")
+	}
+	return h.send(ctx, h.loginNoticeBody, &httpTemplateData{
+		AreaCode:    areaCode,
+		PhoneNumber: phoneNumber,
+		E164:        e164(areaCode, phoneNumber),
+		Platform:    params["platform"],
+		IP:          params["ip"],
+		Country:     params["country"],
+	})
+}
+
+// send returns no message ID, the response body of the API is unknown.
+func (h *httpSMS) send(ctx context.Context, body *template.Template, data *httpTemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := body.Execute(&buf, data); err != nil {
+		return "", errs.WrapMsg(err, "render http sms body failed")
+	}
+	req, err := http.NewRequestWithContext(ctx, h.method, h.url, &buf)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range h.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return "", nil
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return "", errs.New("http sms send failed", "status", resp.StatusCode, "body", string(msg))
+}
@@ -0,0 +1,109 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// Registry builds SMS providers by name, so the phone config can pick any of them per area code.
+type Registry struct {
+	factories map[string]func() (SMS, error)
+	built     map[string]SMS
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		factories: make(map[string]func() (SMS, error)),
+		built:     make(map[string]SMS),
+	}
+}
+
+// Register adds a provider; factory is only called once the provider is used.
+func (r *Registry) Register(name string, factory func() (SMS, error)) {
+	r.factories[strings.ToLower(name)] = factory
+}
+
+// Get builds the named provider on first use and returns the same instance afterwards.
+func (r *Registry) Get(name string) (SMS, error) {
+	name = strings.ToLower(name)
+	if s, ok := r.built[name]; ok {
+		return s, nil
+	}
+	factory, ok := r.factories[name]
+	if !ok {
+		return nil, errs.New("unknown sms provider", "name", name)
+	}
+	s, err := factory()
+	if err != nil {
+		return nil, errs.WrapMsg(err, "build sms provider failed", "name", name)
+	}
+	r.built[name] = s
+	return s, nil
+}
+
+// NewRouter returns an SMS sending through the provider of the area code in routes, or through def.
+func NewRouter(def SMS, routes map[string]SMS) SMS {
+	normalized := make(map[string]SMS, len(routes))
+	for areaCode, s := range routes {
+		normalized[normalizeAreaCode(areaCode)] = s
+	}
+	return &router{def: def, routes: normalized}
+}
+
+type router struct {
+	def    SMS
+	routes map[string]SMS
+}
+
+func normalizeAreaCode(areaCode string) string {
+	return strings.TrimPrefix(strings.TrimSpace(areaCode), "+")
+}
+
+func (r *router) provider(areaCode string) SMS {
+	if s, ok := r.routes[normalizeAreaCode(areaCode)]; ok {
+		return s
+	}
+	return r.def
+}
+
+func (r *router) Name() string {
+	return r.def.Name()
+}
+
+func (r *router) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) (string, error) {
+	return r.provider(areaCode).SendCode(ctx, areaCode, phoneNumber, verifyCode)
+}
+
+func (r *router) SendLoginNotice(ctx context.Context, areaCode string, phoneNumber string, params map[string]string) (string, error) {
+	return r.provider(areaCode).SendLoginNotice(ctx, areaCode, phoneNumber, params)
+}
+
+// e164 joins the area code and number into +<digits>.
+func e164(areaCode string, phoneNumber string) string {
+	return "+" + normalizeAreaCode(areaCode) + strings.TrimSpace(phoneNumber)
+}
+
+// fillText fills {code} or the login notice params such as {ip} into a message template.
+func fillText(template string, params map[string]string) string {
+	pairs := make([]string, 0, len(params)*2)
+	for k, v := range params {
+		pairs = append(pairs, "{"+k+"}", v)
+	}
+	return strings.NewReplacer(pairs...).Replace(template)
+}
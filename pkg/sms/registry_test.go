@@ -0,0 +1,46 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"testing"
+)
+
+type namedSMS string
+
+func (n namedSMS) Name() string { return string(n) }
+
+func (n namedSMS) SendCode(context.Context, string, string, string) (string, error) { return "", nil }
+
+func (n namedSMS) SendLoginNotice(context.Context, string, string, map[string]string) (string, error) {
+	return "", nil
+}
+
+func TestRoute(t *testing.T) {
+	r := NewRouter(namedSMS("default"), map[string]SMS{"+86": namedSMS("china"), " 1": namedSMS("us")})
+	tests := map[string]string{"86": "china", "+86": "china", "+1": "us", "+44": "default", "": "default"}
+	for areaCode, want := range tests {
+		if got := Route(r, areaCode).Name(); got != want {
+			t.Errorf("Route(%q) = %s, want %s", areaCode, got, want)
+		}
+	}
+	if got := Route(namedSMS("single"), "+86").Name(); got != "single" {
+		t.Errorf("Route of a provider = %s, want it", got)
+	}
+	if got := len(Providers(r)); got != 3 {
+		t.Errorf("Providers of the router = %d, want 3", got)
+	}
+}
@@ -4,5 +4,41 @@ import "context"
 
 type SMS interface {
 	Name() string
-	SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error
+	// SendCode returns the message ID given by the provider, if any.
+	SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) (string, error)
+	// SendLoginNotice tells the user about a suspicious login; params are platform, ip and country.
+	SendLoginNotice(ctx context.Context, areaCode string, phoneNumber string, params map[string]string) (string, error)
+}
+
+// TextSMS is implemented by providers sending free text rather than a template kept by the provider,
+// so the caller can send a message of its own such as a localized one.
+type TextSMS interface {
+	SendText(ctx context.Context, areaCode string, phoneNumber string, text string) (string, error)
+}
+
+// AsText returns the provider sending to the area code as a TextSMS, if it sends free text.
+func AsText(s SMS, areaCode string) (TextSMS, bool) {
+	t, ok := Route(s, areaCode).(TextSMS)
+	return t, ok
+}
+
+// Route returns the provider sending to the area code, which is s unless it is a router.
+func Route(s SMS, areaCode string) SMS {
+	if r, ok := s.(*router); ok {
+		return r.provider(areaCode)
+	}
+	return s
+}
+
+// Providers returns the providers s sends through, the default one first.
+func Providers(s SMS) []SMS {
+	r, ok := s.(*router)
+	if !ok {
+		return []SMS{s}
+	}
+	providers := []SMS{r.def}
+	for _, p := range r.routes {
+		providers = append(providers, p)
+	}
+	return providers
 }
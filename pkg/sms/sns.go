@@ -0,0 +1,149 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// NewSNS sends transactional text messages through AWS SNS; the templates hold {code},
+// or {platform}, {ip} and {country}.
+func NewSNS(region, accessKeyID, secretAccessKey, senderID, verificationCodeTemplate, loginNoticeTemplate string) (SMS, error) {
+	if region == "" || accessKeyID == "" || secretAccessKey == "" {
+		return nil, errs.New("sns region, accessKeyId and secretAccessKey are required")
+	}
+	if verificationCodeTemplate == "" {
+		verificationCodeTemplate = "Your verification code is {code}"
+	}
+	return &sns{
+		region:                   region,
+		host:                     "sns." + region + ".amazonaws.com",
+		accessKeyID:              accessKeyID,
+		secretAccessKey:          secretAccessKey,
+		senderID:                 senderID,
+		verificationCodeTemplate: verificationCodeTemplate,
+		loginNoticeTemplate:      loginNoticeTemplate,
+		client:                   &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+type sns struct {
+	region                   string
+	host                     string
+	accessKeyID              string
+	secretAccessKey          string
+	senderID                 string
+	verificationCodeTemplate string
+	loginNoticeTemplate      string
+	client                   *http.Client
+}
+
+func (s *sns) Name() string {
+	return "aws-sns"
+}
+
+func (s *sns) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) (string, error) {
+	return s.publish(ctx, e164(areaCode, phoneNumber), fillText(s.verificationCodeTemplate, map[string]string{"code": verifyCode}))
+}
+
+func (s *sns) SendText(ctx context.Context, areaCode string, phoneNumber string, text string) (string, error) {
+	return s.publish(ctx, e164(areaCode, phoneNumber), text)
+}
+
+func (s *sns) SendLoginNotice(ctx context.Context, areaCode string, phoneNumber string, params map[string]string) (string, error) {
+	if s.loginNoticeTemplate == "" {
+		return "", errs.New("sns login notice template is not configured")
+	}
+	return s.publish(ctx, e164(areaCode, phoneNumber), fillText(s.loginNoticeTemplate, params))
+}
+
+// publish returns the message ID given by SNS.
+func (s *sns) publish(ctx context.Context, phoneNumber string, message string) (string, error) {
+	form := url.Values{
+		"Action":                         {"Publish"},
+		"Version":                        {"2010-03-31"},
+		"PhoneNumber":                    {phoneNumber},
+		"Message":                        {message},
+		"MessageAttributes.entry.1.Name": {"AWS.SNS.SMS.SMSType"},
+		"MessageAttributes.entry.1.Value.DataType":    {"String"},
+		"MessageAttributes.entry.1.Value.StringValue": {"Transactional"},
+	}
+	if s.senderID != "" {
+		form.Set("MessageAttributes.entry.2.Name", "AWS.SNS.SMS.SenderID")
+		form.Set("MessageAttributes.entry.2.Value.DataType", "String")
+		form.Set("MessageAttributes.entry.2.Value.StringValue", s.senderID)
+	}
+	body := form.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+s.host+"/", strings.NewReader(body))
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	now := time.Now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("Authorization", sigV4Authorization(http.MethodPost, s.host, "", s.region, "sns", s.accessKeyID, s.secretAccessKey, body, now))
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	var res struct {
+		PublishResult struct {
+			MessageID string `xml:"MessageId"`
+		} `xml:"PublishResult"`
+		Error struct {
+			Code    string `xml:"Code"`
+			Message string `xml:"Message"`
+		} `xml:"Error"`
+	}
+	_ = xml.Unmarshal(data, &res)
+	if resp.StatusCode < 300 {
+		return res.PublishResult.MessageID, nil
+	}
+	return "", errs.New("sns publish failed", "status", resp.StatusCode, "code", res.Error.Code, "message", res.Error.Message)
+}
+
+// sigV4Authorization signs a form request to the root of host with AWS Signature Version 4;
+// query is the canonical query string, body the form.
+func sigV4Authorization(method, host, query, region, service, accessKeyID, secretAccessKey, body string, now time.Time) string {
+	const signedHeaders = "content-type;host;x-amz-date"
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	bodyHash := sha256.Sum256([]byte(body))
+	canonicalRequest := method + "\n/\n" + query + "\ncontent-type:application/x-www-form-urlencoded; charset=utf-8\nhost:" + host + "\nx-amz-date:" + amzDate + "\n\n" +
+		signedHeaders + "\n" + hex.EncodeToString(bodyHash[:])
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	return "AWS4-HMAC-SHA256 Credential=" + accessKeyID + "/" + scope + ", SignedHeaders=" + signedHeaders + ", Signature=" + signature
+}
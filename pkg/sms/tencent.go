@@ -0,0 +1,171 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	tencentHost    = "sms.tencentcloudapi.com"
+	tencentService = "sms"
+	tencentVersion = "2021-01-11"
+)
+
+// NewTencent sends template messages through Tencent Cloud SMS. The verification code template
+// takes the code, the login notice template the platform, ip and country in this order.
+func NewTencent(secretID, secretKey, region, sdkAppID, signName, verificationCodeTemplateID, loginNoticeTemplateID string) (SMS, error) {
+	if secretID == "" || secretKey == "" || sdkAppID == "" {
+		return nil, errs.New("tencent secretId, secretKey and sdkAppId are required")
+	}
+	if region == "" {
+		region = "ap-guangzhou"
+	}
+	return &tencent{
+		secretID:                   secretID,
+		secretKey:                  secretKey,
+		region:                     region,
+		sdkAppID:                   sdkAppID,
+		signName:                   signName,
+		verificationCodeTemplateID: verificationCodeTemplateID,
+		loginNoticeTemplateID:      loginNoticeTemplateID,
+		client:                     &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+type tencent struct {
+	secretID                   string
+	secretKey                  string
+	region                     string
+	sdkAppID                   string
+	signName                   string
+	verificationCodeTemplateID string
+	loginNoticeTemplateID      string
+	client                     *http.Client
+}
+
+func (t *tencent) Name() string {
+	return "tencent-sms"
+}
+
+func (t *tencent) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) (string, error) {
+	return t.send(ctx, e164(areaCode, phoneNumber), t.verificationCodeTemplateID, []string{verifyCode})
+}
+
+func (t *tencent) SendLoginNotice(ctx context.Context, areaCode string, phoneNumber string, params map[string]string) (string, error) {
+	if t.loginNoticeTemplateID == "" {
+		return "", errs.New("tencent sms login notice template is not configured")
+	}
+	return t.send(ctx, e164(areaCode, phoneNumber), t.loginNoticeTemplateID, []string{params["platform"], params["ip"], params["country"]})
+}
+
+// send returns the serial number of the message.
+func (t *tencent) send(ctx context.Context, phoneNumber string, templateID string, templateParams []string) (string, error) {
+	payload, err := json.Marshal(map[string]any{
+		"PhoneNumberSet":   []string{phoneNumber},
+		"SmsSdkAppId":      t.sdkAppID,
+		"SignName":         t.signName,
+		"TemplateId":       templateID,
+		"TemplateParamSet": templateParams,
+	})
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+tencentHost, bytes.NewReader(payload))
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	now := time.Now().UTC()
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", tc3Authorization(t.secretID, t.secretKey, tencentHost, tencentService, payload, now))
+	req.Header.Set("X-TC-Action", "SendSms")
+	req.Header.Set("X-TC-Timestamp", strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set("X-TC-Version", tencentVersion)
+	req.Header.Set("X-TC-Region", t.region)
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	var res struct {
+		Response struct {
+			Error *struct {
+				Code    string `json:"Code"`
+				Message string `json:"Message"`
+			} `json:"Error"`
+			SendStatusSet []struct {
+				SerialNo string `json:"SerialNo"`
+				Code     string `json:"Code"`
+				Message  string `json:"Message"`
+			} `json:"SendStatusSet"`
+		} `json:"Response"`
+	}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return "", errs.WrapMsg(err, "tencent sms response is invalid", "status", resp.StatusCode)
+	}
+	if e := res.Response.Error; e != nil {
+		return "", errs.New("tencent sms send failed", "code", e.Code, "message", e.Message)
+	}
+	if len(res.Response.SendStatusSet) == 0 {
+		return "", errs.New("tencent sms response has no send status")
+	}
+	status := res.Response.SendStatusSet[0]
+	if status.Code != "Ok" {
+		return "", errs.New("tencent sms send failed", "code", status.Code, "message", status.Message)
+	}
+	return status.SerialNo, nil
+}
+
+// tc3StringToSign is the TC3-HMAC-SHA256 string to sign of a POST of a JSON payload to the root of host.
+func tc3StringToSign(host, service string, payload []byte, now time.Time) string {
+	const signedHeaders = "content-type;host"
+	payloadHash := sha256.Sum256(payload)
+	canonicalRequest := "POST\n/\n\ncontent-type:application/json; charset=utf-8\nhost:" + host + "\n\n" +
+		signedHeaders + "\n" + hex.EncodeToString(payloadHash[:])
+	scope := now.UTC().Format("2006-01-02") + "/" + service + "/tc3_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	return "TC3-HMAC-SHA256\n" + strconv.FormatInt(now.Unix(), 10) + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+}
+
+// tc3Authorization signs a POST of a JSON payload to the root of host with TC3-HMAC-SHA256.
+func tc3Authorization(secretID, secretKey, host, service string, payload []byte, now time.Time) string {
+	date := now.UTC().Format("2006-01-02")
+	key := hmacSHA256([]byte("TC3"+secretKey), date)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "tc3_request")
+	signature := hex.EncodeToString(hmacSHA256(key, tc3StringToSign(host, service, payload, now)))
+	return "TC3-HMAC-SHA256 Credential=" + secretID + "/" + date + "/" + service + "/tc3_request, SignedHeaders=content-type;host, Signature=" + signature
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,46 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"testing"
+	"time"
+)
+
+// The example request of the Tencent Cloud API 3.0 signature documentation (CVM DescribeInstances),
+// the payload keeps its \u escapes as they are hashed.
+var (
+	tc3ExamplePayload = []byte(`{"Limit": 1, "Filters": [{"Values": ["\u672a\u547d\u540d"], "Name": "instance-name"}]}`)
+	tc3ExampleTime    = time.Unix(1551113065, 0)
+)
+
+func TestTC3StringToSign(t *testing.T) {
+	got := tc3StringToSign("cvm.tencentcloudapi.com", "cvm", tc3ExamplePayload, tc3ExampleTime)
+	want := "TC3-HMAC-SHA256\n1551113065\n2019-02-25/cvm/tc3_request\n5ffe6a04c0664d6b969fab9a13bdab201d63ee709638e2749d62a09ca18d7031"
+	if got != want {
+		t.Errorf("tc3StringToSign() = %q, want %q", got, want)
+	}
+}
+
+// The documentation masks its secret key, so the signature was computed independently for this key.
+func TestTC3Authorization(t *testing.T) {
+	got := tc3Authorization("AKIDz8krbsJ5yKBZQpn74WFkmLPx3EXAMPLE", "Gu5t9xGARNpq86cd98joQYCN3EXAMPLE", "cvm.tencentcloudapi.com", "cvm",
+		tc3ExamplePayload, tc3ExampleTime)
+	want := "TC3-HMAC-SHA256 Credential=AKIDz8krbsJ5yKBZQpn74WFkmLPx3EXAMPLE/2019-02-25/cvm/tc3_request, SignedHeaders=content-type;host, " +
+		"Signature=72e494ea809ad7a8c8f7a4507b9bddcbaa8e581f516e8da2f66e2c5a96525168"
+	if got != want {
+		t.Errorf("tc3Authorization() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+const twilioAPI = "https://api.twilio.com/2010-04-01/Accounts/"
+
+// NewTwilio sends plain text messages; the templates hold {code}, or {platform}, {ip} and {country}.
+func NewTwilio(accountSID, authToken, from, messagingServiceSID, verificationCodeTemplate, loginNoticeTemplate string) (SMS, error) {
+	if accountSID == "" || authToken == "" {
+		return nil, errs.New("twilio accountSid and authToken are required")
+	}
+	if from == "" && messagingServiceSID == "" {
+		return nil, errs.New("twilio from or messagingServiceSid is required")
+	}
+	if verificationCodeTemplate == "" {
+		verificationCodeTemplate = "Your verification code is {code}"
+	}
+	return &twilio{
+		accountSID:               accountSID,
+		authToken:                authToken,
+		from:                     from,
+		messagingServiceSID:      messagingServiceSID,
+		verificationCodeTemplate: verificationCodeTemplate,
+		loginNoticeTemplate:      loginNoticeTemplate,
+		client:                   &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+type twilio struct {
+	accountSID               string
+	authToken                string
+	from                     string
+	messagingServiceSID      string
+	verificationCodeTemplate string
+	loginNoticeTemplate      string
+	client                   *http.Client
+}
+
+func (t *twilio) Name() string {
+	return "twilio-sms"
+}
+
+func (t *twilio) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) (string, error) {
+	return t.send(ctx, e164(areaCode, phoneNumber), fillText(t.verificationCodeTemplate, map[string]string{"code": verifyCode}))
+}
+
+func (t *twilio) SendText(ctx context.Context, areaCode string, phoneNumber string, text string) (string, error) {
+	return t.send(ctx, e164(areaCode, phoneNumber), text)
+}
+
+func (t *twilio) SendLoginNotice(ctx context.Context, areaCode string, phoneNumber string, params map[string]string) (string, error) {
+	if t.loginNoticeTemplate == "" {
+		return "", errs.New("twilio sms login notice template is not configured")
+	}
+	return t.send(ctx, e164(areaCode, phoneNumber), fillText(t.loginNoticeTemplate, params))
+}
+
+// send returns the SID of the message.
+func (t *twilio) send(ctx context.Context, to string, body string) (string, error) {
+	form := url.Values{"To": {to}, "Body": {body}}
+	if t.messagingServiceSID != "" {
+		form.Set("MessagingServiceSid", t.messagingServiceSID)
+	} else {
+		form.Set("From", t.from)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, twilioAPI+t.accountSID+"/Messages.json", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	req.SetBasicAuth(t.accountSID, t.authToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	var res struct {
+		SID     string `json:"sid"`
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	_ = json.Unmarshal(data, &res)
+	if resp.StatusCode < 300 {
+		return res.SID, nil
+	}
+	return "", errs.New("twilio sms send failed", "status", resp.StatusCode, "code", res.Code, "message", res.Message)
+}